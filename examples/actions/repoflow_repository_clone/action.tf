@@ -0,0 +1,24 @@
+# Give every team a copy of the golden npm repository
+action "repoflow_repository_clone" "team" {
+  for_each = toset(var.teams)
+
+  config {
+    workspace        = "templates"
+    repository       = "npm-golden"
+    name             = "npm-${each.key}"
+    target_workspace = each.key
+    include_contents = true
+  }
+}
+
+resource "terraform_data" "teams" {
+  for_each = toset(var.teams)
+  input    = each.key
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create]
+      actions = [action.repoflow_repository_clone.team[each.key]]
+    }
+  }
+}
@@ -0,0 +1,19 @@
+# Rebuild the Maven metadata once the storage backend has been migrated
+action "repoflow_system_task" "reindex_maven" {
+  config {
+    task         = "reindex"
+    package_type = "maven"
+    timeout      = "2h"
+  }
+}
+
+resource "terraform_data" "storage_migration" {
+  input = var.storage_backend
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.repoflow_system_task.reindex_maven]
+    }
+  }
+}
@@ -0,0 +1,18 @@
+# Back up the configuration of the workspace after every change
+action "repoflow_workspace_export" "backup" {
+  config {
+    workspace = var.workspace
+    path      = "${path.root}/backups/${var.workspace}.json"
+  }
+}
+
+resource "repoflow_workspace" "example" {
+  name = var.workspace
+
+  lifecycle {
+    action_trigger {
+      events  = [after_create, after_update]
+      actions = [action.repoflow_workspace_export.backup]
+    }
+  }
+}
@@ -0,0 +1,24 @@
+# Stamp out the staging workspace from the production template, reviewing the
+# changes first with `terraform apply -invoke=action.repoflow_workspace_import.staging_preview`
+action "repoflow_workspace_import" "staging_preview" {
+  config {
+    workspace = "staging"
+    document  = templatefile("${path.module}/templates/workspace.json.tftpl", { env = "staging" })
+    dry_run   = true
+  }
+}
+
+action "repoflow_workspace_import" "staging" {
+  config {
+    workspace = "staging"
+    document  = templatefile("${path.module}/templates/workspace.json.tftpl", { env = "staging" })
+  }
+}
+
+# Restore a backup written by the repoflow_workspace_export action
+action "repoflow_workspace_import" "restore" {
+  config {
+    workspace = "example"
+    path      = "${path.root}/backups/example.json"
+  }
+}
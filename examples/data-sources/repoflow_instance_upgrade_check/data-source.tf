@@ -0,0 +1,8 @@
+data "repoflow_instance_upgrade_check" "example" {}
+
+check "repoflow_upgrade" {
+  assert {
+    condition     = length(data.repoflow_instance_upgrade_check.example.breaking_changes) == 0
+    error_message = "RepoFlow ${data.repoflow_instance_upgrade_check.example.latest_version} brings breaking changes to the managed settings."
+  }
+}
@@ -0,0 +1,11 @@
+# The latest stable release of the image, skipping the release candidates
+data "repoflow_latest_version" "api" {
+  workspace    = "platform"
+  repository   = "docker-local"
+  package_name = "acme/api"
+  stable_only  = true
+}
+
+output "api_image" {
+  value = "docker.example.com/acme/api:${data.repoflow_latest_version.api.version}"
+}
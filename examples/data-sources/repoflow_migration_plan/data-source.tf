@@ -0,0 +1,24 @@
+variable "green_api_key" {
+  type      = string
+  sensitive = true
+}
+
+# Compare the running (blue) instance with the upgraded (green) one before switching the traffic
+data "repoflow_migration_plan" "green" {
+  target_base_url = "https://green.repoflow.example/api"
+  target_api_key  = var.green_api_key
+}
+
+output "green_missing" {
+  value = [
+    for d in data.repoflow_migration_plan.green.differences : "${d.workspace}/${d.name}"
+    if d.action == "create"
+  ]
+}
+
+check "green_in_sync" {
+  assert {
+    condition     = data.repoflow_migration_plan.green.in_sync
+    error_message = "The green instance differs from the blue one, see the green_missing output"
+  }
+}
@@ -0,0 +1,12 @@
+# The 1.x releases of the chart, from 1.4 on
+data "repoflow_package_versions" "ingress" {
+  workspace          = "platform"
+  repository         = "charts"
+  package_name       = "ingress"
+  version_constraint = "~> 1.4"
+}
+
+# The newest version matching the constraint comes first
+output "ingress_version" {
+  value = data.repoflow_package_versions.ingress.versions[0].version
+}
@@ -0,0 +1,10 @@
+# The packages of the acme scope published in the npm repository
+data "repoflow_packages" "acme" {
+  workspace   = "platform"
+  repository  = "npm-local"
+  name_prefix = "@acme/"
+}
+
+output "acme_latest_versions" {
+  value = { for pkg in data.repoflow_packages.acme.packages : pkg.name => pkg.latest_version }
+}
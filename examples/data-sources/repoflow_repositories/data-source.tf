@@ -0,0 +1,16 @@
+# The npm remote repositories of the workspace
+data "repoflow_repositories" "npm_remotes" {
+  workspace       = "platform"
+  package_type    = "npm"
+  repository_type = "remote"
+}
+
+# The repositories of a team, by naming convention
+data "repoflow_repositories" "payments" {
+  workspace   = "platform"
+  name_prefix = "payments-"
+}
+
+output "npm_remote_names" {
+  value = data.repoflow_repositories.npm_remotes.repositories[*].name
+}
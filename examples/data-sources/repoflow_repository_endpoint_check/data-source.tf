@@ -0,0 +1,18 @@
+# Smoke test the proxy after each apply, a failed assertion is reported as a warning
+check "npm_remote_endpoint" {
+  data "repoflow_repository_endpoint_check" "npm_remote" {
+    workspace  = "example"
+    repository = repoflow_repository.npm_remote.name
+    path       = "react"
+  }
+
+  assert {
+    condition     = data.repoflow_repository_endpoint_check.npm_remote.healthy
+    error_message = "${data.repoflow_repository_endpoint_check.npm_remote.url} answered ${data.repoflow_repository_endpoint_check.npm_remote.status_code}"
+  }
+
+  assert {
+    condition     = data.repoflow_repository_endpoint_check.npm_remote.latency_ms < 2000
+    error_message = "The npm proxy answered in ${data.repoflow_repository_endpoint_check.npm_remote.latency_ms}ms"
+  }
+}
@@ -0,0 +1,9 @@
+data "repoflow_repository_stats" "example" {
+  workspace  = "example"
+  repository = "npm-remote"
+  period     = "1h"
+}
+
+output "npm_remote_p95_ms" {
+  value = data.repoflow_repository_stats.example.latency_p95_ms
+}
@@ -0,0 +1,10 @@
+data "repoflow_unmanaged_changes" "example" {
+  workspace = "example"
+  since     = "2026-10-01T00:00:00Z"
+}
+
+output "drift_report" {
+  value = [
+    for c in data.repoflow_unmanaged_changes.example.changes : "${c.kind} ${c.name}: ${join(", ", c.reasons)}"
+  ]
+}
@@ -1,3 +1,7 @@
 data "repoflow_workspace" "example" {
   name = "example"
 }
+
+output "example_is_production" {
+  value = lookup(data.repoflow_workspace.example.labels, "environment", "") == "production"
+}
@@ -0,0 +1,15 @@
+# The workspaces of the teams, owned by the platform group
+data "repoflow_workspaces" "teams" {
+  name_regex = "^team-"
+  labels = {
+    owner = "platform"
+  }
+}
+
+# Apply the same naming convention to every team workspace
+resource "repoflow_workspace_settings" "teams" {
+  for_each = { for ws in data.repoflow_workspaces.teams.workspaces : ws.name => ws.id }
+
+  workspace               = each.value
+  repository_name_pattern = "^[a-z0-9-]+$"
+}
@@ -0,0 +1,23 @@
+resource "repoflow_repository" "modules" {
+  name            = "terraform-modules"
+  workspace       = "example"
+  repository_type = "local"
+  package_type    = "terraform-module"
+}
+
+ephemeral "repoflow_registry_token" "ci" {
+  workspace  = "example"
+  repository = repoflow_repository.modules.name
+  scopes     = ["packages:read", "packages:write"]
+  ttl        = "30m"
+}
+
+# Ephemeral values are only accepted by write-only arguments, provider blocks and
+# other ephemeral contexts, e.g. a locals rendering the CLI configuration
+locals {
+  cli_credentials = <<-EOT
+    credentials "${ephemeral.repoflow_registry_token.ci.hostname}" {
+      token = "${ephemeral.repoflow_registry_token.ci.token}"
+    }
+  EOT
+}
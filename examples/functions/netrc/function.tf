@@ -0,0 +1,16 @@
+resource "repoflow_access_token" "agent" {
+  description = "Build agents"
+  scopes      = ["packages:read"]
+}
+
+# Cloud-init of the build agents, authenticating pip, curl and the go toolchain
+locals {
+  agent_user_data = <<-EOT
+    #cloud-config
+    write_files:
+      - path: /home/ci/.netrc
+        permissions: "0600"
+        content: |
+          ${indent(10, provider::repoflow::netrc("https://repoflow.example.com/api", "ci", repoflow_access_token.agent.token))}
+  EOT
+}
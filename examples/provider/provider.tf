@@ -2,3 +2,13 @@ provider "repoflow" {
   base_url = "https://repoflow.example/api"
   api_key  = "pat_xxx"
 }
+
+# Highly available instance behind regional endpoints
+provider "repoflow" {
+  alias = "ha"
+  base_urls = [
+    "https://eu.repoflow.example/api",
+    "https://us.repoflow.example/api",
+  ]
+  api_key = "pat_xxx"
+}
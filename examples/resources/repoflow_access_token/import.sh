@@ -0,0 +1,2 @@
+# Import the access token with its identifier, the token value is not known once imported
+terraform import repoflow_access_token.ci 00000000-0000-0000-0000-000000000000
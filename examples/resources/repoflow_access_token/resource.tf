@@ -0,0 +1,22 @@
+resource "repoflow_access_token" "ci" {
+  description = "CI pipeline publishing the npm packages"
+  scopes      = ["packages:read", "packages:write"]
+  expires_at  = "2027-01-01T00:00:00Z"
+}
+
+# Hand the token to the CI, e.g. as a masked variable
+output "ci_token" {
+  value     = repoflow_access_token.ci.token
+  sensitive = true
+}
+
+# Rotate the deployment token every quarter: bump the trigger, the new
+# token is issued and the previous one revoked in the same apply
+resource "repoflow_access_token" "deploy" {
+  description = "Deployment pulling the release artifacts"
+  scopes      = ["packages:read"]
+
+  rotation_triggers = {
+    quarter = "2026-Q4"
+  }
+}
@@ -0,0 +1,5 @@
+# Import the anonymous access of a workspace with the workspace name or identifier
+terraform import repoflow_anonymous_access.oss oss
+
+# Import the anonymous access of a repository with workspace/repository (names or identifiers)
+terraform import repoflow_anonymous_access.internal oss/internal
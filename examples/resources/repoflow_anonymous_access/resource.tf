@@ -0,0 +1,31 @@
+resource "repoflow_workspace" "oss" {
+  name = "oss"
+}
+
+resource "repoflow_repository" "charts" {
+  workspace       = repoflow_workspace.oss.id
+  name            = "charts"
+  repository_type = "local"
+  package_type    = "helm"
+}
+
+resource "repoflow_repository" "internal" {
+  workspace       = repoflow_workspace.oss.id
+  name            = "internal"
+  repository_type = "local"
+  package_type    = "helm"
+}
+
+# The public charts and images of the workspace can be pulled without credentials
+resource "repoflow_anonymous_access" "oss" {
+  workspace     = repoflow_workspace.oss.id
+  mode          = "read_only"
+  package_types = ["helm", "docker"]
+}
+
+# Except the internal charts
+resource "repoflow_anonymous_access" "internal" {
+  workspace  = repoflow_workspace.oss.id
+  repository = repoflow_repository.internal.repository_id
+  mode       = "disabled"
+}
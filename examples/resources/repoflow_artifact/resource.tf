@@ -0,0 +1,21 @@
+# Seed the internal CA bundle, uploaded again when the local file changes
+resource "repoflow_artifact" "ca_bundle" {
+  workspace  = "example"
+  repository = "bootstrap"
+  path       = "certs/internal-ca.pem"
+  source     = "${path.module}/files/internal-ca.pem"
+}
+
+# Checksum published in https://nodejs.org/dist/v22.11.0/SHASUMS256.txt
+variable "node_sha256" {
+  type = string
+}
+
+# Mirror an installer tarball, verified against its published checksum
+resource "repoflow_artifact" "node" {
+  workspace     = "example"
+  repository    = "bootstrap"
+  path          = "node/node-v22.11.0-linux-x64.tar.xz"
+  source_url    = "https://nodejs.org/dist/v22.11.0/node-v22.11.0-linux-x64.tar.xz"
+  source_sha256 = var.node_sha256
+}
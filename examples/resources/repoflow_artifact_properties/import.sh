@@ -0,0 +1,2 @@
+# Import the artifact properties with workspace/repository/path, workspace and repository can be names or identifiers
+terraform import repoflow_artifact_properties.example example/local-example/libs/acme-core-1.4.2.jar
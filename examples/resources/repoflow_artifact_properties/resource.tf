@@ -0,0 +1,10 @@
+resource "repoflow_artifact_properties" "example" {
+  workspace  = "example"
+  repository = "local-example"
+  path       = "libs/acme-core-1.4.2.jar"
+
+  properties = {
+    approved = "true"
+    build    = "1234"
+  }
+}
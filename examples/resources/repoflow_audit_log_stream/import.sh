@@ -0,0 +1,2 @@
+# Import the audit log stream with its identifier
+terraform import repoflow_audit_log_stream.siem 00000000-0000-0000-0000-000000000000
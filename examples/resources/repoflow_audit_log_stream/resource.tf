@@ -0,0 +1,24 @@
+variable "siem_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Archive every event in an S3 bucket, with the instance role
+resource "repoflow_audit_log_stream" "archive" {
+  name        = "compliance-archive"
+  sink_type   = "s3"
+  destination = "s3://example-audit-logs/repoflow"
+  region      = "eu-west-1"
+}
+
+# Send the security relevant events to the SIEM
+resource "repoflow_audit_log_stream" "siem" {
+  name              = "siem"
+  sink_type         = "https"
+  destination       = "https://siem.example.com/api/v1/ingest"
+  format            = "cef"
+  categories        = ["auth", "permission", "settings"]
+  secret_wo         = var.siem_token
+  secret_wo_version = 1
+}
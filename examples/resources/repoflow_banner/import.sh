@@ -0,0 +1,2 @@
+# Import the banner with its identifier
+terraform import repoflow_banner.storage_migration 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,7 @@
+resource "repoflow_banner" "storage_migration" {
+  title     = "Storage migration"
+  message   = "Uploads are disabled during the migration of the storage backend, downloads are not affected."
+  severity  = "warning"
+  starts_at = "2026-11-07T22:00:00Z"
+  ends_at   = "2026-11-08T02:00:00Z"
+}
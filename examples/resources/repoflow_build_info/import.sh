@@ -0,0 +1,2 @@
+# Import the build info with the workspace (name or Id), the build name and number
+terraform import repoflow_build_info.core example/acme-core/1234
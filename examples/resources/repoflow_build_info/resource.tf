@@ -0,0 +1,40 @@
+variable "run_number" {
+  type = string
+}
+
+resource "repoflow_repository" "releases" {
+  workspace       = "example"
+  name            = "maven-releases"
+  repository_type = "local"
+  package_type    = "maven"
+}
+
+resource "repoflow_artifact" "core" {
+  workspace  = "example"
+  repository = repoflow_repository.releases.repository_id
+  path       = "com/acme/acme-core/1.4.2/acme-core-1.4.2.jar"
+  source     = "${path.module}/target/acme-core-1.4.2.jar"
+}
+
+# Link the uploaded jar to the CI run and the commit it was built from
+resource "repoflow_build_info" "core" {
+  workspace  = "example"
+  name       = "acme-core"
+  number     = var.run_number
+  started_at = "2026-10-16T09:30:00Z"
+  url        = "https://github.com/acme/acme-core/actions/runs/${var.run_number}"
+
+  vcs {
+    url      = "https://github.com/acme/acme-core"
+    revision = "3b1f9c2e7d4a5b6c8e0f1a2b3c4d5e6f7a8b9c0d"
+    branch   = "main"
+  }
+
+  modules = [
+    {
+      id             = "com.acme:acme-core:1.4.2"
+      repository_id  = repoflow_repository.releases.repository_id
+      artifact_paths = [repoflow_artifact.core.path]
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Import the cleanup policy with the workspace (name or Id) and the policy Id
+terraform import repoflow_cleanup_policy.snapshots example/2f1e8c34-6a2b-4d7e-9c1a-0b5f3e7d9a12
@@ -0,0 +1,13 @@
+# Keep the 10 newest snapshots of each package, and the older ones for a month
+resource "repoflow_cleanup_policy" "snapshots" {
+  workspace      = "example"
+  name           = "maven-snapshots"
+  repository_ids = [repoflow_repository.maven_snapshots.repository_id]
+  patterns       = ["**/*-SNAPSHOT*"]
+  keep_last      = 10
+  max_age_days   = 30
+
+  # Every sunday at 2am, only report the artifacts to delete until reviewed
+  schedule = "0 2 * * 0"
+  dry_run  = true
+}
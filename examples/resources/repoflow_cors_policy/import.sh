@@ -0,0 +1,2 @@
+# Import the CORS policy with its target, api or registry
+terraform import repoflow_cors_policy.api api
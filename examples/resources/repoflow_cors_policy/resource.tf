@@ -0,0 +1,16 @@
+# Let the internal portal call the API with the session of the user
+resource "repoflow_cors_policy" "api" {
+  target            = "api"
+  allowed_origins   = ["https://portal.example.com"]
+  allowed_methods   = ["GET", "HEAD", "POST", "PUT", "DELETE"]
+  allowed_headers   = ["Authorization", "Content-Type"]
+  allow_credentials = true
+}
+
+# Let any web page download the public packages
+resource "repoflow_cors_policy" "registry" {
+  target          = "registry"
+  allowed_origins = ["*"]
+  exposed_headers = ["ETag", "Content-Length"]
+  max_age_seconds = 3600
+}
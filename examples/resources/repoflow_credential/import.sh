@@ -0,0 +1,2 @@
+# Import the credential with the workspace and the credential name or Id, the secrets are not known once imported
+terraform import repoflow_credential.artifactory example/artifactory
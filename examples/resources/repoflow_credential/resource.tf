@@ -0,0 +1,29 @@
+variable "artifactory_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_credential" "artifactory" {
+  workspace   = "example"
+  name        = "artifactory"
+  description = "Service account of the platform team on Artifactory"
+  username    = "repoflow-proxy"
+
+  # Bump the version to send a new password, e.g. after its rotation in the vault
+  password_wo      = var.artifactory_password
+  rotation_version = 1
+}
+
+# Every remote repository using the credential gets the new password on rotation
+resource "repoflow_repository" "maven_central" {
+  name            = "maven-central"
+  workspace       = "example"
+  repository_type = "remote"
+  package_type    = "maven"
+
+  remote {
+    url           = "https://artifactory.example/artifactory/maven-central"
+    credential_id = repoflow_credential.artifactory.credential_id
+  }
+}
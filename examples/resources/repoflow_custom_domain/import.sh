@@ -0,0 +1,2 @@
+# Import the custom domain with its identifier
+terraform import repoflow_custom_domain.example 00000000-0000-0000-0000-000000000000
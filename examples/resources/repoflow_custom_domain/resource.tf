@@ -0,0 +1,17 @@
+resource "repoflow_workspace" "example" {
+  name = "example"
+}
+
+resource "repoflow_custom_domain" "example" {
+  domain    = "docker.example.com"
+  workspace = repoflow_workspace.example.id
+}
+
+# Validate the domain
+resource "aws_route53_record" "verification" {
+  zone_id = "Z0123456789ABCDEFGHIJ"
+  name    = repoflow_custom_domain.example.verification_record_name
+  type    = repoflow_custom_domain.example.verification_record_type
+  ttl     = 300
+  records = [repoflow_custom_domain.example.verification_record_value]
+}
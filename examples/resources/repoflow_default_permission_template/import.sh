@@ -0,0 +1,2 @@
+# Import the template of a workspace with the workspace name or identifier
+terraform import repoflow_default_permission_template.team_x team-x
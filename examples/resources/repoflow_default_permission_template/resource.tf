@@ -0,0 +1,13 @@
+resource "repoflow_workspace" "team_x" {
+  name = "team-x"
+}
+
+# Every repository created in the workspace is writable by the team and
+# readable by the rest of engineering
+resource "repoflow_default_permission_template" "team_x" {
+  workspace = repoflow_workspace.team_x.id
+  grants = {
+    "group:team-x"      = "write"
+    "group:engineering" = "read"
+  }
+}
@@ -0,0 +1,2 @@
+# Import the exception with its identifier
+terraform import repoflow_exception.example 00000000-0000-0000-0000-000000000000
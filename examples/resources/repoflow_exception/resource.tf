@@ -0,0 +1,9 @@
+resource "repoflow_exception" "example" {
+  workspace    = "example"
+  package_type = "npm"
+  package      = "left-pad"
+  version      = "1.3.0"
+  reason       = "False positive reported upstream, fix expected in the next release"
+  approver     = "security-team"
+  expires_at   = "2026-12-31T00:00:00Z"
+}
@@ -0,0 +1,2 @@
+# Import the schedule with the garbage collection task
+terraform import repoflow_gc_schedule.blobs blobs
@@ -0,0 +1,12 @@
+# Collect the unreferenced files every sunday night, within the maintenance window
+resource "repoflow_gc_schedule" "blobs" {
+  task                 = "blobs"
+  schedule             = "0 1 * * 0"
+  concurrency          = 4
+  max_duration_minutes = 240
+}
+
+resource "repoflow_gc_schedule" "orphaned_metadata" {
+  task     = "orphaned_metadata"
+  schedule = "30 3 * * *"
+}
@@ -0,0 +1,2 @@
+# Import the immutable tag rule with the workspace, the repository (names or Ids) and the rule Id
+terraform import repoflow_immutable_tag_rule.releases example/docker-releases/6d2f8b4a-0c1e-4a7d-b3f5-8e2a4c6d9b17
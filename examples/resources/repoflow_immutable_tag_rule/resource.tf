@@ -0,0 +1,14 @@
+resource "repoflow_repository" "docker_releases" {
+  workspace       = "example"
+  name            = "docker-releases"
+  repository_type = "local"
+  package_type    = "docker"
+}
+
+# Release tags can never be overwritten, unlike latest or the branch tags
+resource "repoflow_immutable_tag_rule" "releases" {
+  workspace   = "example"
+  repository  = repoflow_repository.docker_releases.repository_id
+  tag_pattern = "^v\\d+\\.\\d+\\.\\d+$"
+  description = "Semantic version release tags"
+}
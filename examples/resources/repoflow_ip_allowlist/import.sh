@@ -0,0 +1,5 @@
+# Import the allowlist of the instance
+terraform import repoflow_ip_allowlist.instance instance
+
+# Import the allowlist of a workspace with the workspace name or identifier
+terraform import repoflow_ip_allowlist.finance finance
@@ -0,0 +1,15 @@
+# Only the corporate network and the CI runners reach the instance
+resource "repoflow_ip_allowlist" "instance" {
+  allow = ["10.0.0.0/8", "192.0.2.0/24", "2001:db8::/32"]
+  deny  = ["10.66.0.0/16"]
+}
+
+resource "repoflow_workspace" "finance" {
+  name = "finance"
+}
+
+# The finance workspace is only reachable from its own network
+resource "repoflow_ip_allowlist" "finance" {
+  workspace = repoflow_workspace.finance.id
+  allow     = ["10.20.0.0/16"]
+}
@@ -0,0 +1,2 @@
+# Import the label taxonomy with its key
+terraform import repoflow_label_taxonomy.environment environment
@@ -0,0 +1,10 @@
+resource "repoflow_label_taxonomy" "environment" {
+  key            = "environment"
+  description    = "Deployment environment owning the resource"
+  allowed_values = ["dev", "staging", "production"]
+}
+
+resource "repoflow_label_taxonomy" "team" {
+  key         = "team"
+  description = "Owning team, any value allowed"
+}
@@ -0,0 +1,2 @@
+# The LDAP config is unique on the instance, any identifier can be used
+terraform import repoflow_ldap_config.example ldap_config
@@ -0,0 +1,27 @@
+variable "ldap_bind_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_ldap_config" "example" {
+  server_url               = "ldaps://ldap.example.com:636"
+  bind_dn                  = "cn=repoflow,ou=services,dc=example,dc=com"
+  bind_password_wo         = var.ldap_bind_password
+  bind_password_wo_version = 1
+
+  user_search_base  = "ou=people,dc=example,dc=com"
+  group_search_base = "ou=groups,dc=example,dc=com"
+
+  group_mappings = {
+    "cn=platform,ou=groups,dc=example,dc=com" = "platform"
+    "cn=devs,ou=groups,dc=example,dc=com"     = "developers"
+  }
+}
+
+# Grant the mapped groups their roles
+resource "repoflow_role_assignment" "platform" {
+  workspace = "example"
+  principal = "group:platform"
+  role      = "admin"
+}
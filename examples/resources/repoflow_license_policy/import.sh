@@ -0,0 +1,5 @@
+# Import the license policy of a workspace with the workspace name or identifier
+terraform import repoflow_license_policy.platform platform
+
+# Import the license policy of a repository with workspace/repository (names or identifiers)
+terraform import repoflow_license_policy.npm_virtual platform/npm
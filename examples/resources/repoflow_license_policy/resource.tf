@@ -0,0 +1,40 @@
+resource "repoflow_workspace" "platform" {
+  name = "platform"
+}
+
+resource "repoflow_repository" "npm_remote" {
+  workspace       = repoflow_workspace.platform.id
+  name            = "npm-remote"
+  repository_type = "remote"
+  package_type    = "npm"
+
+  remote {
+    url = "https://registry.npmjs.org"
+  }
+}
+
+resource "repoflow_repository" "npm_virtual" {
+  workspace       = repoflow_workspace.platform.id
+  name            = "npm"
+  repository_type = "virtual"
+  package_type    = "npm"
+
+  virtual {
+    child_repository_ids = [repoflow_repository.npm_remote.repository_id]
+  }
+}
+
+# Report the strong copyleft licenses in the whole workspace
+resource "repoflow_license_policy" "platform" {
+  workspace       = repoflow_workspace.platform.id
+  denied_licenses = ["GPL-3.0-only", "GPL-3.0-or-later", "AGPL-3.0-only", "AGPL-3.0-or-later"]
+  action          = "warn"
+}
+
+# Block them in the npm repository used by the builds
+resource "repoflow_license_policy" "npm_virtual" {
+  workspace       = repoflow_workspace.platform.id
+  repository      = repoflow_repository.npm_virtual.repository_id
+  denied_licenses = ["GPL-3.0-only", "GPL-3.0-or-later", "AGPL-3.0-only", "AGPL-3.0-or-later"]
+  action          = "block"
+}
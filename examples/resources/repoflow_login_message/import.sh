@@ -0,0 +1,2 @@
+# The login message is unique on the instance, any identifier can be used
+terraform import repoflow_login_message.example login_message
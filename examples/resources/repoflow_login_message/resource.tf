@@ -0,0 +1,5 @@
+resource "repoflow_login_message" "example" {
+  title                   = "Authorized use only"
+  message                 = "Access to this registry is restricted to authorized personnel."
+  require_acknowledgement = true
+}
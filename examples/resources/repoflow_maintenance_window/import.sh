@@ -0,0 +1,2 @@
+# Import the maintenance window with its identifier
+terraform import repoflow_maintenance_window.weekend 00000000-0000-0000-0000-000000000000
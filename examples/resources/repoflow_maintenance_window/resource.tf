@@ -0,0 +1,16 @@
+# Run the garbage collection and the reindexing on the weekend nights only
+resource "repoflow_maintenance_window" "weekend" {
+  name             = "weekend-nights"
+  schedule         = "0 22 * * 5,6"
+  duration_minutes = 480
+  jobs             = ["gc", "reindex"]
+}
+
+# Also allow the weekday nights, closed during the release crunch
+resource "repoflow_maintenance_window" "release_freeze" {
+  name             = "weekday-nights"
+  schedule         = "0 1 * * 1-4"
+  duration_minutes = 240
+  jobs             = ["gc", "reindex", "cleanup"]
+  enabled          = false
+}
@@ -0,0 +1,2 @@
+# Import the feed subscription with its identifier, the API key must be set again on the next apply
+terraform import repoflow_malware_feed_subscription.example 00000000-0000-0000-0000-000000000000
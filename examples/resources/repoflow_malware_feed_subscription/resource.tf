@@ -0,0 +1,12 @@
+variable "malware_feed_api_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_malware_feed_subscription" "example" {
+  feed               = "socket"
+  api_key_wo         = var.malware_feed_api_key
+  api_key_wo_version = 1
+  action             = "block"
+}
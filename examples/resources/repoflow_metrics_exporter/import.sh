@@ -0,0 +1,2 @@
+# The metrics exporter is unique on the instance, any identifier can be used
+terraform import repoflow_metrics_exporter.example metrics_exporter
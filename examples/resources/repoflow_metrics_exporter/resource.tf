@@ -0,0 +1,17 @@
+variable "metrics_scrape_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_metrics_exporter" "example" {
+  metric_groups = ["http", "storage", "jobs"]
+
+  scrape_token_wo         = var.metrics_scrape_token
+  scrape_token_wo_version = 1
+}
+
+# Give the endpoint to the Prometheus scrape configuration
+output "metrics_endpoint_url" {
+  value = repoflow_metrics_exporter.example.endpoint_url
+}
@@ -0,0 +1,2 @@
+# The MFA enforcement is unique on the instance, any identifier can be used
+terraform import repoflow_mfa_enforcement.example mfa_enforcement
@@ -0,0 +1,9 @@
+# Start with the administrators, giving them a week to enroll a second factor
+resource "repoflow_mfa_enforcement" "example" {
+  scope             = "groups"
+  groups            = ["platform", "security"]
+  grace_period_days = 7
+
+  # The break-glass account keeps logging in with its password only
+  exempt_principals = ["user:break-glass"]
+}
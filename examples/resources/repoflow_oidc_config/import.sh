@@ -0,0 +1,2 @@
+# The OIDC config is unique on the instance, any identifier can be used
+terraform import repoflow_oidc_config.example oidc_config
@@ -0,0 +1,25 @@
+variable "oidc_client_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_oidc_config" "example" {
+  issuer_url               = "https://login.example.com/realms/main"
+  client_id                = "repoflow"
+  client_secret_wo         = var.oidc_client_secret
+  client_secret_wo_version = 1
+
+  scopes       = ["openid", "profile", "email", "groups"]
+  groups_claim = "groups"
+
+  group_mappings = {
+    "platform-team" = "platform"
+    "engineering"   = "developers"
+  }
+}
+
+# Callback URL to register on the identity provider
+output "oidc_redirect_uri" {
+  value = repoflow_oidc_config.example.redirect_uri
+}
@@ -0,0 +1,2 @@
+# The outbound proxy is unique on the instance, any identifier can be used
+terraform import repoflow_outbound_proxy.example outbound_proxy
@@ -0,0 +1,19 @@
+variable "proxy_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_outbound_proxy" "example" {
+  http_proxy  = "http://proxy.example.com:3128"
+  https_proxy = "http://proxy.example.com:3128"
+
+  no_proxy = [
+    ".example.com",
+    "10.0.0.0/8",
+  ]
+
+  username            = "repoflow"
+  password_wo         = var.proxy_password
+  password_wo_version = 1
+}
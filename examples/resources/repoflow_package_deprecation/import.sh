@@ -0,0 +1,2 @@
+# Import the package deprecation with workspace/repository/deprecationId, workspace and repository can be names or identifiers
+terraform import repoflow_package_deprecation.example example/local-example/00000000-0000-0000-0000-000000000000
@@ -0,0 +1,7 @@
+resource "repoflow_package_deprecation" "example" {
+  workspace  = "example"
+  repository = "local-example"
+  package    = "@acme/http-client"
+  version    = "<2.0.0"
+  message    = "@acme/http-client 1.x is no longer maintained, upgrade to 2.x"
+}
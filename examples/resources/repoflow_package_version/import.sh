@@ -0,0 +1,2 @@
+# Import the package version with workspace/repository/package/version, workspace and repository can be names or identifiers
+terraform import 'repoflow_package_version.golden["@acme/ui"]' example/local-example/@acme/ui/4.2.0
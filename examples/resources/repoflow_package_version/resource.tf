@@ -0,0 +1,21 @@
+# Golden set of packages approved for the production builds
+locals {
+  golden_packages = {
+    "@acme/ui"          = "4.2.0"
+    "@acme/http-client" = "2.1.3"
+  }
+}
+
+resource "repoflow_package_version" "golden" {
+  for_each = local.golden_packages
+
+  workspace  = "example"
+  repository = "local-example"
+  package    = each.key
+  version    = each.value
+
+  properties = {
+    approved = "true"
+    channel  = "golden"
+  }
+}
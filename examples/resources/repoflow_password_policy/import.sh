@@ -0,0 +1,2 @@
+# The password policy is unique on the instance, any identifier can be used
+terraform import repoflow_password_policy.example password_policy
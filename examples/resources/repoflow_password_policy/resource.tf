@@ -0,0 +1,14 @@
+resource "repoflow_password_policy" "example" {
+  min_length        = 14
+  require_uppercase = true
+  require_lowercase = true
+  require_digit     = true
+  require_symbol    = true
+
+  # Rotate the passwords every quarter
+  max_age_days = 90
+
+  # Lock the account 30 minutes after 5 failed logins
+  lockout_threshold        = 5
+  lockout_duration_minutes = 30
+}
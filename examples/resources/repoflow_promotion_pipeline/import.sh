@@ -0,0 +1,2 @@
+# Import the promotion pipeline with the workspace (name or Id) and the pipeline Id
+terraform import repoflow_promotion_pipeline.releases example/9a1c5e3b-7d2f-4b8e-a6c0-4f2d8b1e6a93
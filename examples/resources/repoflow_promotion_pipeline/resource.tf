@@ -0,0 +1,34 @@
+resource "repoflow_promotion_pipeline" "releases" {
+  workspace = "example"
+  name      = "releases"
+
+  stages = [
+    {
+      # The CI uploads the builds here
+      name          = "dev"
+      repository_id = repoflow_repository.dev.repository_id
+    },
+    {
+      name          = "staging"
+      repository_id = repoflow_repository.staging.repository_id
+      required_properties = {
+        tests = "passed"
+      }
+    },
+    {
+      name          = "release"
+      repository_id = repoflow_repository.release.repository_id
+      required_properties = {
+        tests = "passed"
+        qa    = "approved"
+      }
+      required_approvals = 1
+      approvers          = ["group:release-managers"]
+    },
+  ]
+}
+
+# Pass the pipeline Id to the CI calling the promotion
+output "releases_pipeline_id" {
+  value = repoflow_promotion_pipeline.releases.pipeline_id
+}
@@ -0,0 +1,2 @@
+# Import the push mirror with the workspace, the repository (names or Ids) and the push mirror Id, the password is not known once imported
+terraform import repoflow_push_mirror.ecr example/docker-releases/e3a5c7d9-1b3f-4d5a-8c7e-9f1b3d5a7c21
@@ -0,0 +1,26 @@
+variable "ecr_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# Push the released images to ECR every night
+resource "repoflow_push_mirror" "ecr" {
+  workspace  = "example"
+  repository = "docker-releases"
+  url        = "https://123456789012.dkr.ecr.eu-west-1.amazonaws.com/team"
+  schedule   = "0 2 * * *"
+
+  # Bump the version to send a new password, e.g. from `aws ecr get-login-password`
+  username            = "AWS"
+  password_wo         = var.ecr_password
+  password_wo_version = 1
+}
+
+# Or authenticate with a credential of the workspace
+resource "repoflow_push_mirror" "harbor" {
+  workspace     = "example"
+  repository    = "docker-releases"
+  url           = "https://harbor.example/team"
+  credential_id = repoflow_credential.harbor.credential_id
+}
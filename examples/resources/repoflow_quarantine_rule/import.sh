@@ -0,0 +1,2 @@
+# Import the quarantine rule with the workspace (name or Id) and the rule Id
+terraform import repoflow_quarantine_rule.proxies example/2e9b4f61-8c3a-4d7e-b1f5-6a0c9d3e8b42
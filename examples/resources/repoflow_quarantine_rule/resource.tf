@@ -0,0 +1,19 @@
+# Hold the new upstream versions for three days before they can be downloaded
+resource "repoflow_quarantine_rule" "proxies" {
+  workspace = "example"
+  name      = "proxies"
+  repository_ids = [
+    repoflow_repository.npmjs.repository_id,
+    repoflow_repository.pypi.repository_id,
+  ]
+  quarantine_hours = 72
+}
+
+# A longer quarantine for the packages most targeted by typosquatting
+resource "repoflow_quarantine_rule" "sensitive" {
+  workspace        = "example"
+  name             = "sensitive"
+  repository_ids   = [repoflow_repository.npmjs.repository_id]
+  package_patterns = ["react*", "lodash*", "@types/*"]
+  quarantine_hours = 7 * 24
+}
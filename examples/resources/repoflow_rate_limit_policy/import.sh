@@ -0,0 +1,2 @@
+# Import the rate limit policy with its identifier
+terraform import repoflow_rate_limit_policy.ci 00000000-0000-0000-0000-000000000000
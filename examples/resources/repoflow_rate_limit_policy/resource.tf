@@ -0,0 +1,15 @@
+# Each CI token gets 600 requests per minute, with bursts when a build starts
+resource "repoflow_rate_limit_policy" "ci" {
+  name                = "ci-tokens"
+  scope               = "token"
+  requests_per_minute = 600
+  burst               = 200
+}
+
+# Cap the download bandwidth of the interns to 10 MB/s each
+resource "repoflow_rate_limit_policy" "interns" {
+  name                       = "interns"
+  scope                      = "user"
+  subjects                   = ["group:interns"]
+  bandwidth_bytes_per_second = 10485760
+}
@@ -0,0 +1,2 @@
+# Import the stack with the workspace name or identifier and the name of its virtual repository
+terraform import repoflow_registry_stack.npm platform/npm
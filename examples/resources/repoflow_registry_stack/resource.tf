@@ -0,0 +1,16 @@
+resource "repoflow_workspace" "platform" {
+  name = "platform"
+}
+
+# npm serves the internal packages of npm-local and proxies the public
+# registry through npm-remote, the publications go to npm-local
+resource "repoflow_registry_stack" "npm" {
+  workspace    = repoflow_workspace.platform.id
+  name         = "npm"
+  package_type = "npm"
+  upstream_url = "https://registry.npmjs.org"
+}
+
+output "npm_registry" {
+  value = repoflow_registry_stack.npm.url
+}
@@ -1,3 +1,15 @@
+variable "npm_registry_password" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+variable "gitlab_token" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
 resource "repoflow_workspace" "example" {
   name = "example"
 }
@@ -7,20 +19,92 @@ resource "repoflow_repository" "local" {
   workspace       = repoflow_workspace.example.id
   repository_type = "local"
   package_type    = "npm"
+
+  labels = {
+    team = "frontend"
+  }
 }
 
 resource "repoflow_repository" "virtual" {
-  name                 = "virtual-example"
-  workspace            = repoflow_workspace.example.id
-  repository_type      = "virtual"
-  package_type         = "npm"
-  child_repository_ids = [repoflow_repository.local.repository_id]
+  name            = "virtual-example"
+  workspace       = repoflow_workspace.example.id
+  repository_type = "virtual"
+  package_type    = "npm"
+
+  virtual {
+    child_repository_ids = [
+      repoflow_repository.local.repository_id,
+      repoflow_repository.remote.repository_id,
+    ]
+
+    # Route uploads to the local child serving their scope
+    deployment_policy = "by_scope"
+
+    # Only serve the internal scope from the local child, and never from the upstream
+    members = [
+      {
+        repository_id    = repoflow_repository.local.repository_id
+        include_patterns = ["@acme/*"]
+      },
+      {
+        repository_id    = repoflow_repository.remote.repository_id
+        exclude_patterns = ["@acme/*"]
+      },
+    ]
+  }
 }
 
 resource "repoflow_repository" "remote" {
-  name                  = "remote-example"
-  workspace             = repoflow_workspace.example.id
-  repository_type       = "remote"
-  package_type          = "npm"
-  remote_repository_url = "https://registry.npmjs.org"
+  name            = "remote-example"
+  workspace       = repoflow_workspace.example.id
+  repository_type = "remote"
+  package_type    = "npm"
+
+  remote {
+    url                 = "https://registry.npmjs.org"
+    username            = "proxy"
+    password_wo         = var.npm_registry_password
+    password_wo_version = 1
+  }
+}
+
+resource "repoflow_repository" "gitlab" {
+  name            = "gitlab-example"
+  workspace       = repoflow_workspace.example.id
+  repository_type = "remote"
+  package_type    = "npm"
+
+  # Upstreams authenticating with a custom header
+  remote {
+    url                     = "https://gitlab.example.com/api/v4/packages/npm"
+    header_name             = "PRIVATE-TOKEN"
+    header_value_wo         = var.gitlab_token
+    header_value_wo_version = 1
+  }
+}
+
+resource "repoflow_repository" "conan" {
+  name            = "conan-example"
+  workspace       = repoflow_workspace.example.id
+  repository_type = "local"
+  package_type    = "conan"
+
+  conan {
+    revisions_enabled = true
+  }
+}
+
+resource "repoflow_repository" "oci" {
+  name            = "oci-example"
+  workspace       = repoflow_workspace.example.id
+  repository_type = "local"
+  package_type    = "oci"
+
+  # Only accept Helm charts and WebAssembly modules
+  oci {
+    allowed_media_types = [
+      "application/vnd.cncf.helm.chart.content.v1.tar+gzip",
+      "application/vnd.wasm.content.layer.v1+wasm",
+    ]
+  }
 }
@@ -0,0 +1,2 @@
+# Import the permission with workspace/repository/principal, workspace and repository can be names or identifiers
+terraform import repoflow_repository_permission.developers example/local-example/group:developers
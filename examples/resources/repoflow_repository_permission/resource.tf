@@ -0,0 +1,13 @@
+resource "repoflow_repository_permission" "developers" {
+  workspace  = "example"
+  repository = "local-example"
+  principal  = "group:developers"
+  role       = "write"
+}
+
+resource "repoflow_repository_permission" "ci" {
+  workspace  = "example"
+  repository = "local-example"
+  principal  = "user:ci-bot"
+  role       = "read"
+}
@@ -0,0 +1,3 @@
+# Import the set with the workspace name or identifier and the name of the set,
+# every package type with a complete local, remote and virtual trio is found
+terraform import repoflow_repository_set.payments payments/payments
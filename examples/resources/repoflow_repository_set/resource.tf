@@ -0,0 +1,26 @@
+resource "repoflow_workspace" "payments" {
+  name = "payments"
+}
+
+# payments-npm, payments-pypi and payments-maven, each serving a local
+# repository for the team packages and a proxy of the public registry
+resource "repoflow_repository_set" "payments" {
+  workspace = repoflow_workspace.payments.id
+  name      = "payments"
+
+  packages = {
+    npm = {
+      remote_url = "https://registry.npmjs.org"
+    }
+    pypi = {
+      remote_url = "https://pypi.org"
+    }
+    maven = {
+      remote_url = "https://repo.maven.apache.org/maven2"
+    }
+  }
+}
+
+output "npm_registry" {
+  value = repoflow_repository_set.payments.packages["npm"].url
+}
@@ -0,0 +1,2 @@
+# Import the webhook with the workspace, the repository and the webhook Id
+terraform import repoflow_repository_webhook.releases example/npm-releases/00000000-0000-0000-0000-000000000000
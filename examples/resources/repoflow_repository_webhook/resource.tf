@@ -0,0 +1,16 @@
+variable "webhook_secret" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+resource "repoflow_repository_webhook" "releases" {
+  workspace  = "example"
+  repository = "npm-releases"
+  url        = "https://ci.example/hooks/repoflow"
+  events     = ["artifact.uploaded", "artifact.deleted"]
+
+  # Bump the version to send a new secret
+  secret_wo         = var.webhook_secret
+  secret_wo_version = 1
+}
@@ -0,0 +1,2 @@
+# Import the retention policy with the workspace (name or Id) and the policy Id
+terraform import repoflow_retention_policy.proxies example/7c4d2a91-3e8f-4b6a-a5d0-1f9e2c8b7d34
@@ -0,0 +1,13 @@
+# Purge the cached artifacts not downloaded for 90 days, they are fetched again on demand
+resource "repoflow_retention_policy" "proxies" {
+  workspace = "example"
+  name      = "proxies"
+  repository_ids = [
+    repoflow_repository.maven_central.repository_id,
+    repoflow_repository.npmjs.repository_id,
+  ]
+  unused_days = 90
+
+  # Every saturday at 1am
+  schedule = "0 1 * * 6"
+}
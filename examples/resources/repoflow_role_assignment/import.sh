@@ -0,0 +1,2 @@
+# Import the role assignment with its identifier
+terraform import repoflow_role_assignment.platform 00000000-0000-0000-0000-000000000000
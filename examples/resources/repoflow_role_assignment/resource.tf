@@ -0,0 +1,14 @@
+# Workspace scope
+resource "repoflow_role_assignment" "platform" {
+  workspace = "example"
+  principal = "group:platform"
+  role      = "admin"
+}
+
+# Repository scope
+resource "repoflow_role_assignment" "release" {
+  workspace  = "example"
+  repository = "local-example"
+  principal  = "user:release-bot"
+  role       = "publisher"
+}
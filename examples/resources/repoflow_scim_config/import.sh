@@ -0,0 +1,2 @@
+# The SCIM config is unique on the instance, any identifier can be used
+terraform import repoflow_scim_config.example scim_config
@@ -0,0 +1,14 @@
+ephemeral "random_password" "scim" {
+  length  = 48
+  special = false
+}
+
+resource "repoflow_scim_config" "example" {
+  token_wo         = ephemeral.random_password.scim.result
+  token_wo_version = 1
+}
+
+# Tenant URL of the provisioning settings of the identity provider
+output "scim_base_url" {
+  value = repoflow_scim_config.example.base_url
+}
@@ -0,0 +1,5 @@
+# Import the scan policy of a workspace with the workspace name or identifier
+terraform import repoflow_security_scan_policy.platform platform
+
+# Import the scan policy of a repository with workspace/repository (names or identifiers)
+terraform import repoflow_security_scan_policy.npm_remote platform/npm-remote
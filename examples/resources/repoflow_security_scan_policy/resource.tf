@@ -0,0 +1,28 @@
+resource "repoflow_workspace" "platform" {
+  name = "platform"
+}
+
+resource "repoflow_repository" "npm_remote" {
+  workspace       = repoflow_workspace.platform.id
+  name            = "npm-remote"
+  repository_type = "remote"
+  package_type    = "npm"
+
+  remote {
+    url = "https://registry.npmjs.org"
+  }
+}
+
+# Block the critical vulnerabilities in the whole workspace
+resource "repoflow_security_scan_policy" "platform" {
+  workspace      = repoflow_workspace.platform.id
+  block_severity = "critical"
+}
+
+# Stricter on the packages coming from the public registry, nothing unscanned is served
+resource "repoflow_security_scan_policy" "npm_remote" {
+  workspace      = repoflow_workspace.platform.id
+  repository     = repoflow_repository.npm_remote.repository_id
+  block_severity = "high"
+  fail_mode      = "closed"
+}
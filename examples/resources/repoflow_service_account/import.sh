@@ -0,0 +1,2 @@
+# Import the service account with its identifier, the token values are not known once imported
+terraform import repoflow_service_account.ci 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,19 @@
+resource "repoflow_service_account" "ci" {
+  name        = "ci-publisher"
+  description = "CI pipelines publishing the npm packages"
+  scopes      = ["packages:read", "packages:write"]
+
+  # One token per pipeline, revoke one by removing it
+  tokens = {
+    frontend = {
+      expires_at = "2027-01-01T00:00:00Z"
+    }
+    backend = {}
+  }
+}
+
+# Hand each token to its pipeline, e.g. as a masked variable
+output "frontend_token" {
+  value     = repoflow_service_account.ci.tokens["frontend"].token
+  sensitive = true
+}
@@ -0,0 +1,2 @@
+# The session policy is unique on the instance, any identifier can be used
+terraform import repoflow_session_policy.example session_policy
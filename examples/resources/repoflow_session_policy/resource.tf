@@ -0,0 +1,8 @@
+resource "repoflow_session_policy" "example" {
+  # Log in again every working day, and after 15 minutes away
+  max_session_age_hours = 12
+  idle_timeout_minutes  = 15
+
+  # Rotate the access tokens at least once a year
+  max_token_ttl_days = 365
+}
@@ -0,0 +1,2 @@
+# Import the signing key with workspace/signingKeyId, workspace can be a name or an identifier
+terraform import repoflow_signing_key.generated example/00000000-0000-0000-0000-000000000000
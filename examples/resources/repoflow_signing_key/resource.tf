@@ -0,0 +1,27 @@
+resource "repoflow_repository" "debian" {
+  name            = "debian-internal"
+  workspace       = "example"
+  repository_type = "local"
+  package_type    = "debian"
+}
+
+# Key generated by RepoFlow
+resource "repoflow_signing_key" "generated" {
+  workspace      = "example"
+  name           = "apt-signing"
+  algorithm      = "ed25519"
+  repository_ids = [repoflow_repository.debian.repository_id]
+}
+
+# Existing key uploaded from a secret store
+resource "repoflow_signing_key" "uploaded" {
+  workspace              = "example"
+  name                   = "rpm-signing"
+  private_key_wo         = var.rpm_signing_private_key
+  private_key_wo_version = 1
+  passphrase_wo          = var.rpm_signing_passphrase
+}
+
+output "apt_public_key" {
+  value = repoflow_signing_key.generated.public_key
+}
@@ -0,0 +1,2 @@
+# Import the storage backend with its identifier
+terraform import repoflow_storage_backend.s3 00000000-0000-0000-0000-000000000000
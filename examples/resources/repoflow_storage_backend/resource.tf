@@ -0,0 +1,45 @@
+variable "gcs_service_account_key" {
+  type      = string
+  sensitive = true
+  ephemeral = true
+}
+
+# S3 bucket, with the instance role
+resource "repoflow_storage_backend" "s3" {
+  name   = "s3-eu-west-1"
+  type   = "s3"
+  bucket = "example-repoflow-packages"
+  region = "eu-west-1"
+  path   = "packages"
+}
+
+# GCS bucket, with a service account key
+resource "repoflow_storage_backend" "gcs" {
+  name                   = "gcs-europe"
+  type                   = "gcs"
+  bucket                 = "example-repoflow-packages"
+  credentials_wo         = var.gcs_service_account_key
+  credentials_wo_version = 1
+}
+
+# Local disk of the instance
+resource "repoflow_storage_backend" "disk" {
+  name = "local-disk"
+  type = "filesystem"
+  path = "/var/lib/repoflow/packages"
+}
+
+# Store the packages of the workspace on S3
+resource "repoflow_workspace" "platform" {
+  name               = "platform"
+  storage_backend_id = repoflow_storage_backend.s3.id
+}
+
+# Except the Docker images, kept on GCS
+resource "repoflow_repository" "images" {
+  workspace          = repoflow_workspace.platform.id
+  name               = "images"
+  repository_type    = "local"
+  package_type       = "docker"
+  storage_backend_id = repoflow_storage_backend.gcs.id
+}
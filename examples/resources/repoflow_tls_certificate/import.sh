@@ -0,0 +1,2 @@
+# Import the certificate with its identifier, the private key must be set again on the next apply
+terraform import repoflow_tls_certificate.example 00000000-0000-0000-0000-000000000000
@@ -0,0 +1,19 @@
+resource "repoflow_tls_certificate" "example" {
+  name                   = "npm-example-com"
+  certificate            = file("${path.module}/npm.example.com.pem")
+  private_key_wo         = file("${path.module}/npm.example.com.key")
+  private_key_wo_version = 1
+}
+
+resource "repoflow_custom_domain" "example" {
+  domain         = "npm.example.com"
+  certificate_id = repoflow_tls_certificate.example.id
+}
+
+# Warn on every plan once the certificate expires within 30 days
+check "certificate_expiry" {
+  assert {
+    condition     = timecmp(repoflow_tls_certificate.example.expires_at, timeadd(plantimestamp(), "720h")) > 0
+    error_message = "The certificate of npm.example.com expires on ${repoflow_tls_certificate.example.expires_at}, rotate it."
+  }
+}
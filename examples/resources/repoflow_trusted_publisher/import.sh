@@ -0,0 +1,2 @@
+# Import the trusted publisher with the workspace, the repository (names or Ids) and the publisher Id
+terraform import repoflow_trusted_publisher.github_release example/npm-releases/9c4e2a6f-3b1d-4f8a-a5c7-2e9d4b6f8a13
@@ -0,0 +1,21 @@
+resource "repoflow_repository" "npm_releases" {
+  workspace       = "example"
+  name            = "npm-releases"
+  repository_type = "local"
+  package_type    = "npm"
+}
+
+# Let the release workflow of acme/app publish the tags, from the release environment only
+resource "repoflow_trusted_publisher" "github_release" {
+  workspace        = "example"
+  repository       = repoflow_repository.npm_releases.repository_id
+  issuer           = "https://token.actions.githubusercontent.com"
+  subject_patterns = ["repo:acme/app:environment:release"]
+
+  required_claims = {
+    repository_owner = "acme"
+    workflow_ref     = "acme/app/.github/workflows/release.yml@refs/heads/main"
+  }
+
+  description = "GitHub Actions release workflow of acme/app"
+}
@@ -0,0 +1,5 @@
+# Import the upload policy of a workspace with the workspace name or identifier
+terraform import repoflow_upload_policy.platform platform
+
+# Import the upload policy of a repository with workspace/repository (names or identifiers)
+terraform import repoflow_upload_policy.maven_releases platform/maven-releases
@@ -0,0 +1,26 @@
+resource "repoflow_workspace" "platform" {
+  name = "platform"
+}
+
+resource "repoflow_repository" "maven_releases" {
+  workspace       = repoflow_workspace.platform.id
+  name            = "maven-releases"
+  repository_type = "local"
+  package_type    = "maven"
+}
+
+# Limit the size of the artifacts in the whole workspace
+resource "repoflow_upload_policy" "platform" {
+  workspace               = repoflow_workspace.platform.id
+  max_artifact_size_bytes = 2147483648 # 2 GiB
+}
+
+# Only accept checked Maven artifacts without snapshots in the releases repository
+resource "repoflow_upload_policy" "maven_releases" {
+  workspace               = repoflow_workspace.platform.id
+  repository              = repoflow_repository.maven_releases.repository_id
+  max_artifact_size_bytes = 524288000 # 500 MiB
+  allowed_extensions      = ["jar", "pom", "module", "asc", "sha1", "sha256", "md5"]
+  require_checksum        = true
+  blocked_path_patterns   = ["**/*-SNAPSHOT*"]
+}
@@ -0,0 +1,2 @@
+# Import the vulnerability allowlist entry with its identifier
+terraform import repoflow_vulnerability_allowlist.example 00000000-0000-0000-0000-000000000000
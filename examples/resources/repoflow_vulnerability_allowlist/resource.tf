@@ -0,0 +1,17 @@
+resource "repoflow_workspace" "platform" {
+  name = "platform"
+}
+
+resource "repoflow_security_scan_policy" "platform" {
+  workspace      = repoflow_workspace.platform.id
+  block_severity = "high"
+}
+
+# The vulnerable code path is not used by the services of the workspace
+resource "repoflow_vulnerability_allowlist" "example" {
+  vulnerability_id = "CVE-2024-3094"
+  workspace        = repoflow_workspace.platform.id
+  package          = "xz-utils"
+  justification    = "Only the liblzma decoder is used, the backdoored sshd path is not reachable"
+  expires_at       = "2026-12-31T00:00:00Z"
+}
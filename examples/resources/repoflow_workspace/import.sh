@@ -1,3 +1,3 @@
-# Import the workspace with the name
+# Import the workspace with its name or identifier
 # All workspaces name are available on https://repoflow.example/api/1/workspaces
 terraform import repoflow_workspace.example example
@@ -1,3 +1,28 @@
 resource "repoflow_workspace" "example" {
-  name = "example"
+  name        = "example"
+  description = "Packages of the example team"
+
+  # 50 GiB
+  storage_quota_bytes = 53687091200
+
+  # Inherited by the remote repositories not setting their own cache settings
+  default_remote_cache_enabled = true
+  default_file_cache_ttl       = 86400000
+  default_metadata_cache_ttl   = 600000
+
+  default_member_role  = "viewer"
+  audit_retention_days = 365
+  deletion_protection  = true
+
+  labels = {
+    team        = "example"
+    environment = "production"
+  }
+}
+
+resource "repoflow_workspace" "sandbox" {
+  name = "sandbox"
+
+  # Delete the repositories of the workspace on destroy
+  force_destroy = true
 }
@@ -0,0 +1,2 @@
+# Import the settings of a workspace with the workspace name or identifier
+terraform import repoflow_workspace_settings.frontend frontend
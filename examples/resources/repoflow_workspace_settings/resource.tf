@@ -0,0 +1,12 @@
+resource "repoflow_workspace" "frontend" {
+  name = "frontend"
+}
+
+# The frontend teams only publish JavaScript and container images, named
+# after the package type, and the unused upstream packages go after 90 days
+resource "repoflow_workspace_settings" "frontend" {
+  workspace                     = repoflow_workspace.frontend.id
+  allowed_package_types         = ["npm", "docker"]
+  repository_name_pattern       = "^(npm|docker)-[a-z0-9-]+$"
+  default_retention_unused_days = 90
+}
@@ -0,0 +1,27 @@
+# Module under test: a workspace with a maven proxy
+terraform {
+  required_providers {
+    repoflow = {
+      source = "fe80/repoflow"
+    }
+  }
+}
+
+resource "repoflow_workspace" "team" {
+  name = "team"
+}
+
+resource "repoflow_repository" "maven_central" {
+  name            = "maven-central"
+  workspace       = repoflow_workspace.team.id
+  repository_type = "remote"
+  package_type    = "maven"
+
+  remote {
+    url = "https://repo.maven.apache.org/maven2"
+  }
+}
+
+output "repository_id" {
+  value = repoflow_repository.maven_central.repository_id
+}
@@ -0,0 +1,31 @@
+# Run with `terraform test`, no RepoFlow instance nor API key needed
+mock_provider "repoflow" {
+  source = "./mocks"
+}
+
+run "proxy" {
+  assert {
+    condition     = repoflow_repository.maven_central.workspace == repoflow_workspace.team.id
+    error_message = "The proxy must be created in the team workspace"
+  }
+
+  assert {
+    condition     = can(regex("^[0-9a-f-]{36}$", output.repository_id))
+    error_message = "The repository Id must be an UUID"
+  }
+}
+
+run "distinct_ids" {
+  # The mock values are the same for every object of a kind, override one when a test needs distinct Ids
+  override_resource {
+    target = repoflow_workspace.team
+    values = {
+      id = "5a7c9e1b-3d5f-4a7c-9e1b-3d5f7a9c1e2d"
+    }
+  }
+
+  assert {
+    condition     = repoflow_repository.maven_central.workspace == "5a7c9e1b-3d5f-4a7c-9e1b-3d5f7a9c1e2d"
+    error_message = "The proxy must be created in the team workspace"
+  }
+}
@@ -0,0 +1,474 @@
+# Mock values of the computed attributes of the repoflow provider, for the
+# `mock_provider` blocks of `terraform test`. They look like the values
+# returned by a RepoFlow instance, so the module outputs and the assertions
+# on ids, URLs and dates read like the real ones.
+#
+# Every object of a kind gets the same values, override them in a test with
+# `override_resource` or `override_data` when a module needs distinct ids.
+
+mock_resource "repoflow_workspace" {
+  defaults = {
+    id                 = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91"
+    managed_by         = "terraform"
+    storage_used_bytes = 0
+  }
+}
+
+mock_resource "repoflow_repository" {
+  defaults = {
+    id            = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f"
+    repository_id      = "8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f"
+    managed_by         = "terraform"
+    storage_backend_id = null
+  }
+}
+
+mock_resource "repoflow_repository_permission" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/group:developers"
+  }
+}
+
+mock_resource "repoflow_repository_webhook" {
+  defaults = {
+    id         = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/5e1b7c3d-9a2f-4d6e-8b0c-3f5a7d9e1b24"
+    webhook_id = "5e1b7c3d-9a2f-4d6e-8b0c-3f5a7d9e1b24"
+  }
+}
+
+mock_resource "repoflow_push_mirror" {
+  defaults = {
+    id             = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/e3a5c7d9-1b3f-4d5a-8c7e-9f1b3d5a7c21"
+    push_mirror_id = "e3a5c7d9-1b3f-4d5a-8c7e-9f1b3d5a7c21"
+    last_synced_at = null
+  }
+}
+
+mock_resource "repoflow_artifact" {
+  defaults = {
+    id         = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/certs/internal-ca.pem"
+    sha256     = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+    sha1       = "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+    md5        = "d41d8cd98f00b204e9800998ecf8427e"
+    size_bytes = 0
+  }
+}
+
+mock_resource "repoflow_signing_key" {
+  defaults = {
+    id             = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/e1b7c3d9-2f4a-4c6e-8a0b-5d7f9e1c3a24"
+    signing_key_id = "e1b7c3d9-2f4a-4c6e-8a0b-5d7f9e1c3a24"
+    algorithm      = "rsa4096"
+    fingerprint    = "0123456789ABCDEF0123456789ABCDEF01234567"
+    public_key     = "-----BEGIN PGP PUBLIC KEY BLOCK-----\n\nmock\n-----END PGP PUBLIC KEY BLOCK-----\n"
+    generated      = true
+    created_at     = "2026-01-15T09:30:00Z"
+  }
+}
+
+mock_resource "repoflow_artifact_properties" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/libs/acme-core-1.4.2.jar"
+  }
+}
+
+mock_resource "repoflow_package_version" {
+  defaults = {
+    id         = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/@acme/ui/4.2.0"
+    properties = {}
+    size_bytes = 48213
+    created_at = "2026-01-15T09:30:00Z"
+  }
+}
+
+mock_resource "repoflow_package_deprecation" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/c4e8a2b6-7d1f-4a3c-b5e9-6f0d2b4a8c13"
+  }
+}
+
+mock_resource "repoflow_credential" {
+  defaults = {
+    id            = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/9d3f5b7a-2e4c-4f6b-a8d0-1c3e5a7b9f26"
+    credential_id = "9d3f5b7a-2e4c-4f6b-a8d0-1c3e5a7b9f26"
+    rotated_at    = "2026-01-01T00:00:00Z"
+  }
+}
+
+mock_resource "repoflow_cleanup_policy" {
+  defaults = {
+    id        = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/2f1e8c34-6a2b-4d7e-9c1a-0b5f3e7d9a12"
+    policy_id = "2f1e8c34-6a2b-4d7e-9c1a-0b5f3e7d9a12"
+  }
+}
+
+mock_resource "repoflow_retention_policy" {
+  defaults = {
+    id        = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/7c4d2a91-3e8f-4b6a-a5d0-1f9e2c8b7d34"
+    policy_id = "7c4d2a91-3e8f-4b6a-a5d0-1f9e2c8b7d34"
+  }
+}
+
+mock_resource "repoflow_role_assignment" {
+  defaults = {
+    id = "6b8d0f2a-4c6e-4a8b-9d1f-3e5a7c9b1d48"
+  }
+}
+
+mock_resource "repoflow_access_token" {
+  defaults = {
+    id           = "1a3c5e7b-9d2f-4b4d-8f6a-0c2e4b6d8f57"
+    token        = "pat_mock0000000000000000000000000000"
+    created_at   = "2026-01-01T00:00:00Z"
+    last_used_at = null
+  }
+}
+
+mock_resource "repoflow_service_account" {
+  defaults = {
+    id         = "4d6f8b0c-2e4a-4c6e-a0b2-5d7f9a1c3e69"
+    created_at = "2026-01-01T00:00:00Z"
+  }
+}
+
+mock_resource "repoflow_label_taxonomy" {
+  defaults = {
+    id = "team"
+  }
+}
+
+mock_resource "repoflow_login_message" {
+  defaults = {
+    id = "login-message"
+  }
+}
+
+mock_resource "repoflow_ldap_config" {
+  defaults = {
+    id = "ldap_config"
+  }
+}
+
+mock_resource "repoflow_oidc_config" {
+  defaults = {
+    id           = "oidc_config"
+    redirect_uri = "https://repoflow.example.com/api/auth/oidc/callback"
+  }
+}
+
+mock_resource "repoflow_scim_config" {
+  defaults = {
+    id               = "scim_config"
+    base_url         = "https://repoflow.example.com/api/scim/v2"
+    token_updated_at = "2026-01-01T00:00:00Z"
+  }
+}
+
+mock_resource "repoflow_banner" {
+  defaults = {
+    id     = "7e9a1c3d-5f7b-4d9e-b1c3-8a0c2e4f6b72"
+    status = "scheduled"
+  }
+}
+
+mock_resource "repoflow_audit_log_stream" {
+  defaults = {
+    id                = "5b8e2f4a-9c1d-4e7b-a3f6-2d0c8e4b6a19"
+    status            = "active"
+    last_delivered_at = "2026-01-01T00:00:00Z"
+  }
+}
+
+mock_resource "repoflow_storage_backend" {
+  defaults = {
+    id         = "c4e1a7d3-2f8b-4a6e-9b0d-5e3f1c7a9d24"
+    used_bytes = 0
+  }
+}
+
+mock_resource "repoflow_ip_allowlist" {
+  defaults = {
+    id = "instance"
+  }
+}
+
+mock_resource "repoflow_rate_limit_policy" {
+  defaults = {
+    id = "e2a9c5f1-7b3d-4c8e-a0f6-3d1b9e5c7a48"
+  }
+}
+
+mock_resource "repoflow_anonymous_access" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91"
+  }
+}
+
+mock_resource "repoflow_security_scan_policy" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91"
+  }
+}
+
+mock_resource "repoflow_vulnerability_allowlist" {
+  defaults = {
+    id = "7d2f9a4c-1e6b-4c8d-a3f5-0b9e2c7d4a16"
+  }
+}
+
+mock_resource "repoflow_license_policy" {
+  defaults = {
+    id = "5b8e1c3a-7f2d-4a9e-b6c4-1d3f8a0e2c57"
+  }
+}
+
+mock_resource "repoflow_upload_policy" {
+  defaults = {
+    id = "5b8e1c3a-7f2d-4a9e-b6c4-1d3f8a0e2c57"
+  }
+}
+
+mock_resource "repoflow_immutable_tag_rule" {
+  defaults = {
+    id      = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/6d2f8b4a-0c1e-4a7d-b3f5-8e2a4c6d9b17"
+    rule_id = "6d2f8b4a-0c1e-4a7d-b3f5-8e2a4c6d9b17"
+  }
+}
+
+mock_resource "repoflow_trusted_publisher" {
+  defaults = {
+    id           = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f/9c4e2a6f-3b1d-4f8a-a5c7-2e9d4b6f8a13"
+    publisher_id = "9c4e2a6f-3b1d-4f8a-a5c7-2e9d4b6f8a13"
+    audience     = "https://repoflow.example.com"
+  }
+}
+
+mock_resource "repoflow_default_permission_template" {
+  defaults = {
+    id = "8c4a2e6f-1b3d-4f7a-9e5c-2d6b8f0a4c13"
+  }
+}
+
+mock_resource "repoflow_repository_set" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/payments"
+  }
+}
+
+mock_resource "repoflow_registry_stack" {
+  defaults = {
+    id                    = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/npm"
+    local_repository_id   = "6d1f3b5a-2c4e-4a6b-8d9f-1e3a5c7b9d02"
+    remote_repository_id  = "7e2a4c6b-3d5f-4b7c-9e1a-2f4b6d8c0e13"
+    virtual_repository_id = "8f3b5d7c-4e6a-4c8d-a02b-3a5c7e9d1f24"
+    url                   = "https://repoflow.example.com/api/npm/platform/npm"
+  }
+}
+
+mock_resource "repoflow_workspace_settings" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91"
+  }
+}
+
+mock_resource "repoflow_quarantine_rule" {
+  defaults = {
+    id      = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/2e9b4f61-8c3a-4d7e-b1f5-6a0c9d3e8b42"
+    rule_id = "2e9b4f61-8c3a-4d7e-b1f5-6a0c9d3e8b42"
+  }
+}
+
+mock_resource "repoflow_promotion_pipeline" {
+  defaults = {
+    id          = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/9a1c5e3b-7d2f-4b8e-a6c0-4f2d8b1e6a93"
+    pipeline_id = "9a1c5e3b-7d2f-4b8e-a6c0-4f2d8b1e6a93"
+  }
+}
+
+mock_resource "repoflow_build_info" {
+  defaults = {
+    id = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/acme-core/1234"
+  }
+}
+
+mock_resource "repoflow_gc_schedule" {
+  defaults = {
+    id = "blobs"
+  }
+}
+
+mock_resource "repoflow_outbound_proxy" {
+  defaults = {
+    id = "outbound_proxy"
+  }
+}
+
+mock_resource "repoflow_password_policy" {
+  defaults = {
+    id = "password_policy"
+  }
+}
+
+mock_resource "repoflow_session_policy" {
+  defaults = {
+    id = "session_policy"
+  }
+}
+
+mock_resource "repoflow_mfa_enforcement" {
+  defaults = {
+    id = "mfa_enforcement"
+  }
+}
+
+mock_resource "repoflow_maintenance_window" {
+  defaults = {
+    id = "2b7d9f1a-4c6e-4a8b-9d3f-5e7a1c3b9d26"
+  }
+}
+
+mock_resource "repoflow_metrics_exporter" {
+  defaults = {
+    id           = "metrics_exporter"
+    endpoint_url = "https://repoflow.example.com/metrics"
+  }
+}
+
+mock_resource "repoflow_cors_policy" {
+  defaults = {
+    id = "api"
+  }
+}
+
+mock_resource "repoflow_custom_domain" {
+  defaults = {
+    id                        = "8f0b2d4e-6a8c-4e0f-c2d4-9b1d3f5a7c83"
+    validation_status         = "verified"
+    verification_record_name  = null
+    verification_record_type  = null
+    verification_record_value = null
+  }
+}
+
+mock_resource "repoflow_tls_certificate" {
+  defaults = {
+    id          = "9a1c3e5f-7b9d-4f1a-d3e5-0c2e4a6b8d94"
+    fingerprint = "5d:41:40:2a:bc:4b:2a:76:b9:71:9d:91:10:17:c5:92:8b:26:f1:4c:5e:2d:8a:3f:7b:01:c4:6e:9f:a2:d8:13"
+    not_before  = "2026-01-01T00:00:00Z"
+    expires_at  = "2027-01-01T00:00:00Z"
+  }
+}
+
+mock_resource "repoflow_malware_feed_subscription" {
+  defaults = {
+    id             = "0b2d4f6a-8c0e-4a2b-e4f6-1d3f5b7c9ea5"
+    last_synced_at = "2026-01-01T00:00:00Z"
+  }
+}
+
+mock_resource "repoflow_exception" {
+  defaults = {
+    id = "1c3e5a7b-9d1f-4b3c-f5a7-2e4a6c8d0fb6"
+  }
+}
+
+mock_data "repoflow_workspace" {
+  defaults = {
+    id                  = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91"
+    description         = "Mock workspace"
+    labels              = {}
+    storage_quota_bytes = null
+    storage_used_bytes  = 0
+    region              = null
+  }
+}
+
+mock_data "repoflow_repository" {
+  defaults = {
+    id                                    = "3f6c2a1e-8b4d-4e7a-9c15-2d8e6b0f4a91/8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f"
+    repository_id                         = "8a2d4f6b-1c3e-4b5a-9d7f-0e2c4a6b8d1f"
+    status                                = "active"
+    repository_type                       = "remote"
+    package_type                          = "maven"
+    remote_repository_url                 = "https://repo.maven.apache.org/maven2"
+    remote_cache_enabled                  = true
+    file_cache_time_till_revalidation     = null
+    metadata_cache_time_till_revalidation = 3600000
+    child_repository_ids                  = []
+    upload_local_repository_id            = null
+  }
+}
+
+mock_data "repoflow_repository_stats" {
+  defaults = {
+    request_count       = 0
+    requests_per_second = 0
+    error_count         = 0
+    error_rate          = 0
+    latency_p50_ms      = 0
+    latency_p95_ms      = 0
+    latency_p99_ms      = 0
+  }
+}
+
+mock_data "repoflow_instance_upgrade_check" {
+  defaults = {
+    current_version   = "1.0.0"
+    latest_version    = "1.0.0"
+    upgrade_available = false
+    breaking_changes  = []
+  }
+}
+
+mock_data "repoflow_repository_endpoint_check" {
+  defaults = {
+    url         = "https://repoflow.example/api/maven/team/maven-central/"
+    status_code = 200
+    latency_ms  = 25
+    healthy     = true
+  }
+}
+
+mock_data "repoflow_migration_plan" {
+  defaults = {
+    in_sync     = true
+    differences = []
+  }
+}
+
+mock_data "repoflow_unmanaged_changes" {
+  defaults = {
+    changes = []
+  }
+}
+
+mock_data "repoflow_workspaces" {
+  defaults = {
+    workspaces = []
+  }
+}
+
+mock_data "repoflow_repositories" {
+  defaults = {
+    repositories = []
+  }
+}
+
+mock_data "repoflow_packages" {
+  defaults = {
+    packages = []
+  }
+}
+
+mock_data "repoflow_package_versions" {
+  defaults = {
+    versions = []
+  }
+}
+
+mock_data "repoflow_latest_version" {
+  defaults = {
+    version    = "1.0.0"
+    created_at = "2026-01-01T00:00:00Z"
+  }
+}
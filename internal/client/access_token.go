@@ -0,0 +1,53 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	AccessTokensEndpoint = "/1/access-tokens"
+)
+
+type AccessToken struct {
+	Id          string   `json:"id"`
+	Description string   `json:"description"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   *string  `json:"expiresAt"`
+	CreatedAt   string   `json:"createdAt"`
+	LastUsedAt  *string  `json:"lastUsedAt"`
+	// Token is only returned on creation
+	Token *string `json:"token,omitempty"`
+}
+
+// AccessTokenOptions defines the payload for creating an access token
+type AccessTokenOptions struct {
+	Description string   `json:"description"`
+	Scopes      []string `json:"scopes"`
+	ExpiresAt   *string  `json:"expiresAt,omitempty"`
+}
+
+// CreateAccessToken creates a new access token, its value is only returned once
+// POST /1/access-tokens
+func (c *Client) CreateAccessToken(opts AccessTokenOptions) (*AccessToken, error) {
+	var at AccessToken
+	err := c.DoRequest(http.MethodPost, AccessTokensEndpoint, opts, &at)
+	return &at, err
+}
+
+// GetAccessToken retrieves metadata for a specific access token, the value is never returned
+// GET /1/access-tokens/:id
+func (c *Client) GetAccessToken(id string) (*AccessToken, error) {
+	var at AccessToken
+	endpoint := fmt.Sprintf("%s/%s", AccessTokensEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &at)
+	return &at, err
+}
+
+// DeleteAccessToken revokes an access token by its ID
+// DELETE /1/access-tokens/:id
+func (c *Client) DeleteAccessToken(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", AccessTokensEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
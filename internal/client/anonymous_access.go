@@ -0,0 +1,55 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	AnonymousAccessEndpoint = "/anonymous-access"
+)
+
+// AnonymousAccess defines what the requests without credentials can read in a
+// workspace or a repository. The setting of a repository wins over the one of
+// its workspace.
+type AnonymousAccess struct {
+	WorkspaceId string `json:"workspaceId"`
+	// Unset for the setting of the workspace
+	RepositoryId *string `json:"repositoryId"`
+	// Access mode: disabled or read_only
+	Mode string `json:"mode"`
+	// Package types readable anonymously, every type when empty
+	PackageTypes []string `json:"packageTypes"`
+}
+
+// AnonymousAccessOptions defines the payload for replacing an anonymous access setting
+type AnonymousAccessOptions struct {
+	Mode         string   `json:"mode"`
+	PackageTypes []string `json:"packageTypes"`
+}
+
+// GetAnonymousAccess retrieves the anonymous access of a repository, or of the workspace when the repository is empty
+// GET /1/workspaces/:workspace/anonymous-access
+// GET /1/workspaces/:workspace/repositories/:repository/anonymous-access
+func (c *Client) GetAnonymousAccess(workspace string, repository string) (*AnonymousAccess, error) {
+	var aa AnonymousAccess
+	err := c.DoRequest(http.MethodGet, scopedEndpoint(workspace, repository, AnonymousAccessEndpoint), nil, &aa)
+	return &aa, err
+}
+
+// PutAnonymousAccess replaces the anonymous access of a repository, or of the workspace when the repository is empty
+// PUT /1/workspaces/:workspace/anonymous-access
+// PUT /1/workspaces/:workspace/repositories/:repository/anonymous-access
+func (c *Client) PutAnonymousAccess(workspace string, repository string, opts AnonymousAccessOptions) (*AnonymousAccess, error) {
+	var aa AnonymousAccess
+	err := c.DoRequest(http.MethodPut, scopedEndpoint(workspace, repository, AnonymousAccessEndpoint), opts, &aa)
+	return &aa, err
+}
+
+// DeleteAnonymousAccess resets the anonymous access of a repository to the one
+// of its workspace, or of the workspace to disabled when the repository is empty
+// DELETE /1/workspaces/:workspace/anonymous-access
+// DELETE /1/workspaces/:workspace/repositories/:repository/anonymous-access
+func (c *Client) DeleteAnonymousAccess(workspace string, repository string) error {
+	return c.DoRequest(http.MethodDelete, scopedEndpoint(workspace, repository, AnonymousAccessEndpoint), nil, nil)
+}
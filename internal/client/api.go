@@ -0,0 +1,361 @@
+package client
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// API is the subset of the RepoFlow API used by the provider, made of one
+// interface per area. Resources and data sources depend on the areas they use
+// rather than on *Client, so a test double like the one of the fake package can
+// stand in for a real instance.
+type API interface {
+	WorkspacesAPI
+	RepositoriesAPI
+	PermissionsAPI
+	CredentialsAPI
+	PackagesAPI
+	PoliciesAPI
+	SecurityAPI
+	IdentityAPI
+	LabelsAPI
+	InstanceAPI
+}
+
+// WorkspacesAPI covers the workspaces and their settings.
+type WorkspacesAPI interface {
+	// Workspaces
+	ListWorkspaces() (*[]repoflow.Workspaces, error)
+	CreateWorkspace(opts WorkspaceOptions) (*Workspace, error)
+	GetWorkspace(id string) (*Workspace, error)
+	UpdateWorkspace(id string, opts WorkspaceUpdateOptions) (*Workspace, error)
+	DeleteWorkspace(id string) (*repoflow.Workspace, error)
+	ExportWorkspace(workspace string) (json.RawMessage, error)
+	ImportWorkspace(workspace string, opts WorkspaceImportOptions) (*WorkspaceImport, error)
+
+	// Workspace settings
+	GetWorkspaceSettings(workspace string) (*WorkspaceSettings, error)
+	PutWorkspaceSettings(workspace string, opts WorkspaceSettingsOptions) (*WorkspaceSettings, error)
+	DeleteWorkspaceSettings(workspace string) error
+}
+
+// RepositoriesAPI covers the repositories, their webhooks and push mirrors.
+type RepositoriesAPI interface {
+	// Repositories
+	ListRepositories(workspace string) (*[]repoflow.Repositories, error)
+	GetRepository(workspace string, id string) (*Repository, error)
+	UpdateRepository(workspace string, id string, opts RepositoryUpdateOptions) (*Repository, error)
+	CreateLocalRepository(workspace string, opts RepositoryOptions) (*Repository, error)
+	CreateRemoteRepository(workspace string, opts RepositoryRemoteOptions) (*Repository, error)
+	UpdateRemoteRepository(workspace string, id string, opts RepositoryRemoteUpdateOptions) (*Repository, error)
+	CreateVirtualRepository(workspace string, opts RepositoryVirtualOptions) (*Repository, error)
+	UpdateVirtualRepository(workspace string, id string, opts RepositoryVirtualUpdateOptions) (*Repository, error)
+	UpdateVirtualRepositoryChildren(workspace string, id string, opts RepositoryVirtualChildrenOptions) (*Repository, error)
+	ListVirtualRepositoryMembers(workspace string, id string) (*[]VirtualRepositoryMember, error)
+	UpdateVirtualRepositoryMember(workspace string, id string, child string, opts VirtualRepositoryMemberOptions) (*VirtualRepositoryMember, error)
+	DeleteRepository(workspace string, id string) (*repoflow.RepostotryDelete, error)
+	GetRepositoryStats(workspace string, id string, period string) (*RepositoryStats, error)
+	CloneRepository(workspace string, id string, opts RepositoryCloneOptions) (*RepositoryClone, error)
+	CheckRepositoryEndpoint(packageType string, workspace string, repository string, path string) (*EndpointCheck, error)
+	RepositoryEndpointUrl(packageType string, workspace string, repository string) string
+
+	// Repository webhooks
+	CreateRepositoryWebhook(workspace string, repository string, opts RepositoryWebhookOptions) (*RepositoryWebhook, error)
+	GetRepositoryWebhook(workspace string, repository string, id string) (*RepositoryWebhook, error)
+	UpdateRepositoryWebhook(workspace string, repository string, id string, opts RepositoryWebhookOptions) (*RepositoryWebhook, error)
+	DeleteRepositoryWebhook(workspace string, repository string, id string) error
+
+	// Push mirrors
+	CreatePushMirror(workspace string, repository string, opts PushMirrorOptions) (*PushMirror, error)
+	GetPushMirror(workspace string, repository string, id string) (*PushMirror, error)
+	UpdatePushMirror(workspace string, repository string, id string, opts PushMirrorOptions) (*PushMirror, error)
+	DeletePushMirror(workspace string, repository string, id string) error
+}
+
+// PermissionsAPI covers who can access the workspaces and repositories.
+type PermissionsAPI interface {
+	// Repository permissions
+	GetRepositoryPermission(workspace string, repository string, principal string) (*RepositoryPermission, error)
+	PutRepositoryPermission(workspace string, repository string, principal string, opts RepositoryPermissionOptions) (*RepositoryPermission, error)
+	PutRepositoryPermissions(workspace string, repository string, opts RepositoryPermissionsOptions) (*[]RepositoryPermission, error)
+	DeleteRepositoryPermission(workspace string, repository string, principal string) error
+
+	// Default permission templates
+	GetDefaultPermissionTemplate(workspace string) (*DefaultPermissionTemplate, error)
+	PutDefaultPermissionTemplate(workspace string, opts DefaultPermissionTemplateOptions) (*DefaultPermissionTemplate, error)
+	DeleteDefaultPermissionTemplate(workspace string) error
+
+	// Anonymous access
+	GetAnonymousAccess(workspace string, repository string) (*AnonymousAccess, error)
+	PutAnonymousAccess(workspace string, repository string, opts AnonymousAccessOptions) (*AnonymousAccess, error)
+	DeleteAnonymousAccess(workspace string, repository string) error
+
+	// Role assignments
+	CreateRoleAssignment(opts RoleAssignmentOptions) (*RoleAssignment, error)
+	GetRoleAssignment(id string) (*RoleAssignment, error)
+	UpdateRoleAssignment(id string, opts RoleAssignmentUpdateOptions) (*RoleAssignment, error)
+	DeleteRoleAssignment(id string) error
+
+	// IP allowlists
+	GetIpAllowlist(workspace string) (*IpAllowlist, error)
+	PutIpAllowlist(workspace string, opts IpAllowlistOptions) (*IpAllowlist, error)
+	DeleteIpAllowlist(workspace string) error
+}
+
+// CredentialsAPI covers the secrets held by RepoFlow and the tokens it issues.
+type CredentialsAPI interface {
+	// Credentials
+	ListCredentials(workspace string) (*[]Credential, error)
+	CreateCredential(workspace string, opts CredentialOptions) (*Credential, error)
+	GetCredential(workspace string, id string) (*Credential, error)
+	UpdateCredential(workspace string, id string, opts CredentialUpdateOptions) (*Credential, error)
+	DeleteCredential(workspace string, id string) error
+
+	// Signing keys
+	CreateSigningKey(workspace string, opts SigningKeyOptions) (*SigningKey, error)
+	GetSigningKey(workspace string, id string) (*SigningKey, error)
+	UpdateSigningKey(workspace string, id string, opts SigningKeyUpdateOptions) (*SigningKey, error)
+	DeleteSigningKey(workspace string, id string) error
+
+	// Access tokens
+	CreateAccessToken(opts AccessTokenOptions) (*AccessToken, error)
+	GetAccessToken(id string) (*AccessToken, error)
+	DeleteAccessToken(id string) error
+
+	// Service accounts
+	CreateServiceAccount(opts ServiceAccountOptions) (*ServiceAccount, error)
+	GetServiceAccount(id string) (*ServiceAccount, error)
+	UpdateServiceAccount(id string, opts ServiceAccountUpdateOptions) (*ServiceAccount, error)
+	DeleteServiceAccount(id string) error
+	CreateServiceAccountToken(account string, opts ServiceAccountTokenOptions) (*ServiceAccountToken, error)
+	DeleteServiceAccountToken(account string, id string) error
+}
+
+// PackagesAPI covers the content of the repositories.
+type PackagesAPI interface {
+	// Artifacts
+	UploadArtifact(workspace string, repository string, path string, content io.Reader) (*Artifact, error)
+	GetArtifact(workspace string, repository string, path string) (*Artifact, error)
+	DeleteArtifact(workspace string, repository string, path string) error
+	GetArtifactProperties(workspace string, repository string, path string) (*ArtifactProperties, error)
+	PutArtifactProperties(workspace string, repository string, path string, opts ArtifactPropertiesOptions) (*ArtifactProperties, error)
+	DeleteArtifactProperties(workspace string, repository string, path string) error
+
+	// Packages
+	ListPackages(workspace string, repository string, offset int, limit int) (*Packages, error)
+
+	// Package versions
+	ListPackageVersions(workspace string, repository string, packageName string, offset int, limit int) (*PackageVersions, error)
+	GetPackageVersion(workspace string, repository string, packageName string, version string) (*PackageVersion, error)
+	UpdatePackageVersion(workspace string, repository string, packageName string, version string, opts PackageVersionOptions) (*PackageVersion, error)
+	DeletePackageVersion(workspace string, repository string, packageName string, version string) error
+
+	// Package deprecations
+	CreatePackageDeprecation(workspace string, repository string, opts PackageDeprecationOptions) (*PackageDeprecation, error)
+	GetPackageDeprecation(workspace string, repository string, id string) (*PackageDeprecation, error)
+	UpdatePackageDeprecation(workspace string, repository string, id string, opts PackageDeprecationOptions) (*PackageDeprecation, error)
+	DeletePackageDeprecation(workspace string, repository string, id string) error
+
+	// Build info
+	GetBuildInfo(workspace string, name string, number string) (*BuildInfo, error)
+	PutBuildInfo(workspace string, name string, number string, opts BuildInfoOptions) (*BuildInfo, error)
+	DeleteBuildInfo(workspace string, name string, number string) error
+
+	// Promotion pipelines
+	CreatePromotionPipeline(workspace string, opts PromotionPipelineOptions) (*PromotionPipeline, error)
+	GetPromotionPipeline(workspace string, id string) (*PromotionPipeline, error)
+	UpdatePromotionPipeline(workspace string, id string, opts PromotionPipelineOptions) (*PromotionPipeline, error)
+	DeletePromotionPipeline(workspace string, id string) error
+
+	// Trusted publishers
+	CreateTrustedPublisher(workspace string, repository string, opts TrustedPublisherOptions) (*TrustedPublisher, error)
+	GetTrustedPublisher(workspace string, repository string, id string) (*TrustedPublisher, error)
+	UpdateTrustedPublisher(workspace string, repository string, id string, opts TrustedPublisherOptions) (*TrustedPublisher, error)
+	DeleteTrustedPublisher(workspace string, repository string, id string) error
+}
+
+// PoliciesAPI covers the rules applied to the content of the repositories.
+type PoliciesAPI interface {
+	// Cleanup policies
+	CreateCleanupPolicy(workspace string, opts CleanupPolicyOptions) (*CleanupPolicy, error)
+	GetCleanupPolicy(workspace string, id string) (*CleanupPolicy, error)
+	UpdateCleanupPolicy(workspace string, id string, opts CleanupPolicyOptions) (*CleanupPolicy, error)
+	DeleteCleanupPolicy(workspace string, id string) error
+
+	// Retention policies
+	CreateRetentionPolicy(workspace string, opts RetentionPolicyOptions) (*RetentionPolicy, error)
+	GetRetentionPolicy(workspace string, id string) (*RetentionPolicy, error)
+	UpdateRetentionPolicy(workspace string, id string, opts RetentionPolicyOptions) (*RetentionPolicy, error)
+	DeleteRetentionPolicy(workspace string, id string) error
+
+	// Upload policies
+	GetUploadPolicy(workspace string, repository string) (*UploadPolicy, error)
+	PutUploadPolicy(workspace string, repository string, opts UploadPolicyOptions) (*UploadPolicy, error)
+	DeleteUploadPolicy(workspace string, repository string) error
+
+	// Immutable tag rules
+	CreateImmutableTagRule(workspace string, repository string, opts ImmutableTagRuleOptions) (*ImmutableTagRule, error)
+	GetImmutableTagRule(workspace string, repository string, id string) (*ImmutableTagRule, error)
+	UpdateImmutableTagRule(workspace string, repository string, id string, opts ImmutableTagRuleOptions) (*ImmutableTagRule, error)
+	DeleteImmutableTagRule(workspace string, repository string, id string) error
+
+	// Rate limit policies
+	CreateRateLimitPolicy(opts RateLimitPolicyOptions) (*RateLimitPolicy, error)
+	GetRateLimitPolicy(id string) (*RateLimitPolicy, error)
+	UpdateRateLimitPolicy(id string, opts RateLimitPolicyOptions) (*RateLimitPolicy, error)
+	DeleteRateLimitPolicy(id string) error
+
+	// CORS policies
+	GetCorsPolicy(target string) (*CorsPolicy, error)
+	PutCorsPolicy(target string, opts CorsPolicyOptions) (*CorsPolicy, error)
+	DeleteCorsPolicy(target string) error
+}
+
+// SecurityAPI covers the scanning of the packages.
+type SecurityAPI interface {
+	// Security scan policies
+	GetSecurityScanPolicy(workspace string, repository string) (*SecurityScanPolicy, error)
+	PutSecurityScanPolicy(workspace string, repository string, opts SecurityScanPolicyOptions) (*SecurityScanPolicy, error)
+	DeleteSecurityScanPolicy(workspace string, repository string) error
+
+	// Vulnerability allowlist
+	CreateAllowedVulnerability(opts AllowedVulnerabilityOptions) (*AllowedVulnerability, error)
+	GetAllowedVulnerability(id string) (*AllowedVulnerability, error)
+	UpdateAllowedVulnerability(id string, opts AllowedVulnerabilityUpdateOptions) (*AllowedVulnerability, error)
+	DeleteAllowedVulnerability(id string) error
+
+	// License policies
+	GetLicensePolicy(workspace string, repository string) (*LicensePolicy, error)
+	PutLicensePolicy(workspace string, repository string, opts LicensePolicyOptions) (*LicensePolicy, error)
+	DeleteLicensePolicy(workspace string, repository string) error
+
+	// Quarantine rules
+	CreateQuarantineRule(workspace string, opts QuarantineRuleOptions) (*QuarantineRule, error)
+	GetQuarantineRule(workspace string, id string) (*QuarantineRule, error)
+	UpdateQuarantineRule(workspace string, id string, opts QuarantineRuleOptions) (*QuarantineRule, error)
+	DeleteQuarantineRule(workspace string, id string) error
+
+	// Security
+	CreateMalwareFeedSubscription(opts MalwareFeedSubscriptionOptions) (*MalwareFeedSubscription, error)
+	GetMalwareFeedSubscription(id string) (*MalwareFeedSubscription, error)
+	UpdateMalwareFeedSubscription(id string, opts MalwareFeedSubscriptionOptions) (*MalwareFeedSubscription, error)
+	DeleteMalwareFeedSubscription(id string) error
+	CreateException(opts ExceptionOptions) (*Exception, error)
+	GetException(id string) (*Exception, error)
+	UpdateException(id string, opts ExceptionUpdateOptions) (*Exception, error)
+	DeleteException(id string) error
+}
+
+// IdentityAPI covers the sign-in of the users.
+type IdentityAPI interface {
+	// LDAP integration
+	GetLdapConfig() (*LdapConfig, error)
+	UpdateLdapConfig(opts LdapConfigOptions) (*LdapConfig, error)
+	DeleteLdapConfig() error
+
+	// OIDC single sign-on
+	GetOidcConfig() (*OidcConfig, error)
+	UpdateOidcConfig(opts OidcConfigOptions) (*OidcConfig, error)
+	DeleteOidcConfig() error
+
+	// SCIM provisioning
+	GetScimConfig() (*ScimConfig, error)
+	UpdateScimConfig(opts ScimConfigOptions) (*ScimConfig, error)
+	DeleteScimConfig() error
+
+	// Password policy
+	GetPasswordPolicy() (*PasswordPolicy, error)
+	UpdatePasswordPolicy(opts PasswordPolicyOptions) (*PasswordPolicy, error)
+	DeletePasswordPolicy() error
+
+	// Session policy
+	GetSessionPolicy() (*SessionPolicy, error)
+	UpdateSessionPolicy(opts SessionPolicyOptions) (*SessionPolicy, error)
+	DeleteSessionPolicy() error
+
+	// MFA enforcement
+	GetMfaEnforcement() (*MfaEnforcement, error)
+	UpdateMfaEnforcement(opts MfaEnforcementOptions) (*MfaEnforcement, error)
+	DeleteMfaEnforcement() error
+}
+
+// LabelsAPI covers the label taxonomy shared by the workspaces and repositories.
+type LabelsAPI interface {
+	// Label taxonomy
+	ListLabelKeys() (*[]LabelKey, error)
+	GetLabelKey(key string) (*LabelKey, error)
+	PutLabelKey(key string, opts LabelKeyOptions) (*LabelKey, error)
+	DeleteLabelKey(key string) error
+}
+
+// InstanceAPI covers the administration of the instance.
+type InstanceAPI interface {
+	// Login message
+	GetLoginMessage() (*LoginMessage, error)
+	UpdateLoginMessage(opts LoginMessageOptions) (*LoginMessage, error)
+	DeleteLoginMessage() error
+
+	// Maintenance banners
+	CreateBanner(opts BannerOptions) (*Banner, error)
+	GetBanner(id string) (*Banner, error)
+	UpdateBanner(id string, opts BannerOptions) (*Banner, error)
+	DeleteBanner(id string) error
+
+	// Audit log streams
+	CreateAuditLogStream(opts AuditLogStreamOptions) (*AuditLogStream, error)
+	GetAuditLogStream(id string) (*AuditLogStream, error)
+	UpdateAuditLogStream(id string, opts AuditLogStreamOptions) (*AuditLogStream, error)
+	DeleteAuditLogStream(id string) error
+
+	// Storage backends
+	CreateStorageBackend(opts StorageBackendOptions) (*StorageBackend, error)
+	GetStorageBackend(id string) (*StorageBackend, error)
+	UpdateStorageBackend(id string, opts StorageBackendOptions) (*StorageBackend, error)
+	DeleteStorageBackend(id string) error
+
+	// Garbage collection schedules
+	GetGcSchedule(task string) (*GcSchedule, error)
+	PutGcSchedule(task string, opts GcScheduleOptions) (*GcSchedule, error)
+	DeleteGcSchedule(task string) error
+
+	// Maintenance windows
+	CreateMaintenanceWindow(opts MaintenanceWindowOptions) (*MaintenanceWindow, error)
+	GetMaintenanceWindow(id string) (*MaintenanceWindow, error)
+	UpdateMaintenanceWindow(id string, opts MaintenanceWindowOptions) (*MaintenanceWindow, error)
+	DeleteMaintenanceWindow(id string) error
+
+	// Outbound proxy
+	GetOutboundProxy() (*OutboundProxy, error)
+	UpdateOutboundProxy(opts OutboundProxyOptions) (*OutboundProxy, error)
+	DeleteOutboundProxy() error
+
+	// Metrics exporter
+	GetMetricsExporter() (*MetricsExporter, error)
+	UpdateMetricsExporter(opts MetricsExporterOptions) (*MetricsExporter, error)
+	DeleteMetricsExporter() error
+
+	// Custom domains
+	CreateCustomDomain(opts CustomDomainOptions) (*CustomDomain, error)
+	GetCustomDomain(id string) (*CustomDomain, error)
+	UpdateCustomDomain(id string, opts CustomDomainUpdateOptions) (*CustomDomain, error)
+	DeleteCustomDomain(id string) error
+
+	// TLS certificates
+	CreateTlsCertificate(opts TlsCertificateOptions) (*TlsCertificate, error)
+	GetTlsCertificate(id string) (*TlsCertificate, error)
+	UpdateTlsCertificate(id string, opts TlsCertificateOptions) (*TlsCertificate, error)
+	DeleteTlsCertificate(id string) error
+
+	// Other instances
+	Instance(baseUrls []string, token string) API
+
+	// System
+	GetUpgradeCheck() (*UpgradeCheck, error)
+	StartSystemTask(opts SystemTaskOptions) (*SystemTask, error)
+	GetSystemTask(id string) (*SystemTask, error)
+}
+
+// Ensure the client fully satisfies the interface.
+var _ API = &Client{}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Endpoints definitions
+const (
+	ArtifactsEndpoint = "/artifacts"
+)
+
+// Artifact is a file stored in a repository, with the checksums of its content.
+type Artifact struct {
+	Path      string `json:"path"`
+	SizeBytes int64  `json:"size"`
+	Sha256    string `json:"sha256"`
+	Sha1      string `json:"sha1"`
+	Md5       string `json:"md5"`
+	CreatedAt string `json:"createdAt"`
+}
+
+func artifactEndpoint(workspace string, repository string, path string) string {
+	return fmt.Sprintf("%s%s/%s", repositoryEndpoint(workspace, repository), ArtifactsEndpoint, strings.TrimPrefix(path, "/"))
+}
+
+// UploadArtifact uploads a file to a path of a repository, replacing the current one
+// PUT /1/workspaces/:workspace/repositories/:repository/artifacts/:path
+func (c *Client) UploadArtifact(workspace string, repository string, path string, content io.Reader) (*Artifact, error) {
+	// The content is sent as is, DoRequest only sends JSON payloads
+	req, err := http.NewRequest(http.MethodPut, c.BaseURL+artifactEndpoint(workspace, repository, path), content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Let the failover transport send the content again to another base URL
+	if seeker, ok := content.(io.ReadSeeker); ok && req.GetBody == nil {
+		req.GetBody = func() (io.ReadCloser, error) {
+			if _, err := seeker.Seek(0, io.SeekStart); err != nil {
+				return nil, err
+			}
+			return io.NopCloser(seeker), nil
+		}
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	if c.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var artifact Artifact
+	if err := json.NewDecoder(resp.Body).Decode(&artifact); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &artifact, nil
+}
+
+// GetArtifact retrieves the metadata of a file of a repository
+// GET /1/workspaces/:workspace/repositories/:repository/artifacts/:path
+func (c *Client) GetArtifact(workspace string, repository string, path string) (*Artifact, error) {
+	var artifact Artifact
+	err := c.DoRequest(http.MethodGet, artifactEndpoint(workspace, repository, path), nil, &artifact)
+	return &artifact, err
+}
+
+// DeleteArtifact deletes a file of a repository
+// DELETE /1/workspaces/:workspace/repositories/:repository/artifacts/:path
+func (c *Client) DeleteArtifact(workspace string, repository string, path string) error {
+	return c.DoRequest(http.MethodDelete, artifactEndpoint(workspace, repository, path), nil, nil)
+}
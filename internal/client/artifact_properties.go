@@ -0,0 +1,51 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Endpoints definitions
+const (
+	PropertiesEndpoint = "/properties"
+)
+
+// ArtifactProperties are the key/value properties attached to an artifact, like
+// its promotion metadata.
+type ArtifactProperties struct {
+	Path       string            `json:"path"`
+	Properties map[string]string `json:"properties"`
+}
+
+// ArtifactPropertiesOptions defines the payload for setting the properties of
+// an artifact, they replace the existing ones
+type ArtifactPropertiesOptions struct {
+	Properties map[string]string `json:"properties"`
+}
+
+func artifactPropertiesEndpoint(workspace string, repository string, path string) string {
+	return fmt.Sprintf("%s%s/%s", repositoryEndpoint(workspace, repository), PropertiesEndpoint, strings.TrimPrefix(path, "/"))
+}
+
+// GetArtifactProperties retrieves the properties of an artifact
+// GET /1/workspaces/:workspace/repositories/:repository/properties/:path
+func (c *Client) GetArtifactProperties(workspace string, repository string, path string) (*ArtifactProperties, error) {
+	var ap ArtifactProperties
+	err := c.DoRequest(http.MethodGet, artifactPropertiesEndpoint(workspace, repository, path), nil, &ap)
+	return &ap, err
+}
+
+// PutArtifactProperties replaces the properties of an artifact
+// PUT /1/workspaces/:workspace/repositories/:repository/properties/:path
+func (c *Client) PutArtifactProperties(workspace string, repository string, path string, opts ArtifactPropertiesOptions) (*ArtifactProperties, error) {
+	var ap ArtifactProperties
+	err := c.DoRequest(http.MethodPut, artifactPropertiesEndpoint(workspace, repository, path), opts, &ap)
+	return &ap, err
+}
+
+// DeleteArtifactProperties removes every property of an artifact, the artifact is kept
+// DELETE /1/workspaces/:workspace/repositories/:repository/properties/:path
+func (c *Client) DeleteArtifactProperties(workspace string, repository string, path string) error {
+	return c.DoRequest(http.MethodDelete, artifactPropertiesEndpoint(workspace, repository, path), nil, nil)
+}
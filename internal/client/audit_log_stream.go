@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	AuditLogStreamsEndpoint = "/1/system/audit-log-streams"
+)
+
+type AuditLogStream struct {
+	Id          string   `json:"id"`
+	Name        string   `json:"name"`
+	SinkType    string   `json:"sinkType"`
+	Destination string   `json:"destination"`
+	Region      *string  `json:"region"`
+	Format      string   `json:"format"`
+	Categories  []string `json:"categories"`
+	IsEnabled   bool     `json:"isEnabled"`
+	// Delivery status: active, failing or disabled
+	Status          string  `json:"status"`
+	LastDeliveredAt *string `json:"lastDeliveredAt"`
+}
+
+// AuditLogStreamOptions defines the payload for creating or replacing an audit log stream
+type AuditLogStreamOptions struct {
+	Name        string   `json:"name"`
+	SinkType    string   `json:"sinkType"`
+	Destination string   `json:"destination"`
+	Region      *string  `json:"region,omitempty"`
+	Format      string   `json:"format"`
+	Categories  []string `json:"categories"`
+	IsEnabled   bool     `json:"isEnabled"`
+	// The current secret is kept when unset
+	Secret *string `json:"secret,omitempty"`
+}
+
+// CreateAuditLogStream starts sending the audit events to an external sink
+// POST /1/system/audit-log-streams
+func (c *Client) CreateAuditLogStream(opts AuditLogStreamOptions) (*AuditLogStream, error) {
+	var s AuditLogStream
+	err := c.create(AuditLogStreamsEndpoint, opts, &s)
+	return &s, err
+}
+
+// GetAuditLogStream retrieves an audit log stream by its ID
+// GET /1/system/audit-log-streams/:id
+func (c *Client) GetAuditLogStream(id string) (*AuditLogStream, error) {
+	var s AuditLogStream
+	endpoint := fmt.Sprintf("%s/%s", AuditLogStreamsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &s)
+	return &s, err
+}
+
+// UpdateAuditLogStream replaces an audit log stream
+// PUT /1/system/audit-log-streams/:id
+func (c *Client) UpdateAuditLogStream(id string, opts AuditLogStreamOptions) (*AuditLogStream, error) {
+	var s AuditLogStream
+	endpoint := fmt.Sprintf("%s/%s", AuditLogStreamsEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &s)
+	return &s, err
+}
+
+// DeleteAuditLogStream stops an audit log stream by its ID
+// DELETE /1/system/audit-log-streams/:id
+func (c *Client) DeleteAuditLogStream(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", AuditLogStreamsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
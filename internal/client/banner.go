@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	BannersEndpoint = "/1/system/banners"
+)
+
+type Banner struct {
+	Id               string `json:"id"`
+	Title            string `json:"title"`
+	Message          string `json:"message"`
+	Severity         string `json:"severity"`
+	StartsAt         string `json:"startsAt"`
+	EndsAt           string `json:"endsAt"`
+	ShowOnStatusPage bool   `json:"showOnStatusPage"`
+	Status           string `json:"status"`
+}
+
+// BannerOptions defines the payload for publishing a maintenance notice
+type BannerOptions struct {
+	Title            string `json:"title"`
+	Message          string `json:"message"`
+	Severity         string `json:"severity"`
+	StartsAt         string `json:"startsAt"`
+	EndsAt           string `json:"endsAt"`
+	ShowOnStatusPage bool   `json:"showOnStatusPage"`
+}
+
+// CreateBanner publishes a maintenance notice on the banner and the status endpoint
+// POST /1/system/banners
+func (c *Client) CreateBanner(opts BannerOptions) (*Banner, error) {
+	var b Banner
+	err := c.create(BannersEndpoint, opts, &b)
+	return &b, err
+}
+
+// GetBanner retrieves a maintenance notice by its ID
+// GET /1/system/banners/:id
+func (c *Client) GetBanner(id string) (*Banner, error) {
+	var b Banner
+	endpoint := fmt.Sprintf("%s/%s", BannersEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &b)
+	return &b, err
+}
+
+// UpdateBanner replaces a maintenance notice
+// PUT /1/system/banners/:id
+func (c *Client) UpdateBanner(id string, opts BannerOptions) (*Banner, error) {
+	var b Banner
+	endpoint := fmt.Sprintf("%s/%s", BannersEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &b)
+	return &b, err
+}
+
+// DeleteBanner withdraws a maintenance notice by its ID
+// DELETE /1/system/banners/:id
+func (c *Client) DeleteBanner(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", BannersEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
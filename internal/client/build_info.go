@@ -0,0 +1,75 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	BuildsEndpoint = "/builds"
+)
+
+// BuildInfo is the metadata of a CI build, linking the artifacts it published
+// to the run and the sources they were built from.
+type BuildInfo struct {
+	Name   string `json:"name"`
+	Number string `json:"number"`
+	// Start date of the build (RFC 3339)
+	StartedAt *string `json:"startedAt"`
+	// Link to the CI run
+	Url     *string       `json:"url"`
+	Vcs     *BuildVcs     `json:"vcs"`
+	Modules []BuildModule `json:"modules"`
+}
+
+// BuildVcs locates the sources of a build.
+type BuildVcs struct {
+	Url      *string `json:"url"`
+	Revision string  `json:"revision"`
+	Branch   *string `json:"branch"`
+}
+
+// BuildModule is a unit of a build with the artifacts it published.
+type BuildModule struct {
+	Id            string   `json:"id"`
+	RepositoryId  string   `json:"repositoryId"`
+	ArtifactPaths []string `json:"artifactPaths"`
+}
+
+// BuildInfoOptions defines the payload for publishing the metadata of a build,
+// replacing the existing one
+type BuildInfoOptions struct {
+	StartedAt *string       `json:"startedAt,omitempty"`
+	Url       *string       `json:"url,omitempty"`
+	Vcs       *BuildVcs     `json:"vcs,omitempty"`
+	Modules   []BuildModule `json:"modules"`
+}
+
+func buildInfoEndpoint(workspace string, name string, number string) string {
+	return fmt.Sprintf("%s/%s%s/%s/%s", repoflow.WorkspacesEndpoint, workspace, BuildsEndpoint, name, number)
+}
+
+// GetBuildInfo retrieves the metadata of a build
+// GET /1/workspaces/:workspace/builds/:name/:number
+func (c *Client) GetBuildInfo(workspace string, name string, number string) (*BuildInfo, error) {
+	var bi BuildInfo
+	err := c.DoRequest(http.MethodGet, buildInfoEndpoint(workspace, name, number), nil, &bi)
+	return &bi, err
+}
+
+// PutBuildInfo publishes the metadata of a build
+// PUT /1/workspaces/:workspace/builds/:name/:number
+func (c *Client) PutBuildInfo(workspace string, name string, number string, opts BuildInfoOptions) (*BuildInfo, error) {
+	var bi BuildInfo
+	err := c.DoRequest(http.MethodPut, buildInfoEndpoint(workspace, name, number), opts, &bi)
+	return &bi, err
+}
+
+// DeleteBuildInfo deletes the metadata of a build, its artifacts are kept
+// DELETE /1/workspaces/:workspace/builds/:name/:number
+func (c *Client) DeleteBuildInfo(workspace string, name string, number string) error {
+	return c.DoRequest(http.MethodDelete, buildInfoEndpoint(workspace, name, number), nil, nil)
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	CleanupPoliciesEndpoint = "/cleanup-policies"
+)
+
+// CleanupPolicy deletes the old artifacts uploaded to local repositories of a workspace.
+type CleanupPolicy struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	RepositoryIds []string `json:"repositoryIds"`
+	Patterns      []string `json:"patterns"`
+	KeepLast      *int     `json:"keepLast"`
+	MaxAgeDays    *int     `json:"maxAgeDays"`
+	Schedule      string   `json:"schedule"`
+	DryRun        bool     `json:"dryRun"`
+}
+
+// CleanupPolicyOptions defines the payload for creating or replacing a cleanup policy
+type CleanupPolicyOptions struct {
+	Name          string   `json:"name"`
+	RepositoryIds []string `json:"repositoryIds"`
+	Patterns      []string `json:"patterns"`
+	KeepLast      *int     `json:"keepLast"`
+	MaxAgeDays    *int     `json:"maxAgeDays"`
+	Schedule      string   `json:"schedule"`
+	DryRun        bool     `json:"dryRun"`
+}
+
+func cleanupPoliciesEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, CleanupPoliciesEndpoint)
+}
+
+// CreateCleanupPolicy creates a new cleanup policy in a workspace
+// POST /1/workspaces/:workspace/cleanup-policies
+func (c *Client) CreateCleanupPolicy(workspace string, opts CleanupPolicyOptions) (*CleanupPolicy, error) {
+	var cp CleanupPolicy
+	err := c.create(cleanupPoliciesEndpoint(workspace), opts, &cp)
+	return &cp, err
+}
+
+// GetCleanupPolicy retrieves a cleanup policy
+// GET /1/workspaces/:workspace/cleanup-policies/:id
+func (c *Client) GetCleanupPolicy(workspace string, id string) (*CleanupPolicy, error) {
+	var cp CleanupPolicy
+	endpoint := fmt.Sprintf("%s/%s", cleanupPoliciesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &cp)
+	return &cp, err
+}
+
+// UpdateCleanupPolicy replaces the settings of a cleanup policy
+// PUT /1/workspaces/:workspace/cleanup-policies/:id
+func (c *Client) UpdateCleanupPolicy(workspace string, id string, opts CleanupPolicyOptions) (*CleanupPolicy, error) {
+	var cp CleanupPolicy
+	endpoint := fmt.Sprintf("%s/%s", cleanupPoliciesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &cp)
+	return &cp, err
+}
+
+// DeleteCleanupPolicy deletes a cleanup policy
+// DELETE /1/workspaces/:workspace/cleanup-policies/:id
+func (c *Client) DeleteCleanupPolicy(workspace string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", cleanupPoliciesEndpoint(workspace), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
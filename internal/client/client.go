@@ -0,0 +1,87 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"slices"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Client extends the go-repoflow client with the endpoints it does not cover yet.
+// Every method of the upstream client stays available through embedding.
+type Client struct {
+	*repoflow.Client
+
+	// Checks the region of the workspaces read, see SetRegion
+	region *regionTransport
+
+	// Headers added to every request
+	headers http.Header
+
+	// Client of the creations, sent with an idempotency key
+	creator     *repoflow.Client
+	idempotency *idempotencyTransport
+
+	// Client of the package endpoints, whose 404 responses are results rather
+	// than missing objects
+	endpointClient *http.Client
+}
+
+// NewClient returns a client for the given base URLs. When more than one is
+// given, the next one is used when the current one is unreachable.
+func NewClient(baseUrls []string, token string) *Client {
+	c := repoflow.NewClient(baseUrls[0], token)
+
+	var transport http.RoundTripper = http.DefaultTransport
+	if len(baseUrls) > 1 {
+		transport = &failoverTransport{base: transport, baseUrls: baseUrls}
+	}
+	headers := http.Header{}
+	region := &regionTransport{base: &notFoundTransport{base: transport}, regions: map[string]string{}}
+	c.HTTPClient.Transport = &headerTransport{
+		base:    region,
+		headers: headers,
+	}
+
+	idempotency := &idempotencyTransport{base: &notFoundTransport{base: transport}, logCtx: context.Background()}
+	creator := *c
+	creator.HTTPClient = &http.Client{
+		Timeout:   c.HTTPClient.Timeout,
+		Transport: &headerTransport{base: idempotency, headers: headers},
+	}
+
+	return &Client{
+		Client:      c,
+		headers:     headers,
+		region:      region,
+		creator:     &creator,
+		idempotency: idempotency,
+		endpointClient: &http.Client{
+			Timeout:   c.HTTPClient.Timeout,
+			Transport: &headerTransport{base: transport, headers: headers},
+		},
+	}
+}
+
+// Instance returns a client for another RepoFlow instance, like the target of a
+// migration, built like this one: same transports, headers and log context.
+func (c *Client) Instance(baseUrls []string, token string) API {
+	other := NewClient(baseUrls, token)
+	for k, v := range c.headers {
+		other.headers[k] = slices.Clone(v)
+	}
+	other.idempotency.logCtx = c.idempotency.logCtx
+
+	return other
+}
+
+// SetRunId tags every request with the Terraform run sending it.
+func (c *Client) SetRunId(id string) {
+	c.headers.Set(RunIdHeader, id)
+}
+
+// SetUserAgent names the provider in the User-Agent header of every request.
+func (c *Client) SetUserAgent(userAgent string) {
+	c.headers.Set("User-Agent", userAgent)
+}
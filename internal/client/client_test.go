@@ -0,0 +1,43 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestInstance(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Clone()
+		_, _ = w.Write([]byte(`{"id": "ws-1", "name": "platform"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient([]string{closedURL(t)}, "token")
+	c.SetRunId("run-1")
+	c.SetUserAgent("terraform-provider-repoflow/test")
+
+	// The other instance gets its own base URL and token, and the headers of the client
+	if _, err := c.Instance([]string{srv.URL}, "other-token").GetWorkspace("platform"); err != nil {
+		t.Fatalf("GetWorkspace: %s", err)
+	}
+	for name, want := range map[string]string{
+		"Authorization": "Bearer other-token",
+		RunIdHeader:     "run-1",
+		"User-Agent":    "terraform-provider-repoflow/test",
+	} {
+		if got.Get(name) != want {
+			t.Errorf("%s = %q, want %q", name, got.Get(name), want)
+		}
+	}
+
+	// Not found errors are detected on the other instance too
+	srv.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"errors": ["workspace not found"]}`))
+	})
+	if _, err := c.Instance([]string{srv.URL}, "other-token").GetWorkspace("platform"); !IsNotFound(err) {
+		t.Errorf("error = %v, want a not found error", err)
+	}
+}
@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	CorsPoliciesEndpoint = "/1/settings/cors"
+)
+
+// Endpoints covered by a CORS policy
+const (
+	CorsTargetApi      = "api"
+	CorsTargetRegistry = "registry"
+)
+
+// CorsPolicy defines the cross-origin requests accepted by the API or the
+// registry endpoints, so browser applications can call them directly. There is
+// one policy per target.
+type CorsPolicy struct {
+	Target string `json:"target"`
+	// Origins like https://app.example.com, or * for any origin
+	AllowedOrigins []string `json:"allowedOrigins"`
+	AllowedMethods []string `json:"allowedMethods"`
+	// Request headers accepted on top of the CORS-safelisted ones
+	AllowedHeaders []string `json:"allowedHeaders"`
+	// Response headers readable by the browser applications
+	ExposedHeaders   []string `json:"exposedHeaders"`
+	AllowCredentials bool     `json:"allowCredentials"`
+	// Seconds the browsers cache the preflight responses
+	MaxAgeSeconds int `json:"maxAgeSeconds"`
+}
+
+// CorsPolicyOptions defines the payload for replacing the CORS policy of a target
+type CorsPolicyOptions struct {
+	AllowedOrigins   []string `json:"allowedOrigins"`
+	AllowedMethods   []string `json:"allowedMethods"`
+	AllowedHeaders   []string `json:"allowedHeaders"`
+	ExposedHeaders   []string `json:"exposedHeaders"`
+	AllowCredentials bool     `json:"allowCredentials"`
+	MaxAgeSeconds    int      `json:"maxAgeSeconds"`
+}
+
+// GetCorsPolicy retrieves the CORS policy of a target, not found until set
+// GET /1/settings/cors/:target
+func (c *Client) GetCorsPolicy(target string) (*CorsPolicy, error) {
+	var cp CorsPolicy
+	endpoint := fmt.Sprintf("%s/%s", CorsPoliciesEndpoint, target)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &cp)
+	return &cp, err
+}
+
+// PutCorsPolicy replaces the CORS policy of a target
+// PUT /1/settings/cors/:target
+func (c *Client) PutCorsPolicy(target string, opts CorsPolicyOptions) (*CorsPolicy, error) {
+	var cp CorsPolicy
+	endpoint := fmt.Sprintf("%s/%s", CorsPoliciesEndpoint, target)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &cp)
+	return &cp, err
+}
+
+// DeleteCorsPolicy removes the CORS policy of a target, the cross-origin requests are then refused
+// DELETE /1/settings/cors/:target
+func (c *Client) DeleteCorsPolicy(target string) error {
+	endpoint := fmt.Sprintf("%s/%s", CorsPoliciesEndpoint, target)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
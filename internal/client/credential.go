@@ -0,0 +1,89 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	CredentialsEndpoint = "/credentials"
+)
+
+// Credential is a named credential set of a workspace, shared by its remote repositories.
+// The secrets are never returned by the API.
+type Credential struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	WorkspaceId string  `json:"workspaceId"`
+	Description *string `json:"description"`
+	Username    *string `json:"username"`
+	// Date of the last change of the password or the token
+	RotatedAt *string `json:"rotatedAt"`
+}
+
+// CredentialOptions defines the payload for creating a credential
+type CredentialOptions struct {
+	Name        string  `json:"name"`
+	Description *string `json:"description,omitempty"`
+	Username    *string `json:"username,omitempty"`
+	Password    *string `json:"password,omitempty"`
+	Token       *string `json:"token,omitempty"`
+}
+
+// CredentialUpdateOptions defines the payload for updating a credential, the
+// remote repositories using it get the new values
+type CredentialUpdateOptions struct {
+	Description *string `json:"description"`
+	Username    *string `json:"username"`
+	// The current secrets are kept when unset
+	Password *string `json:"password,omitempty"`
+	Token    *string `json:"token,omitempty"`
+}
+
+func credentialsEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, CredentialsEndpoint)
+}
+
+// ListCredentials retrieves the credentials of a workspace
+// GET /1/workspaces/:workspace/credentials
+func (c *Client) ListCredentials(workspace string) (*[]Credential, error) {
+	var crs []Credential
+	err := c.DoRequest(http.MethodGet, credentialsEndpoint(workspace), nil, &crs)
+	return &crs, err
+}
+
+// CreateCredential creates a new credential in a workspace
+// POST /1/workspaces/:workspace/credentials
+func (c *Client) CreateCredential(workspace string, opts CredentialOptions) (*Credential, error) {
+	var cr Credential
+	err := c.create(credentialsEndpoint(workspace), opts, &cr)
+	return &cr, err
+}
+
+// GetCredential retrieves the metadata of a credential
+// GET /1/workspaces/:workspace/credentials/:id
+func (c *Client) GetCredential(workspace string, id string) (*Credential, error) {
+	var cr Credential
+	endpoint := fmt.Sprintf("%s/%s", credentialsEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &cr)
+	return &cr, err
+}
+
+// UpdateCredential updates the values of a credential
+// PATCH /1/workspaces/:workspace/credentials/:id
+func (c *Client) UpdateCredential(workspace string, id string, opts CredentialUpdateOptions) (*Credential, error) {
+	var cr Credential
+	endpoint := fmt.Sprintf("%s/%s", credentialsEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &cr)
+	return &cr, err
+}
+
+// DeleteCredential deletes a credential, it must not be used by any remote repository
+// DELETE /1/workspaces/:workspace/credentials/:id
+func (c *Client) DeleteCredential(workspace string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", credentialsEndpoint(workspace), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
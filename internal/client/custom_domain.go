@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	CustomDomainsEndpoint = "/1/custom-domains"
+)
+
+type CustomDomain struct {
+	Id               string  `json:"id"`
+	Domain           string  `json:"domain"`
+	WorkspaceId      *string `json:"workspaceId,omitempty"`
+	CertificateId    *string `json:"certificateId,omitempty"`
+	ValidationStatus string  `json:"validationStatus"`
+	// DNS record proving the ownership of the domain
+	VerificationRecord *DomainVerificationRecord `json:"verificationRecord,omitempty"`
+}
+
+// DomainVerificationRecord describes the DNS record to create for the validation of a custom domain
+type DomainVerificationRecord struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// CustomDomainOptions defines the payload for creating a custom domain
+type CustomDomainOptions struct {
+	Domain        string  `json:"domain"`
+	WorkspaceId   *string `json:"workspaceId,omitempty"`
+	CertificateId *string `json:"certificateId,omitempty"`
+}
+
+// CustomDomainUpdateOptions defines the payload for updating a custom domain
+type CustomDomainUpdateOptions struct {
+	CertificateId *string `json:"certificateId"`
+}
+
+// CreateCustomDomain attaches a new custom domain to the instance or a workspace
+// POST /1/custom-domains
+func (c *Client) CreateCustomDomain(opts CustomDomainOptions) (*CustomDomain, error) {
+	var cd CustomDomain
+	err := c.create(CustomDomainsEndpoint, opts, &cd)
+	return &cd, err
+}
+
+// GetCustomDomain retrieves metadata for a specific custom domain
+// GET /1/custom-domains/:id
+func (c *Client) GetCustomDomain(id string) (*CustomDomain, error) {
+	var cd CustomDomain
+	endpoint := fmt.Sprintf("%s/%s", CustomDomainsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &cd)
+	return &cd, err
+}
+
+// UpdateCustomDomain updates a custom domain with the given options
+// PATCH /1/custom-domains/:id
+func (c *Client) UpdateCustomDomain(id string, opts CustomDomainUpdateOptions) (*CustomDomain, error) {
+	var cd CustomDomain
+	endpoint := fmt.Sprintf("%s/%s", CustomDomainsEndpoint, id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &cd)
+	return &cd, err
+}
+
+// DeleteCustomDomain removes a custom domain by its ID
+// DELETE /1/custom-domains/:id
+func (c *Client) DeleteCustomDomain(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", CustomDomainsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
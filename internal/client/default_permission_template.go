@@ -0,0 +1,52 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	DefaultPermissionsEndpoint = "/default-permissions"
+)
+
+// DefaultPermissionTemplate holds the roles granted on every repository created
+// in a workspace. The repositories existing when it changes are left untouched.
+type DefaultPermissionTemplate struct {
+	WorkspaceId string                 `json:"workspaceId"`
+	Grants      []RepositoryPermission `json:"grants"`
+}
+
+// DefaultPermissionTemplateOptions defines the payload for replacing the template of a workspace
+type DefaultPermissionTemplateOptions struct {
+	Grants []RepositoryPermission `json:"grants"`
+}
+
+func defaultPermissionsEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, DefaultPermissionsEndpoint)
+}
+
+// GetDefaultPermissionTemplate retrieves the permission template of a workspace
+// GET /1/workspaces/:workspace/default-permissions
+func (c *Client) GetDefaultPermissionTemplate(workspace string) (*DefaultPermissionTemplate, error) {
+	var dp DefaultPermissionTemplate
+	err := c.DoRequest(http.MethodGet, defaultPermissionsEndpoint(workspace), nil, &dp)
+	return &dp, err
+}
+
+// PutDefaultPermissionTemplate replaces the permission template of a workspace
+// PUT /1/workspaces/:workspace/default-permissions
+func (c *Client) PutDefaultPermissionTemplate(workspace string, opts DefaultPermissionTemplateOptions) (*DefaultPermissionTemplate, error) {
+	var dp DefaultPermissionTemplate
+	err := c.DoRequest(http.MethodPut, defaultPermissionsEndpoint(workspace), opts, &dp)
+	return &dp, err
+}
+
+// DeleteDefaultPermissionTemplate removes the permission template of a workspace,
+// the new repositories are then created without grants
+// DELETE /1/workspaces/:workspace/default-permissions
+func (c *Client) DeleteDefaultPermissionTemplate(workspace string) error {
+	return c.DoRequest(http.MethodDelete, defaultPermissionsEndpoint(workspace), nil, nil)
+}
@@ -0,0 +1,105 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// ErrNotFound is returned by every request answered with a 404 status and a
+// RepoFlow error body.
+var ErrNotFound = errors.New("resource not found")
+
+// ErrUnsupported is returned by the requests to an endpoint the instance does not
+// serve, like one added by a later RepoFlow version.
+var ErrUnsupported = errors.New("endpoint not supported")
+
+// unsupportedError keeps the message of a response matching ErrUnsupported.
+type unsupportedError string
+
+func (e unsupportedError) Error() string {
+	return string(e)
+}
+
+func (e unsupportedError) Is(target error) bool {
+	return target == ErrUnsupported
+}
+
+// Maximum size of a 404 body read to look for a RepoFlow error
+const maxNotFoundBody = 64 << 10
+
+// notFoundTransport turns 404 responses into ErrNotFound. The upstream client
+// flattens the status into its error message, so we catch it before it does.
+//
+// Only the 404 carrying a RepoFlow error body mean the object is missing. Any
+// other 404, like a proxy answering for a wrong base URL, is an error: treating
+// it as missing would drop every resource from the state. Like the 405 and 501
+// responses, it matches ErrUnsupported as the instance may not serve the route.
+type notFoundTransport struct {
+	base http.RoundTripper
+}
+
+func (t *notFoundTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusNotImplemented:
+	default:
+		return resp, nil
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxNotFoundBody))
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %d response: %w", resp.StatusCode, err)
+	}
+
+	message, ok := apiErrorMessage(body)
+	if resp.StatusCode != http.StatusNotFound {
+		if !ok {
+			message = http.StatusText(resp.StatusCode)
+		}
+		return nil, unsupportedError(fmt.Sprintf("%s %s answered %d: %s", req.Method, req.URL.Redacted(), resp.StatusCode, message))
+	}
+
+	if ok {
+		return nil, fmt.Errorf("%w: %s", ErrNotFound, message)
+	}
+
+	return nil, unsupportedError(fmt.Sprintf("%s %s answered 404 without a RepoFlow error, check the provider base URL", req.Method, req.URL.Redacted()))
+}
+
+// apiErrorMessage returns the message of a RepoFlow error body, either a list of
+// errors or a code and a message.
+func apiErrorMessage(body []byte) (string, bool) {
+	var errs repoflow.APIErrors
+	if err := json.Unmarshal(body, &errs); err == nil && len(errs.Errors) > 0 {
+		return strings.Join(errs.Errors, "; "), true
+	}
+
+	var apiErr repoflow.APIError
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+		return apiErr.Message, true
+	}
+
+	return "", false
+}
+
+// IsNotFound reports whether the error comes from a 404 API response.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrNotFound)
+}
+
+// IsUnsupported reports whether the error comes from an endpoint the instance
+// does not serve.
+func IsUnsupported(err error) bool {
+	return errors.Is(err, ErrUnsupported)
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNotFound(t *testing.T) {
+	for name, tc := range map[string]struct {
+		status      int
+		body        string
+		notFound    bool
+		unsupported bool
+		err         string
+	}{
+		"error list":         {status: http.StatusNotFound, body: `{"errors": ["workspace not found"]}`, notFound: true, err: "workspace not found"},
+		"error message":      {status: http.StatusNotFound, body: `{"code": "not_found", "message": "no such repository"}`, notFound: true, err: "no such repository"},
+		"empty body":         {status: http.StatusNotFound, body: ``, unsupported: true, err: "check the provider base URL"},
+		"html page":          {status: http.StatusNotFound, body: `<html><body>Not Found</body></html>`, unsupported: true, err: "check the provider base URL"},
+		"empty error list":   {status: http.StatusNotFound, body: `{"errors": []}`, unsupported: true, err: "check the provider base URL"},
+		"method not allowed": {status: http.StatusMethodNotAllowed, body: ``, unsupported: true, err: "answered 405: Method Not Allowed"},
+		"not implemented":    {status: http.StatusNotImplemented, body: `{"errors": ["statistics disabled"]}`, unsupported: true, err: "answered 501: statistics disabled"},
+		"other status code":  {status: http.StatusForbidden, body: `{"errors": ["forbidden"]}`, err: "forbidden"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tc.status)
+				_, _ = w.Write([]byte(tc.body))
+			}))
+			defer srv.Close()
+
+			_, err := NewClient([]string{srv.URL}, "token").GetRepositoryStats("ws", "rp", "24h")
+
+			if err == nil {
+				t.Fatal("no error")
+			}
+			if IsNotFound(err) != tc.notFound {
+				t.Errorf("IsNotFound(%q) = %v, want %v", err, IsNotFound(err), tc.notFound)
+			}
+			if IsUnsupported(err) != tc.unsupported {
+				t.Errorf("IsUnsupported(%q) = %v, want %v", err, IsUnsupported(err), tc.unsupported)
+			}
+			if !strings.Contains(err.Error(), tc.err) {
+				t.Errorf("error %q does not mention %q", err, tc.err)
+			}
+		})
+	}
+}
+
+func TestNotFoundEndpointCheck(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("method = %s, want HEAD", r.Method)
+		}
+		if r.URL.Path == "/npm/platform/npm-local/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	c := NewClient([]string{srv.URL}, "token")
+
+	// A HEAD 404 has no body, it is a result and not a misconfigured base URL
+	for path, status := range map[string]int{"missing": http.StatusNotFound, "lodash": http.StatusOK} {
+		check, err := c.CheckRepositoryEndpoint("npm", "platform", "npm-local", path)
+		if err != nil {
+			t.Fatalf("CheckRepositoryEndpoint(%s): %s", path, err)
+		}
+		if check.StatusCode != status {
+			t.Errorf("CheckRepositoryEndpoint(%s) status = %d, want %d", path, check.StatusCode, status)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	ExceptionsEndpoint = "/1/security/exceptions"
+)
+
+type Exception struct {
+	Id          string  `json:"id"`
+	WorkspaceId *string `json:"workspaceId"`
+	PackageType string  `json:"packageType"`
+	PackageName string  `json:"packageName"`
+	Version     *string `json:"version"`
+	Reason      string  `json:"reason"`
+	Approver    string  `json:"approver"`
+	ExpiresAt   string  `json:"expiresAt"`
+}
+
+// ExceptionOptions defines the payload for creating a policy exception
+type ExceptionOptions struct {
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+	PackageType string  `json:"packageType"`
+	PackageName string  `json:"packageName"`
+	Version     *string `json:"version,omitempty"`
+	Reason      string  `json:"reason"`
+	Approver    string  `json:"approver"`
+	ExpiresAt   string  `json:"expiresAt"`
+}
+
+// ExceptionUpdateOptions defines the payload for updating a policy exception
+type ExceptionUpdateOptions struct {
+	Reason    string `json:"reason"`
+	Approver  string `json:"approver"`
+	ExpiresAt string `json:"expiresAt"`
+}
+
+// CreateException allows a blocked package until the exception expires
+// POST /1/security/exceptions
+func (c *Client) CreateException(opts ExceptionOptions) (*Exception, error) {
+	var ex Exception
+	err := c.create(ExceptionsEndpoint, opts, &ex)
+	return &ex, err
+}
+
+// GetException retrieves a policy exception, expired exceptions are not found
+// GET /1/security/exceptions/:id
+func (c *Client) GetException(id string) (*Exception, error) {
+	var ex Exception
+	endpoint := fmt.Sprintf("%s/%s", ExceptionsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &ex)
+	return &ex, err
+}
+
+// UpdateException updates the justification or the expiry of a policy exception
+// PATCH /1/security/exceptions/:id
+func (c *Client) UpdateException(id string, opts ExceptionUpdateOptions) (*Exception, error) {
+	var ex Exception
+	endpoint := fmt.Sprintf("%s/%s", ExceptionsEndpoint, id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &ex)
+	return &ex, err
+}
+
+// DeleteException revokes a policy exception by its ID
+// DELETE /1/security/exceptions/:id
+func (c *Client) DeleteException(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", ExceptionsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
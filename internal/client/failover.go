@@ -0,0 +1,97 @@
+package client
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// failoverTransport sends requests to the first reachable base URL. Requests
+// are built against the first base URL, the prefix is swapped with the active
+// one. The active base URL only changes when the current one cannot be
+// connected to, so every request of an apply sticks to the same endpoint.
+type failoverTransport struct {
+	base     http.RoundTripper
+	baseUrls []string
+
+	mu      sync.Mutex
+	current int
+}
+
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	target := req.URL.String()
+	if !strings.HasPrefix(target, t.baseUrls[0]) {
+		return t.base.RoundTrip(req)
+	}
+	endpoint := strings.TrimPrefix(target, t.baseUrls[0])
+
+	t.mu.Lock()
+	start := t.current
+	t.mu.Unlock()
+
+	var lastErr error
+	for i := range t.baseUrls {
+		idx := (start + i) % len(t.baseUrls)
+
+		r, err := rewriteRequest(req, t.baseUrls[idx]+endpoint)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := t.base.RoundTrip(r)
+		if err == nil {
+			if idx != start {
+				t.mu.Lock()
+				t.current = idx
+				t.mu.Unlock()
+			}
+			return resp, nil
+		}
+
+		if !isConnectionError(err) {
+			return nil, err
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+// rewriteRequest clones the request for another URL with a fresh body.
+func rewriteRequest(req *http.Request, target string) (*http.Request, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, err
+	}
+
+	r := req.Clone(req.Context())
+	r.URL = u
+	r.Host = ""
+
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+
+	return r, nil
+}
+
+// isConnectionError reports whether the request never reached the server: the
+// name did not resolve or the connection was refused. A reset or a timeout once
+// connected may come after the server received the request, sending it to
+// another base URL could then apply a creation or an update twice.
+func isConnectionError(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
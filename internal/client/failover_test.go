@@ -0,0 +1,94 @@
+package client
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// closedURL returns the URL of a port nothing listens on anymore.
+func closedURL(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Listen: %s", err)
+	}
+	l.Close()
+
+	return "http://" + l.Addr().String()
+}
+
+func TestFailover(t *testing.T) {
+	var hits [2]atomic.Int32
+	var servers [2]*httptest.Server
+	for i := range servers {
+		servers[i] = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			hits[i].Add(1)
+			_, _ = w.Write([]byte(`{"id": "ws-1", "name": "platform"}`))
+		}))
+		defer servers[i].Close()
+	}
+
+	c := NewClient([]string{closedURL(t), servers[0].URL, servers[1].URL}, "token")
+
+	get := func() {
+		t.Helper()
+		if _, err := c.GetWorkspace("platform"); err != nil {
+			t.Fatalf("GetWorkspace: %s", err)
+		}
+	}
+
+	// The unreachable base URL is skipped, and the next one kept for the following requests
+	get()
+	get()
+	if got := [2]int32{hits[0].Load(), hits[1].Load()}; got != [2]int32{2, 0} {
+		t.Errorf("hits = %v, want [2 0]", got)
+	}
+
+	// The active base URL only changes once it becomes unreachable
+	servers[0].Close()
+	get()
+	get()
+	if got := [2]int32{hits[0].Load(), hits[1].Load()}; got != [2]int32{2, 2} {
+		t.Errorf("hits = %v, want [2 2]", got)
+	}
+}
+
+func TestFailoverReset(t *testing.T) {
+	var received atomic.Int32
+	reset := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received.Add(1)
+
+		// The connection is reset after the request reached the server
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("Hijack: %s", err)
+			return
+		}
+		_ = conn.(*net.TCPConn).SetLinger(0)
+		conn.Close()
+	}))
+	defer reset.Close()
+
+	var other atomic.Int32
+	standby := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		other.Add(1)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer standby.Close()
+
+	c := NewClient([]string{reset.URL, standby.URL}, "token")
+
+	if _, err := c.UpdateWorkspace("platform", WorkspaceUpdateOptions{}); err == nil {
+		t.Fatal("no error on a connection reset after the request was sent")
+	}
+	if received.Load() != 1 {
+		t.Errorf("request received %d times, want 1", received.Load())
+	}
+	if other.Load() != 0 {
+		t.Error("request sent again to the next base URL")
+	}
+}
@@ -0,0 +1,90 @@
+package fake
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// newToken returns a random token value.
+func newToken() string {
+	b := make([]byte, 24)
+	_, _ = rand.Read(b)
+	return "pat_" + hex.EncodeToString(b)
+}
+
+// checkTokenTtl refuses the tokens living longer than the session policy allows.
+// It must be called with the lock held.
+func (c *Client) checkTokenTtl(expiresAt *string) error {
+	if c.sessionPolicy == nil || c.sessionPolicy.MaxTokenTtlDays == nil {
+		return nil
+	}
+
+	maxTtl := time.Duration(*c.sessionPolicy.MaxTokenTtlDays) * 24 * time.Hour
+	if expiresAt == nil {
+		return fmt.Errorf("tokens must expire within %d days", *c.sessionPolicy.MaxTokenTtlDays)
+	}
+
+	expiry, err := time.Parse(time.RFC3339, *expiresAt)
+	if err != nil {
+		return err
+	}
+	if time.Until(expiry) > maxTtl {
+		return fmt.Errorf("tokens must expire within %d days", *c.sessionPolicy.MaxTokenTtlDays)
+	}
+
+	return nil
+}
+
+func (c *Client) CreateAccessToken(opts client.AccessTokenOptions) (*client.AccessToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := c.checkTokenTtl(opts.ExpiresAt); err != nil {
+		return nil, err
+	}
+
+	at := client.AccessToken{
+		Id:          c.newId(),
+		Description: opts.Description,
+		Scopes:      slices.Clone(opts.Scopes),
+		ExpiresAt:   opts.ExpiresAt,
+		CreatedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+	c.accessTokens[at.Id] = at
+
+	// The value is only given back on creation
+	token := newToken()
+	at.Token = &token
+
+	return &at, nil
+}
+
+func (c *Client) GetAccessToken(id string) (*client.AccessToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	at, ok := c.accessTokens[id]
+	if !ok {
+		return nil, notFound("access token", id)
+	}
+	at.Scopes = slices.Clone(at.Scopes)
+
+	return &at, nil
+}
+
+func (c *Client) DeleteAccessToken(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.accessTokens[id]; !ok {
+		return notFound("access token", id)
+	}
+	delete(c.accessTokens, id)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetAnonymousAccess(workspace string, repository string) (*client.AnonymousAccess, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	aa, ok := c.anonymousAccess[key]
+	if !ok {
+		return nil, notFound("anonymous access", key)
+	}
+
+	aa.PackageTypes = slices.Clone(aa.PackageTypes)
+	return &aa, nil
+}
+
+func (c *Client) PutAnonymousAccess(workspace string, repository string, opts client.AnonymousAccessOptions) (*client.AnonymousAccess, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, workspaceId, repositoryId, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	aa := client.AnonymousAccess{
+		WorkspaceId:  workspaceId,
+		RepositoryId: repositoryId,
+		Mode:         opts.Mode,
+		PackageTypes: slices.Clone(opts.PackageTypes),
+	}
+	c.anonymousAccess[key] = aa
+
+	aa.PackageTypes = slices.Clone(aa.PackageTypes)
+	return &aa, nil
+}
+
+func (c *Client) DeleteAnonymousAccess(workspace string, repository string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.anonymousAccess[key]; !ok {
+		return notFound("anonymous access", key)
+	}
+
+	delete(c.anonymousAccess, key)
+
+	return nil
+}
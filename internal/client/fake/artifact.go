@@ -0,0 +1,85 @@
+package fake
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) UploadArtifact(workspace string, repository string, path string, content io.Reader) (*client.Artifact, error) {
+	// Read the content before taking the lock
+	b, err := io.ReadAll(content)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+	if rp.RepositoryType != "local" {
+		return nil, fmt.Errorf("repository %s is not a local repository", rp.Name)
+	}
+
+	sha256Sum := sha256.Sum256(b)
+	sha1Sum := sha1.Sum(b)
+	md5Sum := md5.Sum(b)
+
+	path = strings.TrimPrefix(path, "/")
+	artifact := client.Artifact{
+		Path:      path,
+		SizeBytes: int64(len(b)),
+		Sha256:    hex.EncodeToString(sha256Sum[:]),
+		Sha1:      hex.EncodeToString(sha1Sum[:]),
+		Md5:       hex.EncodeToString(md5Sum[:]),
+		CreatedAt: *now(),
+	}
+	c.artifacts[rp.Id+"/"+path] = artifact
+
+	return &artifact, nil
+}
+
+func (c *Client) GetArtifact(workspace string, repository string, path string) (*client.Artifact, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact, ok := c.artifacts[rp.Id+"/"+strings.TrimPrefix(path, "/")]
+	if !ok {
+		return nil, notFound("artifact", path)
+	}
+
+	return &artifact, nil
+}
+
+func (c *Client) DeleteArtifact(workspace string, repository string, path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	key := rp.Id + "/" + strings.TrimPrefix(path, "/")
+	if _, ok := c.artifacts[key]; !ok {
+		return notFound("artifact", path)
+	}
+	delete(c.artifacts, key)
+	delete(c.artifactProperties, key)
+
+	return nil
+}
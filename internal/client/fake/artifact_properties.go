@@ -0,0 +1,68 @@
+package fake
+
+import (
+	"maps"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetArtifactProperties(workspace string, repository string, path string) (*client.ArtifactProperties, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.TrimPrefix(path, "/")
+	if _, ok := c.artifacts[rp.Id+"/"+path]; !ok {
+		return nil, notFound("artifact", path)
+	}
+
+	return &client.ArtifactProperties{
+		Path:       path,
+		Properties: maps.Clone(c.artifactProperties[rp.Id+"/"+path]),
+	}, nil
+}
+
+func (c *Client) PutArtifactProperties(workspace string, repository string, path string, opts client.ArtifactPropertiesOptions) (*client.ArtifactProperties, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	path = strings.TrimPrefix(path, "/")
+	if _, ok := c.artifacts[rp.Id+"/"+path]; !ok {
+		return nil, notFound("artifact", path)
+	}
+
+	c.artifactProperties[rp.Id+"/"+path] = maps.Clone(opts.Properties)
+
+	return &client.ArtifactProperties{
+		Path:       path,
+		Properties: maps.Clone(opts.Properties),
+	}, nil
+}
+
+func (c *Client) DeleteArtifactProperties(workspace string, repository string, path string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	path = strings.TrimPrefix(path, "/")
+	if _, ok := c.artifacts[rp.Id+"/"+path]; !ok {
+		return notFound("artifact", path)
+	}
+	delete(c.artifactProperties, rp.Id+"/"+path)
+
+	return nil
+}
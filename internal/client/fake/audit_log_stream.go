@@ -0,0 +1,83 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// auditLogStream builds a stream from the options, delivering at once when enabled.
+func auditLogStream(id string, opts client.AuditLogStreamOptions) client.AuditLogStream {
+	s := client.AuditLogStream{
+		Id:          id,
+		Name:        opts.Name,
+		SinkType:    opts.SinkType,
+		Destination: opts.Destination,
+		Region:      opts.Region,
+		Format:      opts.Format,
+		Categories:  slices.Clone(opts.Categories),
+		IsEnabled:   opts.IsEnabled,
+		Status:      "disabled",
+	}
+
+	if s.IsEnabled {
+		s.Status = "active"
+		s.LastDeliveredAt = now()
+	}
+
+	return s
+}
+
+func (c *Client) CreateAuditLogStream(opts client.AuditLogStreamOptions) (*client.AuditLogStream, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, s := range c.auditLogStreams {
+		if s.Name == opts.Name {
+			return nil, conflict("audit log stream", opts.Name)
+		}
+	}
+
+	s := auditLogStream(c.newId(), opts)
+	c.auditLogStreams[s.Id] = s
+
+	return &s, nil
+}
+
+func (c *Client) GetAuditLogStream(id string) (*client.AuditLogStream, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	s, ok := c.auditLogStreams[id]
+	if !ok {
+		return nil, notFound("audit log stream", id)
+	}
+
+	return &s, nil
+}
+
+func (c *Client) UpdateAuditLogStream(id string, opts client.AuditLogStreamOptions) (*client.AuditLogStream, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.auditLogStreams[id]; !ok {
+		return nil, notFound("audit log stream", id)
+	}
+
+	s := auditLogStream(id, opts)
+	c.auditLogStreams[id] = s
+
+	return &s, nil
+}
+
+func (c *Client) DeleteAuditLogStream(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.auditLogStreams[id]; !ok {
+		return notFound("audit log stream", id)
+	}
+	delete(c.auditLogStreams, id)
+
+	return nil
+}
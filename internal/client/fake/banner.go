@@ -0,0 +1,86 @@
+package fake
+
+import (
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// bannerStatus computes the status of a notice from its window, like the API.
+func bannerStatus(b client.Banner) string {
+	now := time.Now()
+	if endsAt, err := time.Parse(time.RFC3339, b.EndsAt); err == nil && !now.Before(endsAt) {
+		return "ended"
+	}
+	if startsAt, err := time.Parse(time.RFC3339, b.StartsAt); err == nil && now.Before(startsAt) {
+		return "scheduled"
+	}
+	return "active"
+}
+
+func (c *Client) CreateBanner(opts client.BannerOptions) (*client.Banner, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b := client.Banner{
+		Id:               c.newId(),
+		Title:            opts.Title,
+		Message:          opts.Message,
+		Severity:         opts.Severity,
+		StartsAt:         opts.StartsAt,
+		EndsAt:           opts.EndsAt,
+		ShowOnStatusPage: opts.ShowOnStatusPage,
+	}
+	c.banners[b.Id] = b
+
+	b.Status = bannerStatus(b)
+	return &b, nil
+}
+
+func (c *Client) GetBanner(id string) (*client.Banner, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	b, ok := c.banners[id]
+	if !ok {
+		return nil, notFound("banner", id)
+	}
+
+	b.Status = bannerStatus(b)
+	return &b, nil
+}
+
+func (c *Client) UpdateBanner(id string, opts client.BannerOptions) (*client.Banner, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.banners[id]; !ok {
+		return nil, notFound("banner", id)
+	}
+
+	b := client.Banner{
+		Id:               id,
+		Title:            opts.Title,
+		Message:          opts.Message,
+		Severity:         opts.Severity,
+		StartsAt:         opts.StartsAt,
+		EndsAt:           opts.EndsAt,
+		ShowOnStatusPage: opts.ShowOnStatusPage,
+	}
+	c.banners[id] = b
+
+	b.Status = bannerStatus(b)
+	return &b, nil
+}
+
+func (c *Client) DeleteBanner(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.banners[id]; !ok {
+		return notFound("banner", id)
+	}
+	delete(c.banners, id)
+
+	return nil
+}
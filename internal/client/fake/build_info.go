@@ -0,0 +1,95 @@
+package fake
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// copyBuildInfo returns a copy of a build metadata, so the caller can't change the stored one.
+func copyBuildInfo(bi client.BuildInfo) client.BuildInfo {
+	if bi.Vcs != nil {
+		vcs := *bi.Vcs
+		bi.Vcs = &vcs
+	}
+
+	bi.Modules = slices.Clone(bi.Modules)
+	for i := range bi.Modules {
+		bi.Modules[i].ArtifactPaths = slices.Clone(bi.Modules[i].ArtifactPaths)
+	}
+
+	return bi
+}
+
+func (c *Client) GetBuildInfo(workspace string, name string, number string) (*client.BuildInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	bi, ok := c.buildInfos[ws.Id+"/"+name+"/"+number]
+	if !ok {
+		return nil, notFound("build", name+"/"+number)
+	}
+	bi = copyBuildInfo(bi)
+
+	return &bi, nil
+}
+
+func (c *Client) PutBuildInfo(workspace string, name string, number string, opts client.BuildInfoOptions) (*client.BuildInfo, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	// The published artifacts must exist
+	for _, module := range opts.Modules {
+		rp, err := c.repository(ws.Id, module.RepositoryId)
+		if err != nil {
+			return nil, err
+		}
+		for _, path := range module.ArtifactPaths {
+			path = strings.TrimPrefix(path, "/")
+			if _, ok := c.artifacts[rp.Id+"/"+path]; !ok {
+				return nil, notFound("artifact", path)
+			}
+		}
+	}
+
+	bi := copyBuildInfo(client.BuildInfo{
+		Name:      name,
+		Number:    number,
+		StartedAt: opts.StartedAt,
+		Url:       opts.Url,
+		Vcs:       opts.Vcs,
+		Modules:   opts.Modules,
+	})
+	c.buildInfos[ws.Id+"/"+name+"/"+number] = bi
+	bi = copyBuildInfo(bi)
+
+	return &bi, nil
+}
+
+func (c *Client) DeleteBuildInfo(workspace string, name string, number string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.buildInfos[ws.Id+"/"+name+"/"+number]; !ok {
+		return notFound("build", name+"/"+number)
+	}
+	delete(c.buildInfos, ws.Id+"/"+name+"/"+number)
+
+	return nil
+}
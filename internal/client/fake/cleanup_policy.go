@@ -0,0 +1,109 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// cleanupPolicy builds a cleanup policy of a workspace, checking its repositories.
+// It must be called with the lock held.
+func (c *Client) cleanupPolicy(ws *client.Workspace, id string, opts client.CleanupPolicyOptions) (client.CleanupPolicy, error) {
+	for _, repositoryId := range opts.RepositoryIds {
+		rp, err := c.repository(ws.Id, repositoryId)
+		if err != nil {
+			return client.CleanupPolicy{}, err
+		}
+		if rp.RepositoryType != "local" {
+			return client.CleanupPolicy{}, fmt.Errorf("repository %s is not a local repository", rp.Name)
+		}
+	}
+
+	return client.CleanupPolicy{
+		Id:            id,
+		Name:          opts.Name,
+		RepositoryIds: slices.Clone(opts.RepositoryIds),
+		Patterns:      slices.Clone(opts.Patterns),
+		KeepLast:      opts.KeepLast,
+		MaxAgeDays:    opts.MaxAgeDays,
+		Schedule:      opts.Schedule,
+		DryRun:        opts.DryRun,
+	}, nil
+}
+
+func (c *Client) CreateCleanupPolicy(workspace string, opts client.CleanupPolicyOptions) (*client.CleanupPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, err := c.cleanupPolicy(ws, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.cleanupPolicies[ws.Id+"/"+cp.Id] = cp
+
+	return &cp, nil
+}
+
+func (c *Client) GetCleanupPolicy(workspace string, id string) (*client.CleanupPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	cp, ok := c.cleanupPolicies[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("cleanup policy", id)
+	}
+	cp.RepositoryIds = slices.Clone(cp.RepositoryIds)
+	cp.Patterns = slices.Clone(cp.Patterns)
+
+	return &cp, nil
+}
+
+func (c *Client) UpdateCleanupPolicy(workspace string, id string, opts client.CleanupPolicyOptions) (*client.CleanupPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.cleanupPolicies[ws.Id+"/"+id]; !ok {
+		return nil, notFound("cleanup policy", id)
+	}
+
+	cp, err := c.cleanupPolicy(ws, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.cleanupPolicies[ws.Id+"/"+id] = cp
+
+	return &cp, nil
+}
+
+func (c *Client) DeleteCleanupPolicy(workspace string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.cleanupPolicies[ws.Id+"/"+id]; !ok {
+		return notFound("cleanup policy", id)
+	}
+	delete(c.cleanupPolicies, ws.Id+"/"+id)
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Package fake provides an in-memory implementation of client.API.
+//
+// It keeps every object in maps and answers like a RepoFlow instance would,
+// returning client.ErrNotFound for missing objects. It is meant for the unit
+// tests of the provider, served with provider.NewWithClient. Being internal, it
+// cannot be imported from other modules.
+package fake
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure the fake fully satisfies the interface.
+var _ client.API = &Client{}
+
+// Client is an in-memory RepoFlow. The zero value is not usable, use New.
+type Client struct {
+	mu  sync.Mutex
+	seq int
+
+	workspaces         map[string]*client.Workspace
+	repositories       map[string]*client.Repository
+	credentials        map[string]client.Credential
+	cleanupPolicies    map[string]client.CleanupPolicy
+	retentionPolicies  map[string]client.RetentionPolicy
+	signingKeys        map[string]client.SigningKey
+	members            map[string]client.VirtualRepositoryMember
+	permissions        map[string]client.RepositoryPermission
+	defaultPermissions map[string]client.DefaultPermissionTemplate
+	workspaceSettings  map[string]client.WorkspaceSettings
+	webhooks           map[string]client.RepositoryWebhook
+	pushMirrors        map[string]client.PushMirror
+	artifacts          map[string]client.Artifact
+	artifactProperties map[string]map[string]string
+	packageVersions    map[string]client.PackageVersion
+	roleAssignments    map[string]client.RoleAssignment
+	accessTokens       map[string]client.AccessToken
+	serviceAccounts    map[string]client.ServiceAccount
+	deprecations       map[string]client.PackageDeprecation
+	labelKeys          map[string]client.LabelKey
+	loginMessage       client.LoginMessage
+	ldapConfig         *client.LdapConfig
+	outboundProxy      *client.OutboundProxy
+	passwordPolicy     *client.PasswordPolicy
+	sessionPolicy      *client.SessionPolicy
+	mfaEnforcement     *client.MfaEnforcement
+	metricsExporter    client.MetricsExporter
+	oidcConfig         *client.OidcConfig
+	scimConfig         *client.ScimConfig
+	banners            map[string]client.Banner
+	auditLogStreams    map[string]client.AuditLogStream
+	storageBackends    map[string]client.StorageBackend
+	ipAllowlists       map[string]client.IpAllowlist
+	rateLimitPolicies  map[string]client.RateLimitPolicy
+	anonymousAccess    map[string]client.AnonymousAccess
+	scanPolicies       map[string]client.SecurityScanPolicy
+	allowedVulns       map[string]client.AllowedVulnerability
+	licensePolicies    map[string]client.LicensePolicy
+	uploadPolicies     map[string]client.UploadPolicy
+	immutableTagRules  map[string]client.ImmutableTagRule
+	trustedPublishers  map[string]client.TrustedPublisher
+	quarantineRules    map[string]client.QuarantineRule
+	promotionPipelines map[string]client.PromotionPipeline
+	buildInfos         map[string]client.BuildInfo
+	gcSchedules        map[string]client.GcSchedule
+	maintenanceWindows map[string]client.MaintenanceWindow
+	corsPolicies       map[string]client.CorsPolicy
+	customDomains      map[string]client.CustomDomain
+	tlsCertificates    map[string]client.TlsCertificate
+	malwareFeeds       map[string]client.MalwareFeedSubscription
+	exceptions         map[string]client.Exception
+	systemTasks        map[string]client.SystemTask
+	instances          map[string]*Client
+
+	// UpgradeCheck is returned as is by GetUpgradeCheck.
+	UpgradeCheck client.UpgradeCheck
+	// RepositoryStats is returned by GetRepositoryStats for every repository,
+	// with the requested period.
+	RepositoryStats client.RepositoryStats
+}
+
+// New returns an empty in-memory RepoFlow.
+func New() *Client {
+	return &Client{
+		workspaces:         map[string]*client.Workspace{},
+		repositories:       map[string]*client.Repository{},
+		credentials:        map[string]client.Credential{},
+		cleanupPolicies:    map[string]client.CleanupPolicy{},
+		retentionPolicies:  map[string]client.RetentionPolicy{},
+		signingKeys:        map[string]client.SigningKey{},
+		members:            map[string]client.VirtualRepositoryMember{},
+		permissions:        map[string]client.RepositoryPermission{},
+		defaultPermissions: map[string]client.DefaultPermissionTemplate{},
+		workspaceSettings:  map[string]client.WorkspaceSettings{},
+		webhooks:           map[string]client.RepositoryWebhook{},
+		pushMirrors:        map[string]client.PushMirror{},
+		artifacts:          map[string]client.Artifact{},
+		artifactProperties: map[string]map[string]string{},
+		packageVersions:    map[string]client.PackageVersion{},
+		roleAssignments:    map[string]client.RoleAssignment{},
+		accessTokens:       map[string]client.AccessToken{},
+		serviceAccounts:    map[string]client.ServiceAccount{},
+		deprecations:       map[string]client.PackageDeprecation{},
+		labelKeys:          map[string]client.LabelKey{},
+		banners:            map[string]client.Banner{},
+		auditLogStreams:    map[string]client.AuditLogStream{},
+		storageBackends:    map[string]client.StorageBackend{},
+		ipAllowlists:       map[string]client.IpAllowlist{},
+		rateLimitPolicies:  map[string]client.RateLimitPolicy{},
+		anonymousAccess:    map[string]client.AnonymousAccess{},
+		scanPolicies:       map[string]client.SecurityScanPolicy{},
+		licensePolicies:    map[string]client.LicensePolicy{},
+		uploadPolicies:     map[string]client.UploadPolicy{},
+		immutableTagRules:  map[string]client.ImmutableTagRule{},
+		trustedPublishers:  map[string]client.TrustedPublisher{},
+		quarantineRules:    map[string]client.QuarantineRule{},
+		promotionPipelines: map[string]client.PromotionPipeline{},
+		buildInfos:         map[string]client.BuildInfo{},
+		gcSchedules:        map[string]client.GcSchedule{},
+		maintenanceWindows: map[string]client.MaintenanceWindow{},
+		corsPolicies:       map[string]client.CorsPolicy{},
+		allowedVulns:       map[string]client.AllowedVulnerability{},
+		customDomains:      map[string]client.CustomDomain{},
+		tlsCertificates:    map[string]client.TlsCertificate{},
+		malwareFeeds:       map[string]client.MalwareFeedSubscription{},
+		exceptions:         map[string]client.Exception{},
+		systemTasks:        map[string]client.SystemTask{},
+		instances:          map[string]*Client{},
+	}
+}
+
+// newId returns a new identifier, shaped like the UUIDs of the API.
+// It must be called with the lock held.
+func (c *Client) newId() string {
+	c.seq++
+	return fmt.Sprintf("00000000-0000-4000-8000-%012d", c.seq)
+}
+
+// notFound wraps client.ErrNotFound so client.IsNotFound reports it.
+func notFound(kind string, id string) error {
+	return fmt.Errorf("%s %s: %w", kind, id, client.ErrNotFound)
+}
+
+// conflict reports an object created twice.
+func conflict(kind string, id string) error {
+	return fmt.Errorf("%s %s already exists", kind, id)
+}
+
+// deletePrefix removes the objects whose key starts with prefix, like the
+// objects held by a deleted repository.
+func deletePrefix[V any](m map[string]V, prefix string) {
+	for key := range m {
+		if strings.HasPrefix(key, prefix) {
+			delete(m, key)
+		}
+	}
+}
+
+// now returns the current date, formatted like the dates of the API.
+func now() *string {
+	s := time.Now().UTC().Format(time.RFC3339)
+	return &s
+}
+
+// stringOrNil returns nil for an empty string, like the optional fields of the API.
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,84 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// corsTarget checks the target of a CORS policy.
+func corsTarget(target string) error {
+	switch target {
+	case client.CorsTargetApi, client.CorsTargetRegistry:
+		return nil
+	}
+	return fmt.Errorf("unknown CORS target %q", target)
+}
+
+// cloneCorsPolicy copies the lists of a CORS policy, so the callers can not
+// alter the stored one.
+func cloneCorsPolicy(cp client.CorsPolicy) client.CorsPolicy {
+	cp.AllowedOrigins = slices.Clone(cp.AllowedOrigins)
+	cp.AllowedMethods = slices.Clone(cp.AllowedMethods)
+	cp.AllowedHeaders = slices.Clone(cp.AllowedHeaders)
+	cp.ExposedHeaders = slices.Clone(cp.ExposedHeaders)
+	return cp
+}
+
+func (c *Client) GetCorsPolicy(target string) (*client.CorsPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := corsTarget(target); err != nil {
+		return nil, err
+	}
+
+	cp, ok := c.corsPolicies[target]
+	if !ok {
+		return nil, notFound("CORS policy", target)
+	}
+
+	cp = cloneCorsPolicy(cp)
+	return &cp, nil
+}
+
+func (c *Client) PutCorsPolicy(target string, opts client.CorsPolicyOptions) (*client.CorsPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := corsTarget(target); err != nil {
+		return nil, err
+	}
+
+	// Like the API, follow the CORS specification forbidding credentials with any origin
+	if opts.AllowCredentials && slices.Contains(opts.AllowedOrigins, "*") {
+		return nil, fmt.Errorf("credentials can not be allowed with the * origin")
+	}
+
+	cp := cloneCorsPolicy(client.CorsPolicy{
+		Target:           target,
+		AllowedOrigins:   opts.AllowedOrigins,
+		AllowedMethods:   opts.AllowedMethods,
+		AllowedHeaders:   opts.AllowedHeaders,
+		ExposedHeaders:   opts.ExposedHeaders,
+		AllowCredentials: opts.AllowCredentials,
+		MaxAgeSeconds:    opts.MaxAgeSeconds,
+	})
+	c.corsPolicies[target] = cp
+
+	cp = cloneCorsPolicy(cp)
+	return &cp, nil
+}
+
+func (c *Client) DeleteCorsPolicy(target string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.corsPolicies[target]; !ok {
+		return notFound("CORS policy", target)
+	}
+	delete(c.corsPolicies, target)
+
+	return nil
+}
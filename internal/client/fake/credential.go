@@ -0,0 +1,124 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// credential finds a credential of a workspace by Id. It must be called with
+// the lock held.
+func (c *Client) credential(workspace string, id string) (*client.Credential, error) {
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	cr, ok := c.credentials[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("credential", id)
+	}
+
+	return &cr, nil
+}
+
+func (c *Client) ListCredentials(workspace string) (*[]client.Credential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	crs := []client.Credential{}
+	for _, cr := range c.credentials {
+		if cr.WorkspaceId == ws.Id {
+			crs = append(crs, cr)
+		}
+	}
+	sort.Slice(crs, func(i, j int) bool { return crs[i].Name < crs[j].Name })
+
+	return &crs, nil
+}
+
+func (c *Client) CreateCredential(workspace string, opts client.CredentialOptions) (*client.Credential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cr := range c.credentials {
+		if cr.WorkspaceId == ws.Id && cr.Name == opts.Name {
+			return nil, conflict("credential", opts.Name)
+		}
+	}
+
+	cr := client.Credential{
+		Id:          c.newId(),
+		Name:        opts.Name,
+		WorkspaceId: ws.Id,
+		Description: opts.Description,
+		Username:    opts.Username,
+		RotatedAt:   now(),
+	}
+	c.credentials[ws.Id+"/"+cr.Id] = cr
+
+	return &cr, nil
+}
+
+func (c *Client) GetCredential(workspace string, id string) (*client.Credential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.credential(workspace, id)
+}
+
+func (c *Client) UpdateCredential(workspace string, id string, opts client.CredentialUpdateOptions) (*client.Credential, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cr, err := c.credential(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	cr.Description = opts.Description
+	cr.Username = opts.Username
+	if opts.Password != nil || opts.Token != nil {
+		cr.RotatedAt = now()
+	}
+	c.credentials[cr.WorkspaceId+"/"+cr.Id] = *cr
+
+	return cr, nil
+}
+
+func (c *Client) DeleteCredential(workspace string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cr, err := c.credential(workspace, id)
+	if err != nil {
+		return err
+	}
+
+	// Like the API, refuse to delete a credential still used by a remote repository or a push mirror
+	for _, rp := range c.repositories {
+		if rp.CredentialId != nil && *rp.CredentialId == cr.Id {
+			return fmt.Errorf("credential %s is used by repository %s", cr.Name, rp.Name)
+		}
+	}
+	for _, pm := range c.pushMirrors {
+		if pm.CredentialId != nil && *pm.CredentialId == cr.Id {
+			return fmt.Errorf("credential %s is used by push mirror %s", cr.Name, pm.Url)
+		}
+	}
+
+	delete(c.credentials, cr.WorkspaceId+"/"+cr.Id)
+
+	return nil
+}
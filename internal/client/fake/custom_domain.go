@@ -0,0 +1,72 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreateCustomDomain(opts client.CustomDomainOptions) (*client.CustomDomain, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, cd := range c.customDomains {
+		if cd.Domain == opts.Domain {
+			return nil, conflict("custom domain", opts.Domain)
+		}
+	}
+
+	// The DNS validation never runs, the domain stays pending
+	cd := client.CustomDomain{
+		Id:               c.newId(),
+		Domain:           opts.Domain,
+		WorkspaceId:      opts.WorkspaceId,
+		CertificateId:    opts.CertificateId,
+		ValidationStatus: "pending",
+		VerificationRecord: &client.DomainVerificationRecord{
+			Name:  "_repoflow-challenge." + opts.Domain,
+			Type:  "TXT",
+			Value: "repoflow-verification=" + c.newId(),
+		},
+	}
+	c.customDomains[cd.Id] = cd
+
+	return &cd, nil
+}
+
+func (c *Client) GetCustomDomain(id string) (*client.CustomDomain, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cd, ok := c.customDomains[id]
+	if !ok {
+		return nil, notFound("custom domain", id)
+	}
+
+	return &cd, nil
+}
+
+func (c *Client) UpdateCustomDomain(id string, opts client.CustomDomainUpdateOptions) (*client.CustomDomain, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cd, ok := c.customDomains[id]
+	if !ok {
+		return nil, notFound("custom domain", id)
+	}
+
+	cd.CertificateId = opts.CertificateId
+	c.customDomains[id] = cd
+
+	return &cd, nil
+}
+
+func (c *Client) DeleteCustomDomain(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.customDomains[id]; !ok {
+		return notFound("custom domain", id)
+	}
+	delete(c.customDomains, id)
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package fake
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetDefaultPermissionTemplate(workspace string) (*client.DefaultPermissionTemplate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	dp, ok := c.defaultPermissions[ws.Id]
+	if !ok {
+		return nil, notFound("default permission template", ws.Id)
+	}
+
+	dp.Grants = slices.Clone(dp.Grants)
+	return &dp, nil
+}
+
+func (c *Client) PutDefaultPermissionTemplate(workspace string, opts client.DefaultPermissionTemplateOptions) (*client.DefaultPermissionTemplate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	dp := client.DefaultPermissionTemplate{
+		WorkspaceId: ws.Id,
+		Grants:      slices.Clone(opts.Grants),
+	}
+	// Like the API, return the grants ordered by principal
+	slices.SortFunc(dp.Grants, func(a, b client.RepositoryPermission) int {
+		return strings.Compare(a.Principal, b.Principal)
+	})
+	c.defaultPermissions[ws.Id] = dp
+
+	dp.Grants = slices.Clone(dp.Grants)
+	return &dp, nil
+}
+
+func (c *Client) DeleteDefaultPermissionTemplate(workspace string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.defaultPermissions[ws.Id]; !ok {
+		return notFound("default permission template", ws.Id)
+	}
+	delete(c.defaultPermissions, ws.Id)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreateException(opts client.ExceptionOptions) (*client.Exception, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ex := client.Exception{
+		Id:          c.newId(),
+		PackageType: opts.PackageType,
+		PackageName: opts.PackageName,
+		Version:     opts.Version,
+		Reason:      opts.Reason,
+		Approver:    opts.Approver,
+		ExpiresAt:   opts.ExpiresAt,
+	}
+
+	// Exceptions are stored with the workspace Id, even when given by name
+	if opts.WorkspaceId != nil {
+		ws, err := c.workspace(*opts.WorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+		ex.WorkspaceId = &ws.Id
+	}
+	c.exceptions[ex.Id] = ex
+
+	return &ex, nil
+}
+
+func (c *Client) GetException(id string) (*client.Exception, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ex, ok := c.exceptions[id]
+	if !ok {
+		return nil, notFound("exception", id)
+	}
+
+	return &ex, nil
+}
+
+func (c *Client) UpdateException(id string, opts client.ExceptionUpdateOptions) (*client.Exception, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ex, ok := c.exceptions[id]
+	if !ok {
+		return nil, notFound("exception", id)
+	}
+
+	ex.Reason = opts.Reason
+	ex.Approver = opts.Approver
+	ex.ExpiresAt = opts.ExpiresAt
+	c.exceptions[id] = ex
+
+	return &ex, nil
+}
+
+func (c *Client) DeleteException(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.exceptions[id]; !ok {
+		return notFound("exception", id)
+	}
+	delete(c.exceptions, id)
+
+	return nil
+}
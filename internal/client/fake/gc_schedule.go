@@ -0,0 +1,64 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// gcTask checks the name of a garbage collection task.
+func gcTask(task string) error {
+	switch task {
+	case client.GcTaskBlobs, client.GcTaskOrphanedMetadata:
+		return nil
+	}
+	return fmt.Errorf("unknown garbage collection task %q", task)
+}
+
+func (c *Client) GetGcSchedule(task string) (*client.GcSchedule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := gcTask(task); err != nil {
+		return nil, err
+	}
+
+	gs, ok := c.gcSchedules[task]
+	if !ok {
+		return nil, notFound("garbage collection schedule", task)
+	}
+
+	return &gs, nil
+}
+
+func (c *Client) PutGcSchedule(task string, opts client.GcScheduleOptions) (*client.GcSchedule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if err := gcTask(task); err != nil {
+		return nil, err
+	}
+
+	gs := client.GcSchedule{
+		Task:               task,
+		Schedule:           opts.Schedule,
+		Concurrency:        opts.Concurrency,
+		IsEnabled:          opts.IsEnabled,
+		MaxDurationMinutes: opts.MaxDurationMinutes,
+	}
+	c.gcSchedules[task] = gs
+
+	return &gs, nil
+}
+
+func (c *Client) DeleteGcSchedule(task string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.gcSchedules[task]; !ok {
+		return notFound("garbage collection schedule", task)
+	}
+	delete(c.gcSchedules, task)
+
+	return nil
+}
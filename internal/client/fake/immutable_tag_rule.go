@@ -0,0 +1,96 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// immutableTagRule builds an immutable tag rule of a local container repository.
+// It must be called with the lock held.
+func (c *Client) immutableTagRule(rp *client.Repository, id string, opts client.ImmutableTagRuleOptions) (client.ImmutableTagRule, error) {
+	if rp.RepositoryType != "local" || (rp.PackageType != "docker" && rp.PackageType != "oci") {
+		return client.ImmutableTagRule{}, fmt.Errorf("repository %s is not a local docker or oci repository", rp.Name)
+	}
+
+	return client.ImmutableTagRule{
+		Id:          id,
+		TagPattern:  opts.TagPattern,
+		Description: opts.Description,
+		IsEnabled:   opts.IsEnabled,
+	}, nil
+}
+
+func (c *Client) CreateImmutableTagRule(workspace string, repository string, opts client.ImmutableTagRuleOptions) (*client.ImmutableTagRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := c.immutableTagRule(rp, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.immutableTagRules[rp.Id+"/"+it.Id] = it
+
+	return &it, nil
+}
+
+func (c *Client) GetImmutableTagRule(workspace string, repository string, id string) (*client.ImmutableTagRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	it, ok := c.immutableTagRules[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("immutable tag rule", id)
+	}
+
+	return &it, nil
+}
+
+func (c *Client) UpdateImmutableTagRule(workspace string, repository string, id string, opts client.ImmutableTagRuleOptions) (*client.ImmutableTagRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.immutableTagRules[rp.Id+"/"+id]; !ok {
+		return nil, notFound("immutable tag rule", id)
+	}
+
+	it, err := c.immutableTagRule(rp, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.immutableTagRules[rp.Id+"/"+id] = it
+
+	return &it, nil
+}
+
+func (c *Client) DeleteImmutableTagRule(workspace string, repository string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.immutableTagRules[rp.Id+"/"+id]; !ok {
+		return notFound("immutable tag rule", id)
+	}
+	delete(c.immutableTagRules, rp.Id+"/"+id)
+
+	return nil
+}
@@ -0,0 +1,20 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Instance returns the in-memory RepoFlow served at the first base URL, empty
+// until the test fills it. The same base URL always returns the same instance.
+func (c *Client) Instance(baseUrls []string, token string) client.API {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	other, ok := c.instances[baseUrls[0]]
+	if !ok {
+		other = New()
+		c.instances[baseUrls[0]] = other
+	}
+
+	return other
+}
@@ -0,0 +1,83 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// ipAllowlistKey returns the key of the allowlist of a workspace, empty for the
+// instance. It must be called with the lock held.
+func (c *Client) ipAllowlistKey(workspace string) (string, error) {
+	if workspace == "" {
+		return "", nil
+	}
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return "", err
+	}
+
+	return ws.Id, nil
+}
+
+// copyIpAllowlist returns a copy of the allowlist safe to hand to the caller.
+func copyIpAllowlist(al client.IpAllowlist) *client.IpAllowlist {
+	al.Allow = slices.Clone(al.Allow)
+	al.Deny = slices.Clone(al.Deny)
+	return &al
+}
+
+func (c *Client) GetIpAllowlist(workspace string) (*client.IpAllowlist, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, err := c.ipAllowlistKey(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	al, ok := c.ipAllowlists[key]
+	if !ok {
+		return nil, notFound("ip allowlist", workspace)
+	}
+
+	return copyIpAllowlist(al), nil
+}
+
+func (c *Client) PutIpAllowlist(workspace string, opts client.IpAllowlistOptions) (*client.IpAllowlist, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, err := c.ipAllowlistKey(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	al := client.IpAllowlist{
+		WorkspaceId: stringOrNil(key),
+		Allow:       slices.Clone(opts.Allow),
+		Deny:        slices.Clone(opts.Deny),
+	}
+	c.ipAllowlists[key] = al
+
+	return copyIpAllowlist(al), nil
+}
+
+func (c *Client) DeleteIpAllowlist(workspace string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, err := c.ipAllowlistKey(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.ipAllowlists[key]; !ok {
+		return notFound("ip allowlist", workspace)
+	}
+
+	delete(c.ipAllowlists, key)
+
+	return nil
+}
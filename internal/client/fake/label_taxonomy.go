@@ -0,0 +1,61 @@
+package fake
+
+import (
+	"slices"
+	"sort"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) ListLabelKeys() (*[]client.LabelKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lks := []client.LabelKey{}
+	for _, lk := range c.labelKeys {
+		lk.AllowedValues = slices.Clone(lk.AllowedValues)
+		lks = append(lks, lk)
+	}
+	sort.Slice(lks, func(i, j int) bool { return lks[i].Key < lks[j].Key })
+
+	return &lks, nil
+}
+
+func (c *Client) GetLabelKey(key string) (*client.LabelKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lk, ok := c.labelKeys[key]
+	if !ok {
+		return nil, notFound("label key", key)
+	}
+	lk.AllowedValues = slices.Clone(lk.AllowedValues)
+
+	return &lk, nil
+}
+
+func (c *Client) PutLabelKey(key string, opts client.LabelKeyOptions) (*client.LabelKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lk := client.LabelKey{
+		Key:           key,
+		Description:   opts.Description,
+		AllowedValues: slices.Clone(opts.AllowedValues),
+	}
+	c.labelKeys[key] = lk
+
+	return &lk, nil
+}
+
+func (c *Client) DeleteLabelKey(key string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.labelKeys[key]; !ok {
+		return notFound("label key", key)
+	}
+	delete(c.labelKeys, key)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"fmt"
+	"maps"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The LDAP integration is not found until set.
+
+func (c *Client) GetLdapConfig() (*client.LdapConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ldapConfig == nil {
+		return nil, notFound("ldap config", "ldap")
+	}
+
+	lc := *c.ldapConfig
+	lc.GroupMappings = maps.Clone(lc.GroupMappings)
+	return &lc, nil
+}
+
+func (c *Client) UpdateLdapConfig(opts client.LdapConfigOptions) (*client.LdapConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The bind password is required on the first setup only
+	if c.ldapConfig == nil && opts.BindPassword == nil {
+		return nil, fmt.Errorf("bind password is required")
+	}
+
+	c.ldapConfig = &client.LdapConfig{
+		IsEnabled:         opts.IsEnabled,
+		ServerUrl:         opts.ServerUrl,
+		StartTls:          opts.StartTls,
+		BindDn:            opts.BindDn,
+		UserSearchBase:    opts.UserSearchBase,
+		UserSearchFilter:  opts.UserSearchFilter,
+		GroupSearchBase:   opts.GroupSearchBase,
+		GroupSearchFilter: opts.GroupSearchFilter,
+		GroupMappings:     maps.Clone(opts.GroupMappings),
+	}
+
+	lc := *c.ldapConfig
+	lc.GroupMappings = maps.Clone(lc.GroupMappings)
+	return &lc, nil
+}
+
+func (c *Client) DeleteLdapConfig() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.ldapConfig == nil {
+		return notFound("ldap config", "ldap")
+	}
+
+	c.ldapConfig = nil
+
+	return nil
+}
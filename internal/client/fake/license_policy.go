@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetLicensePolicy(workspace string, repository string) (*client.LicensePolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	lp, ok := c.licensePolicies[key]
+	if !ok {
+		return nil, notFound("license policy", key)
+	}
+
+	return &lp, nil
+}
+
+func (c *Client) PutLicensePolicy(workspace string, repository string, opts client.LicensePolicyOptions) (*client.LicensePolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, workspaceId, repositoryId, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	lp := client.LicensePolicy{
+		WorkspaceId:     workspaceId,
+		RepositoryId:    repositoryId,
+		AllowedLicenses: opts.AllowedLicenses,
+		DeniedLicenses:  opts.DeniedLicenses,
+		Action:          opts.Action,
+		EnforceOn:       opts.EnforceOn,
+	}
+	c.licensePolicies[key] = lp
+
+	return &lp, nil
+}
+
+func (c *Client) DeleteLicensePolicy(workspace string, repository string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.licensePolicies[key]; !ok {
+		return notFound("license policy", key)
+	}
+
+	delete(c.licensePolicies, key)
+
+	return nil
+}
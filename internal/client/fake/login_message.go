@@ -0,0 +1,39 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The login message always exists, it is disabled until set.
+
+func (c *Client) GetLoginMessage() (*client.LoginMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	lm := c.loginMessage
+	return &lm, nil
+}
+
+func (c *Client) UpdateLoginMessage(opts client.LoginMessageOptions) (*client.LoginMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.loginMessage = client.LoginMessage{
+		Title:                  opts.Title,
+		Message:                opts.Message,
+		IsEnabled:              opts.IsEnabled,
+		RequireAcknowledgement: opts.RequireAcknowledgement,
+	}
+
+	lm := c.loginMessage
+	return &lm, nil
+}
+
+func (c *Client) DeleteLoginMessage() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.loginMessage = client.LoginMessage{}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreateMaintenanceWindow(opts client.MaintenanceWindowOptions) (*client.MaintenanceWindow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mw := client.MaintenanceWindow{
+		Id:              c.newId(),
+		Name:            opts.Name,
+		Schedule:        opts.Schedule,
+		DurationMinutes: opts.DurationMinutes,
+		Jobs:            slices.Clone(opts.Jobs),
+		IsEnabled:       opts.IsEnabled,
+	}
+	c.maintenanceWindows[mw.Id] = mw
+
+	mw.Jobs = slices.Clone(mw.Jobs)
+	return &mw, nil
+}
+
+func (c *Client) GetMaintenanceWindow(id string) (*client.MaintenanceWindow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	mw, ok := c.maintenanceWindows[id]
+	if !ok {
+		return nil, notFound("maintenance window", id)
+	}
+
+	mw.Jobs = slices.Clone(mw.Jobs)
+	return &mw, nil
+}
+
+func (c *Client) UpdateMaintenanceWindow(id string, opts client.MaintenanceWindowOptions) (*client.MaintenanceWindow, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.maintenanceWindows[id]; !ok {
+		return nil, notFound("maintenance window", id)
+	}
+
+	mw := client.MaintenanceWindow{
+		Id:              id,
+		Name:            opts.Name,
+		Schedule:        opts.Schedule,
+		DurationMinutes: opts.DurationMinutes,
+		Jobs:            slices.Clone(opts.Jobs),
+		IsEnabled:       opts.IsEnabled,
+	}
+	c.maintenanceWindows[id] = mw
+
+	mw.Jobs = slices.Clone(mw.Jobs)
+	return &mw, nil
+}
+
+func (c *Client) DeleteMaintenanceWindow(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.maintenanceWindows[id]; !ok {
+		return notFound("maintenance window", id)
+	}
+	delete(c.maintenanceWindows, id)
+
+	return nil
+}
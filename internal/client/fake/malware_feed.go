@@ -0,0 +1,67 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreateMalwareFeedSubscription(opts client.MalwareFeedSubscriptionOptions) (*client.MalwareFeedSubscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sub := range c.malwareFeeds {
+		if sub.Feed == opts.Feed {
+			return nil, conflict("malware feed subscription", opts.Feed)
+		}
+	}
+
+	// The feed is never synchronized, so LastSyncedAt stays unset
+	sub := client.MalwareFeedSubscription{
+		Id:      c.newId(),
+		Feed:    opts.Feed,
+		Enabled: opts.Enabled,
+		Action:  opts.Action,
+	}
+	c.malwareFeeds[sub.Id] = sub
+
+	return &sub, nil
+}
+
+func (c *Client) GetMalwareFeedSubscription(id string) (*client.MalwareFeedSubscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sub, ok := c.malwareFeeds[id]
+	if !ok {
+		return nil, notFound("malware feed subscription", id)
+	}
+
+	return &sub, nil
+}
+
+func (c *Client) UpdateMalwareFeedSubscription(id string, opts client.MalwareFeedSubscriptionOptions) (*client.MalwareFeedSubscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sub, ok := c.malwareFeeds[id]
+	if !ok {
+		return nil, notFound("malware feed subscription", id)
+	}
+
+	sub.Enabled = opts.Enabled
+	sub.Action = opts.Action
+	c.malwareFeeds[id] = sub
+
+	return &sub, nil
+}
+
+func (c *Client) DeleteMalwareFeedSubscription(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.malwareFeeds[id]; !ok {
+		return notFound("malware feed subscription", id)
+	}
+	delete(c.malwareFeeds, id)
+
+	return nil
+}
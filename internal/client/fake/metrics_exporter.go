@@ -0,0 +1,47 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The metrics exporter always exists, it is disabled until set.
+
+const metricsEndpointUrl = "https://repoflow.fake/metrics"
+
+func (c *Client) GetMetricsExporter() (*client.MetricsExporter, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	me := c.metricsExporter
+	me.MetricGroups = slices.Clone(me.MetricGroups)
+	me.EndpointUrl = metricsEndpointUrl
+	return &me, nil
+}
+
+func (c *Client) UpdateMetricsExporter(opts client.MetricsExporterOptions) (*client.MetricsExporter, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metricsExporter = client.MetricsExporter{
+		IsEnabled:    opts.IsEnabled,
+		MetricGroups: slices.Clone(opts.MetricGroups),
+		// Like the API, keep the current token when none is sent
+		IsTokenRequired: c.metricsExporter.IsTokenRequired || (opts.ScrapeToken != nil && *opts.ScrapeToken != ""),
+	}
+
+	me := c.metricsExporter
+	me.MetricGroups = slices.Clone(me.MetricGroups)
+	me.EndpointUrl = metricsEndpointUrl
+	return &me, nil
+}
+
+func (c *Client) DeleteMetricsExporter() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metricsExporter = client.MetricsExporter{}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The MFA enforcement is not found until set.
+
+func (c *Client) GetMfaEnforcement() (*client.MfaEnforcement, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mfaEnforcement == nil {
+		return nil, notFound("mfa enforcement", "mfa-enforcement")
+	}
+
+	me := *c.mfaEnforcement
+	me.Groups = slices.Clone(me.Groups)
+	me.ExemptPrincipals = slices.Clone(me.ExemptPrincipals)
+	return &me, nil
+}
+
+func (c *Client) UpdateMfaEnforcement(opts client.MfaEnforcementOptions) (*client.MfaEnforcement, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Like the API, the groups only make sense with the groups scope
+	if (opts.Scope == client.MfaScopeGroups) != (len(opts.Groups) > 0) {
+		return nil, fmt.Errorf("groups must be set with the %s scope only", client.MfaScopeGroups)
+	}
+
+	c.mfaEnforcement = &client.MfaEnforcement{
+		Scope:            opts.Scope,
+		Groups:           slices.Clone(opts.Groups),
+		ExemptPrincipals: slices.Clone(opts.ExemptPrincipals),
+		GracePeriodDays:  opts.GracePeriodDays,
+	}
+
+	me := *c.mfaEnforcement
+	me.Groups = slices.Clone(me.Groups)
+	me.ExemptPrincipals = slices.Clone(me.ExemptPrincipals)
+	return &me, nil
+}
+
+func (c *Client) DeleteMfaEnforcement() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.mfaEnforcement == nil {
+		return notFound("mfa enforcement", "mfa-enforcement")
+	}
+
+	c.mfaEnforcement = nil
+
+	return nil
+}
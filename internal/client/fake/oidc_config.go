@@ -0,0 +1,64 @@
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The OIDC single sign-on is not found until set.
+
+func (c *Client) GetOidcConfig() (*client.OidcConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.oidcConfig == nil {
+		return nil, notFound("oidc config", "oidc")
+	}
+
+	return copyOidcConfig(c.oidcConfig), nil
+}
+
+func (c *Client) UpdateOidcConfig(opts client.OidcConfigOptions) (*client.OidcConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The client secret is required on the first setup only
+	if c.oidcConfig == nil && opts.ClientSecret == nil {
+		return nil, fmt.Errorf("client secret is required")
+	}
+
+	c.oidcConfig = &client.OidcConfig{
+		IsEnabled:     opts.IsEnabled,
+		IssuerUrl:     opts.IssuerUrl,
+		ClientId:      opts.ClientId,
+		Scopes:        slices.Clone(opts.Scopes),
+		GroupsClaim:   opts.GroupsClaim,
+		GroupMappings: maps.Clone(opts.GroupMappings),
+		RedirectUri:   endpointBaseUrl + "/auth/oidc/callback",
+	}
+
+	return copyOidcConfig(c.oidcConfig), nil
+}
+
+func (c *Client) DeleteOidcConfig() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.oidcConfig == nil {
+		return notFound("oidc config", "oidc")
+	}
+
+	c.oidcConfig = nil
+
+	return nil
+}
+
+func copyOidcConfig(oc *client.OidcConfig) *client.OidcConfig {
+	cp := *oc
+	cp.Scopes = slices.Clone(oc.Scopes)
+	cp.GroupMappings = maps.Clone(oc.GroupMappings)
+	return &cp
+}
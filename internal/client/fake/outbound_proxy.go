@@ -0,0 +1,52 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The outbound proxy is not found until set.
+
+func (c *Client) GetOutboundProxy() (*client.OutboundProxy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.outboundProxy == nil {
+		return nil, notFound("outbound proxy", "outbound-proxy")
+	}
+
+	op := *c.outboundProxy
+	op.NoProxy = slices.Clone(op.NoProxy)
+	return &op, nil
+}
+
+func (c *Client) UpdateOutboundProxy(opts client.OutboundProxyOptions) (*client.OutboundProxy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.outboundProxy = &client.OutboundProxy{
+		IsEnabled:  opts.IsEnabled,
+		HttpProxy:  opts.HttpProxy,
+		HttpsProxy: opts.HttpsProxy,
+		NoProxy:    slices.Clone(opts.NoProxy),
+		Username:   opts.Username,
+	}
+
+	op := *c.outboundProxy
+	op.NoProxy = slices.Clone(op.NoProxy)
+	return &op, nil
+}
+
+func (c *Client) DeleteOutboundProxy() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.outboundProxy == nil {
+		return notFound("outbound proxy", "outbound-proxy")
+	}
+
+	c.outboundProxy = nil
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package fake
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) ListPackages(workspace string, repository string, offset int, limit int) (*client.Packages, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	// The latest version of a package is the last one published, the fake
+	// serves no download
+	byName := map[string]client.Package{}
+	for key, pv := range c.packageVersions {
+		if !strings.HasPrefix(key, rp.Id+"/") {
+			continue
+		}
+
+		pkg, ok := byName[pv.PackageName]
+		if !ok || pv.CreatedAt > pkg.UpdatedAt || (pv.CreatedAt == pkg.UpdatedAt && pv.Version > pkg.LatestVersion) {
+			byName[pv.PackageName] = client.Package{
+				Name:          pv.PackageName,
+				LatestVersion: pv.Version,
+				UpdatedAt:     pv.CreatedAt,
+			}
+		}
+	}
+
+	pkgs := make([]client.Package, 0, len(byName))
+	for _, pkg := range byName {
+		pkgs = append(pkgs, pkg)
+	}
+	slices.SortFunc(pkgs, func(a, b client.Package) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	page := client.Packages{Total: len(pkgs), Offset: offset, Limit: limit, Packages: []client.Package{}}
+	if offset < len(pkgs) {
+		page.Packages = pkgs[offset:min(offset+limit, len(pkgs))]
+	}
+
+	return &page, nil
+}
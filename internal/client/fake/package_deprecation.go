@@ -0,0 +1,80 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreatePackageDeprecation(workspace string, repository string, opts client.PackageDeprecationOptions) (*client.PackageDeprecation, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pd := client.PackageDeprecation{
+		Id:          c.newId(),
+		PackageName: opts.PackageName,
+		Version:     opts.Version,
+		Message:     opts.Message,
+	}
+	c.deprecations[rp.Id+"/"+pd.Id] = pd
+
+	return &pd, nil
+}
+
+func (c *Client) GetPackageDeprecation(workspace string, repository string, id string) (*client.PackageDeprecation, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pd, ok := c.deprecations[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("package deprecation", id)
+	}
+
+	return &pd, nil
+}
+
+func (c *Client) UpdatePackageDeprecation(workspace string, repository string, id string, opts client.PackageDeprecationOptions) (*client.PackageDeprecation, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pd, ok := c.deprecations[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("package deprecation", id)
+	}
+
+	// Only the message can change, the package and version identify the deprecation
+	pd.Message = opts.Message
+	c.deprecations[rp.Id+"/"+id] = pd
+
+	return &pd, nil
+}
+
+func (c *Client) DeletePackageDeprecation(workspace string, repository string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.deprecations[rp.Id+"/"+id]; !ok {
+		return notFound("package deprecation", id)
+	}
+	delete(c.deprecations, rp.Id+"/"+id)
+
+	return nil
+}
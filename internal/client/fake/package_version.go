@@ -0,0 +1,127 @@
+package fake
+
+import (
+	"cmp"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// AddPackageVersion publishes a version of a package in a repository, the
+// fake has no package endpoints to upload it.
+func (c *Client) AddPackageVersion(workspace string, repository string, packageName string, version string, sizeBytes int64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	key := rp.Id + "/" + packageName + "@" + version
+	if _, ok := c.packageVersions[key]; ok {
+		return conflict("package version", packageName+"@"+version)
+	}
+
+	c.packageVersions[key] = client.PackageVersion{
+		PackageName: packageName,
+		Version:     version,
+		SizeBytes:   sizeBytes,
+		Properties:  map[string]string{},
+		CreatedAt:   *now(),
+	}
+
+	return nil
+}
+
+func (c *Client) ListPackageVersions(workspace string, repository string, packageName string, offset int, limit int) (*client.PackageVersions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pvs := []client.PackageVersion{}
+	for key, pv := range c.packageVersions {
+		if strings.HasPrefix(key, rp.Id+"/"+packageName+"@") {
+			pv.Properties = maps.Clone(pv.Properties)
+			pvs = append(pvs, pv)
+		}
+	}
+	if len(pvs) == 0 {
+		return nil, notFound("package", packageName)
+	}
+	slices.SortFunc(pvs, func(a, b client.PackageVersion) int {
+		return cmp.Or(strings.Compare(a.CreatedAt, b.CreatedAt), strings.Compare(a.Version, b.Version))
+	})
+
+	page := client.PackageVersions{Total: len(pvs), Offset: offset, Limit: limit, Versions: []client.PackageVersion{}}
+	if offset < len(pvs) {
+		page.Versions = pvs[offset:min(offset+limit, len(pvs))]
+	}
+
+	return &page, nil
+}
+
+func (c *Client) GetPackageVersion(workspace string, repository string, packageName string, version string) (*client.PackageVersion, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pv, ok := c.packageVersions[rp.Id+"/"+packageName+"@"+version]
+	if !ok {
+		return nil, notFound("package version", packageName+"@"+version)
+	}
+	pv.Properties = maps.Clone(pv.Properties)
+
+	return &pv, nil
+}
+
+func (c *Client) UpdatePackageVersion(workspace string, repository string, packageName string, version string, opts client.PackageVersionOptions) (*client.PackageVersion, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	key := rp.Id + "/" + packageName + "@" + version
+	pv, ok := c.packageVersions[key]
+	if !ok {
+		return nil, notFound("package version", packageName+"@"+version)
+	}
+
+	pv.Properties = map[string]string{}
+	maps.Copy(pv.Properties, opts.Properties)
+	c.packageVersions[key] = pv
+	pv.Properties = maps.Clone(pv.Properties)
+
+	return &pv, nil
+}
+
+func (c *Client) DeletePackageVersion(workspace string, repository string, packageName string, version string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	key := rp.Id + "/" + packageName + "@" + version
+	if _, ok := c.packageVersions[key]; !ok {
+		return notFound("package version", packageName+"@"+version)
+	}
+	delete(c.packageVersions, key)
+
+	return nil
+}
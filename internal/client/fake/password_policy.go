@@ -0,0 +1,51 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The password policy always exists, with the defaults of the API until set.
+var defaultPasswordPolicy = client.PasswordPolicy{
+	MinLength:              8,
+	LockoutDurationMinutes: 15,
+}
+
+func (c *Client) GetPasswordPolicy() (*client.PasswordPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	pp := defaultPasswordPolicy
+	if c.passwordPolicy != nil {
+		pp = *c.passwordPolicy
+	}
+
+	return &pp, nil
+}
+
+func (c *Client) UpdatePasswordPolicy(opts client.PasswordPolicyOptions) (*client.PasswordPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.passwordPolicy = &client.PasswordPolicy{
+		MinLength:              opts.MinLength,
+		RequireUppercase:       opts.RequireUppercase,
+		RequireLowercase:       opts.RequireLowercase,
+		RequireDigit:           opts.RequireDigit,
+		RequireSymbol:          opts.RequireSymbol,
+		MaxAgeDays:             opts.MaxAgeDays,
+		LockoutThreshold:       opts.LockoutThreshold,
+		LockoutDurationMinutes: opts.LockoutDurationMinutes,
+	}
+
+	pp := *c.passwordPolicy
+	return &pp, nil
+}
+
+func (c *Client) DeletePasswordPolicy() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.passwordPolicy = nil
+
+	return nil
+}
@@ -0,0 +1,115 @@
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// promotionPipeline builds a promotion pipeline of a workspace, checking its repositories.
+// It must be called with the lock held.
+func (c *Client) promotionPipeline(ws *client.Workspace, id string, opts client.PromotionPipelineOptions) (client.PromotionPipeline, error) {
+	pp := client.PromotionPipeline{
+		Id:     id,
+		Name:   opts.Name,
+		Stages: make([]client.PromotionStage, 0, len(opts.Stages)),
+	}
+
+	for _, stage := range opts.Stages {
+		rp, err := c.repository(ws.Id, stage.RepositoryId)
+		if err != nil {
+			return client.PromotionPipeline{}, err
+		}
+		if rp.RepositoryType != "local" {
+			return client.PromotionPipeline{}, fmt.Errorf("repository %s is not a local repository", rp.Name)
+		}
+
+		stage.RequiredProperties = maps.Clone(stage.RequiredProperties)
+		stage.Approvers = slices.Clone(stage.Approvers)
+		pp.Stages = append(pp.Stages, stage)
+	}
+
+	return pp, nil
+}
+
+func (c *Client) CreatePromotionPipeline(workspace string, opts client.PromotionPipelineOptions) (*client.PromotionPipeline, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, err := c.promotionPipeline(ws, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.promotionPipelines[ws.Id+"/"+pp.Id] = pp
+
+	return &pp, nil
+}
+
+func (c *Client) GetPromotionPipeline(workspace string, id string) (*client.PromotionPipeline, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	pp, ok := c.promotionPipelines[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("promotion pipeline", id)
+	}
+
+	pp.Stages = slices.Clone(pp.Stages)
+	for i := range pp.Stages {
+		pp.Stages[i].RequiredProperties = maps.Clone(pp.Stages[i].RequiredProperties)
+		pp.Stages[i].Approvers = slices.Clone(pp.Stages[i].Approvers)
+	}
+
+	return &pp, nil
+}
+
+func (c *Client) UpdatePromotionPipeline(workspace string, id string, opts client.PromotionPipelineOptions) (*client.PromotionPipeline, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.promotionPipelines[ws.Id+"/"+id]; !ok {
+		return nil, notFound("promotion pipeline", id)
+	}
+
+	pp, err := c.promotionPipeline(ws, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.promotionPipelines[ws.Id+"/"+id] = pp
+
+	return &pp, nil
+}
+
+func (c *Client) DeletePromotionPipeline(workspace string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.promotionPipelines[ws.Id+"/"+id]; !ok {
+		return notFound("promotion pipeline", id)
+	}
+	delete(c.promotionPipelines, ws.Id+"/"+id)
+
+	return nil
+}
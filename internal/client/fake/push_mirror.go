@@ -0,0 +1,106 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// pushMirror builds a push mirror of a local repository, checking its credential.
+// It must be called with the lock held.
+func (c *Client) pushMirror(rp *client.Repository, id string, opts client.PushMirrorOptions) (client.PushMirror, error) {
+	if rp.RepositoryType != "local" {
+		return client.PushMirror{}, fmt.Errorf("repository %s is not a local repository", rp.Name)
+	}
+
+	if opts.CredentialId != nil {
+		if _, err := c.credential(rp.WorkspaceId, *opts.CredentialId); err != nil {
+			return client.PushMirror{}, err
+		}
+	}
+
+	return client.PushMirror{
+		Id:           id,
+		Url:          opts.Url,
+		CredentialId: opts.CredentialId,
+		Username:     opts.Username,
+		Schedule:     opts.Schedule,
+		Enabled:      opts.Enabled,
+	}, nil
+}
+
+func (c *Client) CreatePushMirror(workspace string, repository string, opts client.PushMirrorOptions) (*client.PushMirror, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pm, err := c.pushMirror(rp, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.pushMirrors[rp.Id+"/"+pm.Id] = pm
+
+	return &pm, nil
+}
+
+func (c *Client) GetPushMirror(workspace string, repository string, id string) (*client.PushMirror, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	pm, ok := c.pushMirrors[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("push mirror", id)
+	}
+
+	return &pm, nil
+}
+
+func (c *Client) UpdatePushMirror(workspace string, repository string, id string, opts client.PushMirrorOptions) (*client.PushMirror, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	current, ok := c.pushMirrors[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("push mirror", id)
+	}
+
+	pm, err := c.pushMirror(rp, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	pm.LastSyncedAt = current.LastSyncedAt
+	c.pushMirrors[rp.Id+"/"+id] = pm
+
+	return &pm, nil
+}
+
+func (c *Client) DeletePushMirror(workspace string, repository string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.pushMirrors[rp.Id+"/"+id]; !ok {
+		return notFound("push mirror", id)
+	}
+	delete(c.pushMirrors, rp.Id+"/"+id)
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// quarantineRule builds a quarantine rule of a workspace, checking its repositories.
+// It must be called with the lock held.
+func (c *Client) quarantineRule(ws *client.Workspace, id string, opts client.QuarantineRuleOptions) (client.QuarantineRule, error) {
+	for _, repositoryId := range opts.RepositoryIds {
+		rp, err := c.repository(ws.Id, repositoryId)
+		if err != nil {
+			return client.QuarantineRule{}, err
+		}
+		if rp.RepositoryType != "remote" {
+			return client.QuarantineRule{}, fmt.Errorf("repository %s is not a remote repository", rp.Name)
+		}
+	}
+
+	return client.QuarantineRule{
+		Id:              id,
+		Name:            opts.Name,
+		RepositoryIds:   slices.Clone(opts.RepositoryIds),
+		PackagePatterns: slices.Clone(opts.PackagePatterns),
+		QuarantineHours: opts.QuarantineHours,
+		IsEnabled:       opts.IsEnabled,
+	}, nil
+}
+
+func (c *Client) CreateQuarantineRule(workspace string, opts client.QuarantineRuleOptions) (*client.QuarantineRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	qr, err := c.quarantineRule(ws, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.quarantineRules[ws.Id+"/"+qr.Id] = qr
+
+	return &qr, nil
+}
+
+func (c *Client) GetQuarantineRule(workspace string, id string) (*client.QuarantineRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	qr, ok := c.quarantineRules[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("quarantine rule", id)
+	}
+	qr.RepositoryIds = slices.Clone(qr.RepositoryIds)
+	qr.PackagePatterns = slices.Clone(qr.PackagePatterns)
+
+	return &qr, nil
+}
+
+func (c *Client) UpdateQuarantineRule(workspace string, id string, opts client.QuarantineRuleOptions) (*client.QuarantineRule, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.quarantineRules[ws.Id+"/"+id]; !ok {
+		return nil, notFound("quarantine rule", id)
+	}
+
+	qr, err := c.quarantineRule(ws, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.quarantineRules[ws.Id+"/"+id] = qr
+
+	return &qr, nil
+}
+
+func (c *Client) DeleteQuarantineRule(workspace string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.quarantineRules[ws.Id+"/"+id]; !ok {
+		return notFound("quarantine rule", id)
+	}
+	delete(c.quarantineRules, ws.Id+"/"+id)
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// rateLimitPolicy builds a policy from the options.
+func rateLimitPolicy(id string, opts client.RateLimitPolicyOptions) client.RateLimitPolicy {
+	return client.RateLimitPolicy{
+		Id:                      id,
+		Name:                    opts.Name,
+		Scope:                   opts.Scope,
+		Subjects:                slices.Clone(opts.Subjects),
+		RequestsPerMinute:       opts.RequestsPerMinute,
+		Burst:                   opts.Burst,
+		BandwidthBytesPerSecond: opts.BandwidthBytesPerSecond,
+		IsEnabled:               opts.IsEnabled,
+	}
+}
+
+func (c *Client) CreateRateLimitPolicy(opts client.RateLimitPolicyOptions) (*client.RateLimitPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, rl := range c.rateLimitPolicies {
+		if rl.Name == opts.Name {
+			return nil, conflict("rate limit policy", opts.Name)
+		}
+	}
+
+	rl := rateLimitPolicy(c.newId(), opts)
+	c.rateLimitPolicies[rl.Id] = rl
+
+	return &rl, nil
+}
+
+func (c *Client) GetRateLimitPolicy(id string) (*client.RateLimitPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rl, ok := c.rateLimitPolicies[id]
+	if !ok {
+		return nil, notFound("rate limit policy", id)
+	}
+
+	return &rl, nil
+}
+
+func (c *Client) UpdateRateLimitPolicy(id string, opts client.RateLimitPolicyOptions) (*client.RateLimitPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.rateLimitPolicies[id]; !ok {
+		return nil, notFound("rate limit policy", id)
+	}
+
+	rl := rateLimitPolicy(id, opts)
+	c.rateLimitPolicies[id] = rl
+
+	return &rl, nil
+}
+
+func (c *Client) DeleteRateLimitPolicy(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.rateLimitPolicies[id]; !ok {
+		return notFound("rate limit policy", id)
+	}
+
+	delete(c.rateLimitPolicies, id)
+
+	return nil
+}
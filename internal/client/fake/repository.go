@@ -0,0 +1,480 @@
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// repository finds a repository of a workspace by Id or name, both given by Id
+// or name. It must be called with the lock held.
+func (c *Client) repository(workspace string, id string) (*client.Repository, error) {
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rp := range c.repositories {
+		if rp.WorkspaceId == ws.Id && (rp.Id == id || rp.Name == id) {
+			return rp, nil
+		}
+	}
+
+	return nil, notFound("repository", id)
+}
+
+// scope finds the workspace and, when not empty, the repository of a setting
+// and returns its key. It must be called with the lock held.
+func (c *Client) scope(workspace string, repository string) (string, string, *string, error) {
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	if repository == "" {
+		return ws.Id, ws.Id, nil, nil
+	}
+
+	rp, err := c.repository(ws.Id, repository)
+	if err != nil {
+		return "", "", nil, err
+	}
+
+	return ws.Id + "/" + rp.Id, ws.Id, &rp.Id, nil
+}
+
+// copyRepository returns a copy of the repository safe to hand to the caller.
+func copyRepository(rp *client.Repository) *client.Repository {
+	cp := *rp
+	cp.ChildRepositories = slices.Clone(rp.ChildRepositories)
+	cp.Labels = maps.Clone(rp.Labels)
+	return &cp
+}
+
+// memberKey is the key of the resolution filters of a virtual repository child.
+func memberKey(id string, child string) string {
+	return id + "/" + child
+}
+
+// addRepository stores a new repository in a workspace. It must be called with
+// the lock held.
+func (c *Client) addRepository(workspace string, rp *client.Repository) (*client.Repository, error) {
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := c.repository(ws.Id, rp.Name); err == nil {
+		return nil, conflict("repository", rp.Name)
+	}
+
+	if err := c.checkWorkspaceSettings(ws.Id, rp); err != nil {
+		return nil, err
+	}
+
+	// Packages are stored on the backend of the workspace unless chosen at creation
+	if rp.RepositoryType != "virtual" {
+		if rp.StorageBackendId == nil {
+			rp.StorageBackendId = ws.StorageBackendId
+		} else if err := c.storageBackend(rp.StorageBackendId); err != nil {
+			return nil, err
+		}
+	}
+
+	rp.Id = c.newId()
+	rp.WorkspaceId = ws.Id
+	rp.Status = "active"
+	rp.UpdatedAt = now()
+
+	// Terraform registries are served under the hostname of the instance
+	if service, ok := strings.CutPrefix(rp.PackageType, "terraform-"); ok {
+		rp.TerraformRegistry = &client.TerraformRegistry{
+			Hostname:            "repoflow.fake",
+			ServiceDiscoveryUrl: "https://repoflow.fake/.well-known/terraform.json",
+			ApiUrl:              fmt.Sprintf("%s/%s/%s/%s/v1/%ss/", endpointBaseUrl, rp.PackageType, ws.Name, rp.Name, service),
+		}
+	}
+	c.repositories[rp.Id] = rp
+
+	// The new repository receives the grants of the template of its workspace
+	for _, grant := range c.defaultPermissions[ws.Id].Grants {
+		c.permissions[rp.Id+"/"+grant.Principal] = grant
+	}
+
+	return copyRepository(rp), nil
+}
+
+func (c *Client) ListRepositories(workspace string) (*[]repoflow.Repositories, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	rps := []repoflow.Repositories{}
+	for _, rp := range c.repositories {
+		if rp.WorkspaceId == ws.Id {
+			rps = append(rps, repoflow.Repositories{
+				Id:             rp.Id,
+				Name:           rp.Name,
+				PackageType:    rp.PackageType,
+				RepositoryType: rp.RepositoryType,
+				Status:         rp.Status,
+			})
+		}
+	}
+	sort.Slice(rps, func(i, j int) bool { return rps[i].Name < rps[j].Name })
+
+	return &rps, nil
+}
+
+func (c *Client) GetRepository(workspace string, id string) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyRepository(rp), nil
+}
+
+func (c *Client) CreateLocalRepository(workspace string, opts client.RepositoryOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.addRepository(workspace, &client.Repository{
+		Repository: repoflow.Repository{
+			Name:           opts.Name,
+			PackageType:    opts.PackageType,
+			RepositoryType: "local",
+		},
+		StorageBackendId: stringOrNil(opts.StorageBackendId),
+		ManagedBy:        stringOrNil(opts.ManagedBy),
+		Labels:           maps.Clone(opts.Labels),
+		PackageSettings:  opts.PackageSettings,
+	})
+}
+
+func (c *Client) UpdateRepository(workspace string, id string, opts client.RepositoryUpdateOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ManagedBy != nil {
+		rp.ManagedBy = stringOrNil(*opts.ManagedBy)
+	}
+	if opts.Labels != nil {
+		rp.Labels = maps.Clone(*opts.Labels)
+	}
+	if opts.ConanSettings != nil {
+		rp.ConanSettings = opts.ConanSettings
+	}
+	if opts.OciSettings != nil {
+		rp.OciSettings = opts.OciSettings
+	}
+	rp.UpdatedAt = now()
+
+	return copyRepository(rp), nil
+}
+
+func (c *Client) CreateRemoteRepository(workspace string, opts client.RepositoryRemoteOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp := &client.Repository{
+		Repository: repoflow.Repository{
+			Name:                              opts.Name,
+			PackageType:                       opts.PackageType,
+			RepositoryType:                    "remote",
+			RemoteRepositoryUrl:               &opts.RemoteRepositoryUrl,
+			IsRemoteCacheEnabled:              opts.IsRemoteCacheEnabled,
+			FileCacheTimeTillRevalidation:     opts.FileCacheTimeTillRevalidation,
+			MetadataCacheTimeTillRevalidation: opts.MetadataCacheTimeTillRevalidation,
+		},
+		StorageBackendId: stringOrNil(opts.StorageBackendId),
+		ManagedBy:        stringOrNil(opts.ManagedBy),
+		Labels:           maps.Clone(opts.Labels),
+		PackageSettings:  opts.PackageSettings,
+	}
+	// Secrets are never returned by the API
+	if opts.RemoteRepositoryUsername != "" {
+		rp.RemoteRepositoryUsername = &opts.RemoteRepositoryUsername
+	}
+	if opts.CredentialId != "" {
+		cr, err := c.credential(workspace, opts.CredentialId)
+		if err != nil {
+			return nil, err
+		}
+		rp.CredentialId = &cr.Id
+	}
+
+	return c.addRepository(workspace, rp)
+}
+
+func (c *Client) UpdateRemoteRepository(workspace string, id string, opts client.RepositoryRemoteUpdateOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rp.RepositoryType != "remote" {
+		return nil, fmt.Errorf("repository %s is not a remote repository", rp.Name)
+	}
+
+	if opts.RemoteRepositoryUsername != nil {
+		rp.RemoteRepositoryUsername = opts.RemoteRepositoryUsername
+		if *opts.RemoteRepositoryUsername == "" {
+			rp.RemoteRepositoryUsername = nil
+		}
+	}
+	if opts.CredentialId != nil {
+		rp.CredentialId = nil
+		if *opts.CredentialId != "" {
+			cr, err := c.credential(workspace, *opts.CredentialId)
+			if err != nil {
+				return nil, err
+			}
+			rp.CredentialId = &cr.Id
+		}
+	}
+	rp.UpdatedAt = now()
+
+	return copyRepository(rp), nil
+}
+
+func (c *Client) CreateVirtualRepository(workspace string, opts client.RepositoryVirtualOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp := &client.Repository{
+		Repository: repoflow.Repository{
+			Name:           opts.Name,
+			PackageType:    opts.PackageType,
+			RepositoryType: "virtual",
+		},
+		ManagedBy:       stringOrNil(opts.ManagedBy),
+		Labels:          maps.Clone(opts.Labels),
+		PackageSettings: opts.PackageSettings,
+	}
+
+	for _, childId := range opts.ChildRepositoryIds {
+		child, err := c.repository(workspace, childId)
+		if err != nil {
+			return nil, err
+		}
+		rp.ChildRepositories = append(rp.ChildRepositories, repoflow.ChildRepository{Id: child.Id, Name: child.Name})
+	}
+
+	if opts.UploadLocalRepositoryId != "" {
+		upload, err := c.repository(workspace, opts.UploadLocalRepositoryId)
+		if err != nil {
+			return nil, err
+		}
+		rp.UploadLocalRepositoryId = &upload.Id
+		rp.UploadTargetLocalRepository = repoflow.UploadTargetLocalRepository{Id: upload.Id, Name: upload.Name}
+	}
+
+	policy := opts.DeploymentPolicy
+	if policy == "" {
+		policy = "reject"
+	}
+	rp.DeploymentPolicy = &policy
+
+	return c.addRepository(workspace, rp)
+}
+
+func (c *Client) UpdateVirtualRepository(workspace string, id string, opts client.RepositoryVirtualUpdateOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rp.RepositoryType != "virtual" {
+		return nil, fmt.Errorf("repository %s is not a virtual repository", rp.Name)
+	}
+
+	if opts.DeploymentPolicy != nil {
+		policy := *opts.DeploymentPolicy
+		rp.DeploymentPolicy = &policy
+	}
+	rp.UpdatedAt = now()
+
+	return copyRepository(rp), nil
+}
+
+func (c *Client) UpdateVirtualRepositoryChildren(workspace string, id string, opts client.RepositoryVirtualChildrenOptions) (*client.Repository, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if rp.RepositoryType != "virtual" {
+		return nil, fmt.Errorf("repository %s is not a virtual repository", rp.Name)
+	}
+
+	for _, childId := range opts.RemoveChildRepositoryIds {
+		rp.ChildRepositories = slices.DeleteFunc(rp.ChildRepositories, func(child repoflow.ChildRepository) bool {
+			return child.Id == childId
+		})
+		delete(c.members, memberKey(rp.Id, childId))
+	}
+
+	for _, childId := range opts.AddChildRepositoryIds {
+		child, err := c.repository(workspace, childId)
+		if err != nil {
+			return nil, err
+		}
+		rp.ChildRepositories = append(rp.ChildRepositories, repoflow.ChildRepository{Id: child.Id, Name: child.Name})
+	}
+	rp.UpdatedAt = now()
+
+	return copyRepository(rp), nil
+}
+
+func (c *Client) ListVirtualRepositoryMembers(workspace string, id string) (*[]client.VirtualRepositoryMember, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every child is a member, with empty filters until they are set
+	members := []client.VirtualRepositoryMember{}
+	for _, child := range rp.ChildRepositories {
+		member, ok := c.members[memberKey(rp.Id, child.Id)]
+		if !ok {
+			member = client.VirtualRepositoryMember{
+				RepositoryId:    child.Id,
+				IncludePatterns: []string{},
+				ExcludePatterns: []string{},
+			}
+		}
+		members = append(members, member)
+	}
+
+	return &members, nil
+}
+
+func (c *Client) UpdateVirtualRepositoryMember(workspace string, id string, child string, opts client.VirtualRepositoryMemberOptions) (*client.VirtualRepositoryMember, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.ContainsFunc(rp.ChildRepositories, func(cr repoflow.ChildRepository) bool { return cr.Id == child }) {
+		return nil, notFound("child repository", child)
+	}
+
+	member := client.VirtualRepositoryMember{
+		RepositoryId:    child,
+		IncludePatterns: slices.Clone(opts.IncludePatterns),
+		ExcludePatterns: slices.Clone(opts.ExcludePatterns),
+	}
+	c.members[memberKey(rp.Id, child)] = member
+	rp.UpdatedAt = now()
+
+	return &member, nil
+}
+
+func (c *Client) DeleteRepository(workspace string, id string) (*repoflow.RepostotryDelete, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(c.repositories, rp.Id)
+
+	// Drop the repository from the virtual repositories and the objects it holds
+	for _, other := range c.repositories {
+		other.ChildRepositories = slices.DeleteFunc(other.ChildRepositories, func(child repoflow.ChildRepository) bool {
+			return child.Id == rp.Id
+		})
+		delete(c.members, memberKey(other.Id, rp.Id))
+	}
+	deletePrefix(c.members, rp.Id+"/")
+	deletePrefix(c.permissions, rp.Id+"/")
+	deletePrefix(c.webhooks, rp.Id+"/")
+	deletePrefix(c.pushMirrors, rp.Id+"/")
+	deletePrefix(c.immutableTagRules, rp.Id+"/")
+	deletePrefix(c.trustedPublishers, rp.Id+"/")
+	deletePrefix(c.artifacts, rp.Id+"/")
+	deletePrefix(c.artifactProperties, rp.Id+"/")
+	deletePrefix(c.packageVersions, rp.Id+"/")
+	deletePrefix(c.deprecations, rp.Id+"/")
+	delete(c.anonymousAccess, rp.WorkspaceId+"/"+rp.Id)
+	delete(c.scanPolicies, rp.WorkspaceId+"/"+rp.Id)
+	delete(c.licensePolicies, rp.WorkspaceId+"/"+rp.Id)
+	delete(c.uploadPolicies, rp.WorkspaceId+"/"+rp.Id)
+	for key, cp := range c.cleanupPolicies {
+		cp.RepositoryIds = slices.DeleteFunc(slices.Clone(cp.RepositoryIds), func(id string) bool { return id == rp.Id })
+		c.cleanupPolicies[key] = cp
+	}
+	for key, rt := range c.retentionPolicies {
+		rt.RepositoryIds = slices.DeleteFunc(slices.Clone(rt.RepositoryIds), func(id string) bool { return id == rp.Id })
+		c.retentionPolicies[key] = rt
+	}
+	for key, qr := range c.quarantineRules {
+		qr.RepositoryIds = slices.DeleteFunc(slices.Clone(qr.RepositoryIds), func(id string) bool { return id == rp.Id })
+		c.quarantineRules[key] = qr
+	}
+	for key, pp := range c.promotionPipelines {
+		pp.Stages = slices.DeleteFunc(slices.Clone(pp.Stages), func(s client.PromotionStage) bool { return s.RepositoryId == rp.Id })
+		c.promotionPipelines[key] = pp
+	}
+	for key, sk := range c.signingKeys {
+		sk.RepositoryIds = slices.DeleteFunc(slices.Clone(sk.RepositoryIds), func(id string) bool { return id == rp.Id })
+		c.signingKeys[key] = sk
+	}
+	for id, ra := range c.roleAssignments {
+		if ra.RepositoryId != nil && *ra.RepositoryId == rp.Id {
+			delete(c.roleAssignments, id)
+		}
+	}
+
+	return &repoflow.RepostotryDelete{RepositoryId: rp.Id, Status: "deleted"}, nil
+}
+
+func (c *Client) GetRepositoryStats(workspace string, id string, period string) (*client.RepositoryStats, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := c.repository(workspace, id); err != nil {
+		return nil, err
+	}
+
+	stats := c.RepositoryStats
+	stats.Period = period
+
+	return &stats, nil
+}
@@ -0,0 +1,61 @@
+package fake
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CloneRepository(workspace string, id string, opts client.RepositoryCloneOptions) (*client.RepositoryClone, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	src, err := c.repository(workspace, id)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := c.workspace(opts.TargetWorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	// The children of a virtual repository belong to its workspace
+	if src.RepositoryType == "virtual" && target.Id != src.WorkspaceId {
+		return nil, fmt.Errorf("virtual repository %s can only be cloned in its workspace", src.Name)
+	}
+
+	rp := copyRepository(src)
+	rp.Name = opts.Name
+	// The copy is not managed by the tool managing the source
+	rp.ManagedBy = nil
+	rp, err = c.addRepository(target.Id, rp)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range rp.ChildRepositories {
+		if member, ok := c.members[memberKey(src.Id, child.Id)]; ok {
+			c.members[memberKey(rp.Id, child.Id)] = member
+		}
+	}
+
+	clone := client.RepositoryClone{Repository: *rp}
+
+	// No contents are stored, the copy is over at once
+	if opts.IncludeContents {
+		now := time.Now().UTC().Format(time.RFC3339)
+		st := client.SystemTask{
+			Id:          c.newId(),
+			Task:        "clone",
+			Status:      client.SystemTaskSucceeded,
+			StartedAt:   &now,
+			CompletedAt: &now,
+		}
+		c.systemTasks[st.Id] = st
+		clone.Task = &st
+	}
+
+	return &clone, nil
+}
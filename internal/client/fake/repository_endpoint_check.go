@@ -0,0 +1,33 @@
+package fake
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// endpointBaseUrl is the base URL of the package endpoints of the fake.
+const endpointBaseUrl = "https://repoflow.fake/api"
+
+func (c *Client) RepositoryEndpointUrl(packageType string, workspace string, repository string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", endpointBaseUrl, packageType, workspace, repository)
+}
+
+// CheckRepositoryEndpoint answers 200 for the repositories of the fake, 404 otherwise.
+func (c *Client) CheckRepositoryEndpoint(packageType string, workspace string, repository string, path string) (*client.EndpointCheck, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	check := client.EndpointCheck{
+		Url:        c.RepositoryEndpointUrl(packageType, workspace, repository) + "/" + strings.TrimPrefix(path, "/"),
+		StatusCode: http.StatusNotFound,
+	}
+
+	if rp, err := c.repository(workspace, repository); err == nil && rp.PackageType == packageType {
+		check.StatusCode = http.StatusOK
+	}
+
+	return &check, nil
+}
@@ -0,0 +1,76 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetRepositoryPermission(workspace string, repository string, principal string) (*client.RepositoryPermission, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	perm, ok := c.permissions[rp.Id+"/"+principal]
+	if !ok {
+		return nil, notFound("permission", principal)
+	}
+
+	return &perm, nil
+}
+
+func (c *Client) PutRepositoryPermission(workspace string, repository string, principal string, opts client.RepositoryPermissionOptions) (*client.RepositoryPermission, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	perm := client.RepositoryPermission{
+		Principal: principal,
+		Role:      opts.Role,
+	}
+	c.permissions[rp.Id+"/"+principal] = perm
+
+	return &perm, nil
+}
+
+func (c *Client) PutRepositoryPermissions(workspace string, repository string, opts client.RepositoryPermissionsOptions) (*[]client.RepositoryPermission, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	perms := []client.RepositoryPermission{}
+	for _, grant := range opts.Grants {
+		c.permissions[rp.Id+"/"+grant.Principal] = grant
+		perms = append(perms, grant)
+	}
+
+	return &perms, nil
+}
+
+func (c *Client) DeleteRepositoryPermission(workspace string, repository string, principal string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	key := rp.Id + "/" + principal
+	if _, ok := c.permissions[key]; !ok {
+		return notFound("permission", principal)
+	}
+	delete(c.permissions, key)
+
+	return nil
+}
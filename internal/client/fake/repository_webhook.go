@@ -0,0 +1,86 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreateRepositoryWebhook(workspace string, repository string, opts client.RepositoryWebhookOptions) (*client.RepositoryWebhook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	wh := client.RepositoryWebhook{
+		Id:      c.newId(),
+		Url:     opts.Url,
+		Events:  slices.Clone(opts.Events),
+		Enabled: opts.Enabled,
+	}
+	c.webhooks[rp.Id+"/"+wh.Id] = wh
+
+	return &wh, nil
+}
+
+func (c *Client) GetRepositoryWebhook(workspace string, repository string, id string) (*client.RepositoryWebhook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	wh, ok := c.webhooks[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("repository webhook", id)
+	}
+	wh.Events = slices.Clone(wh.Events)
+
+	return &wh, nil
+}
+
+func (c *Client) UpdateRepositoryWebhook(workspace string, repository string, id string, opts client.RepositoryWebhookOptions) (*client.RepositoryWebhook, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.webhooks[rp.Id+"/"+id]; !ok {
+		return nil, notFound("repository webhook", id)
+	}
+
+	wh := client.RepositoryWebhook{
+		Id:      id,
+		Url:     opts.Url,
+		Events:  slices.Clone(opts.Events),
+		Enabled: opts.Enabled,
+	}
+	c.webhooks[rp.Id+"/"+id] = wh
+
+	return &wh, nil
+}
+
+func (c *Client) DeleteRepositoryWebhook(workspace string, repository string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.webhooks[rp.Id+"/"+id]; !ok {
+		return notFound("repository webhook", id)
+	}
+	delete(c.webhooks, rp.Id+"/"+id)
+
+	return nil
+}
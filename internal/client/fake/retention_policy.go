@@ -0,0 +1,105 @@
+package fake
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// retentionPolicy builds a retention policy of a workspace, checking its repositories.
+// It must be called with the lock held.
+func (c *Client) retentionPolicy(ws *client.Workspace, id string, opts client.RetentionPolicyOptions) (client.RetentionPolicy, error) {
+	for _, repositoryId := range opts.RepositoryIds {
+		rp, err := c.repository(ws.Id, repositoryId)
+		if err != nil {
+			return client.RetentionPolicy{}, err
+		}
+		if rp.RepositoryType != "remote" {
+			return client.RetentionPolicy{}, fmt.Errorf("repository %s is not a remote repository", rp.Name)
+		}
+	}
+
+	return client.RetentionPolicy{
+		Id:            id,
+		Name:          opts.Name,
+		RepositoryIds: slices.Clone(opts.RepositoryIds),
+		UnusedDays:    opts.UnusedDays,
+		Schedule:      opts.Schedule,
+	}, nil
+}
+
+func (c *Client) CreateRetentionPolicy(workspace string, opts client.RetentionPolicyOptions) (*client.RetentionPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, err := c.retentionPolicy(ws, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.retentionPolicies[ws.Id+"/"+rt.Id] = rt
+
+	return &rt, nil
+}
+
+func (c *Client) GetRetentionPolicy(workspace string, id string) (*client.RetentionPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	rt, ok := c.retentionPolicies[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("retention policy", id)
+	}
+	rt.RepositoryIds = slices.Clone(rt.RepositoryIds)
+
+	return &rt, nil
+}
+
+func (c *Client) UpdateRetentionPolicy(workspace string, id string, opts client.RetentionPolicyOptions) (*client.RetentionPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.retentionPolicies[ws.Id+"/"+id]; !ok {
+		return nil, notFound("retention policy", id)
+	}
+
+	rt, err := c.retentionPolicy(ws, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.retentionPolicies[ws.Id+"/"+id] = rt
+
+	return &rt, nil
+}
+
+func (c *Client) DeleteRetentionPolicy(workspace string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.retentionPolicies[ws.Id+"/"+id]; !ok {
+		return notFound("retention policy", id)
+	}
+	delete(c.retentionPolicies, ws.Id+"/"+id)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) CreateRoleAssignment(opts client.RoleAssignmentOptions) (*client.RoleAssignment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(opts.WorkspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	ra := client.RoleAssignment{
+		Role:        opts.Role,
+		Principal:   opts.Principal,
+		WorkspaceId: ws.Id,
+	}
+
+	if opts.RepositoryId != nil {
+		rp, err := c.repository(ws.Id, *opts.RepositoryId)
+		if err != nil {
+			return nil, err
+		}
+		ra.RepositoryId = &rp.Id
+	}
+
+	// A principal holds a single role per scope
+	for _, other := range c.roleAssignments {
+		if other.Principal == ra.Principal && other.WorkspaceId == ra.WorkspaceId &&
+			(other.RepositoryId == nil) == (ra.RepositoryId == nil) &&
+			(other.RepositoryId == nil || *other.RepositoryId == *ra.RepositoryId) {
+			return nil, conflict("role assignment of", ra.Principal)
+		}
+	}
+
+	ra.Id = c.newId()
+	c.roleAssignments[ra.Id] = ra
+
+	return &ra, nil
+}
+
+func (c *Client) GetRoleAssignment(id string) (*client.RoleAssignment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ra, ok := c.roleAssignments[id]
+	if !ok {
+		return nil, notFound("role assignment", id)
+	}
+
+	return &ra, nil
+}
+
+func (c *Client) UpdateRoleAssignment(id string, opts client.RoleAssignmentUpdateOptions) (*client.RoleAssignment, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ra, ok := c.roleAssignments[id]
+	if !ok {
+		return nil, notFound("role assignment", id)
+	}
+
+	ra.Role = opts.Role
+	c.roleAssignments[id] = ra
+
+	return &ra, nil
+}
+
+func (c *Client) DeleteRoleAssignment(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.roleAssignments[id]; !ok {
+		return notFound("role assignment", id)
+	}
+	delete(c.roleAssignments, id)
+
+	return nil
+}
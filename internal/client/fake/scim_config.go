@@ -0,0 +1,57 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The SCIM provisioning is not found until set.
+
+func (c *Client) GetScimConfig() (*client.ScimConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.scimConfig == nil {
+		return nil, notFound("scim config", "scim")
+	}
+
+	sc := *c.scimConfig
+	return &sc, nil
+}
+
+func (c *Client) UpdateScimConfig(opts client.ScimConfigOptions) (*client.ScimConfig, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// The token is required on the first setup only
+	if c.scimConfig == nil {
+		if opts.Token == nil {
+			return nil, fmt.Errorf("token is required")
+		}
+		c.scimConfig = &client.ScimConfig{
+			BaseUrl: endpointBaseUrl + "/scim/v2",
+		}
+	}
+
+	c.scimConfig.IsEnabled = opts.IsEnabled
+	if opts.Token != nil {
+		c.scimConfig.TokenUpdatedAt = now()
+	}
+
+	sc := *c.scimConfig
+	return &sc, nil
+}
+
+func (c *Client) DeleteScimConfig() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.scimConfig == nil {
+		return notFound("scim config", "scim")
+	}
+
+	c.scimConfig = nil
+
+	return nil
+}
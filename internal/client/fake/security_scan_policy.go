@@ -0,0 +1,61 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetSecurityScanPolicy(workspace string, repository string) (*client.SecurityScanPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	sp, ok := c.scanPolicies[key]
+	if !ok {
+		return nil, notFound("security scan policy", key)
+	}
+
+	return &sp, nil
+}
+
+func (c *Client) PutSecurityScanPolicy(workspace string, repository string, opts client.SecurityScanPolicyOptions) (*client.SecurityScanPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, workspaceId, repositoryId, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	sp := client.SecurityScanPolicy{
+		WorkspaceId:   workspaceId,
+		RepositoryId:  repositoryId,
+		BlockSeverity: opts.BlockSeverity,
+		FailMode:      opts.FailMode,
+		ScanOnUpload:  opts.ScanOnUpload,
+	}
+	c.scanPolicies[key] = sp
+
+	return &sp, nil
+}
+
+func (c *Client) DeleteSecurityScanPolicy(workspace string, repository string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.scanPolicies[key]; !ok {
+		return notFound("security scan policy", key)
+	}
+
+	delete(c.scanPolicies, key)
+
+	return nil
+}
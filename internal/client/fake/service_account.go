@@ -0,0 +1,126 @@
+package fake
+
+import (
+	"slices"
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// copyServiceAccount returns a copy not sharing its slices with the stored account.
+func copyServiceAccount(sa client.ServiceAccount) *client.ServiceAccount {
+	sa.Scopes = slices.Clone(sa.Scopes)
+	sa.Tokens = slices.Clone(sa.Tokens)
+	return &sa
+}
+
+func (c *Client) CreateServiceAccount(opts client.ServiceAccountOptions) (*client.ServiceAccount, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sa := range c.serviceAccounts {
+		if sa.Name == opts.Name {
+			return nil, conflict("service account", opts.Name)
+		}
+	}
+
+	sa := client.ServiceAccount{
+		Id:          c.newId(),
+		Name:        opts.Name,
+		Description: opts.Description,
+		Scopes:      slices.Clone(opts.Scopes),
+		Tokens:      []client.ServiceAccountToken{},
+		CreatedAt:   time.Now().UTC().Format(time.RFC3339),
+	}
+	c.serviceAccounts[sa.Id] = sa
+
+	return copyServiceAccount(sa), nil
+}
+
+func (c *Client) GetServiceAccount(id string) (*client.ServiceAccount, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sa, ok := c.serviceAccounts[id]
+	if !ok {
+		return nil, notFound("service account", id)
+	}
+
+	return copyServiceAccount(sa), nil
+}
+
+func (c *Client) UpdateServiceAccount(id string, opts client.ServiceAccountUpdateOptions) (*client.ServiceAccount, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sa, ok := c.serviceAccounts[id]
+	if !ok {
+		return nil, notFound("service account", id)
+	}
+	sa.Description = opts.Description
+	sa.Scopes = slices.Clone(opts.Scopes)
+	c.serviceAccounts[id] = sa
+
+	return copyServiceAccount(sa), nil
+}
+
+func (c *Client) DeleteServiceAccount(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.serviceAccounts[id]; !ok {
+		return notFound("service account", id)
+	}
+	delete(c.serviceAccounts, id)
+
+	return nil
+}
+
+func (c *Client) CreateServiceAccountToken(account string, opts client.ServiceAccountTokenOptions) (*client.ServiceAccountToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sa, ok := c.serviceAccounts[account]
+	if !ok {
+		return nil, notFound("service account", account)
+	}
+	for _, t := range sa.Tokens {
+		if t.Name == opts.Name {
+			return nil, conflict("service account token", opts.Name)
+		}
+	}
+
+	t := client.ServiceAccountToken{
+		Id:        c.newId(),
+		Name:      opts.Name,
+		ExpiresAt: opts.ExpiresAt,
+		CreatedAt: time.Now().UTC().Format(time.RFC3339),
+	}
+	sa.Tokens = append(slices.Clone(sa.Tokens), t)
+	c.serviceAccounts[account] = sa
+
+	// The value is only given back on creation
+	token := newToken()
+	t.Token = &token
+
+	return &t, nil
+}
+
+func (c *Client) DeleteServiceAccountToken(account string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sa, ok := c.serviceAccounts[account]
+	if !ok {
+		return notFound("service account", account)
+	}
+
+	i := slices.IndexFunc(sa.Tokens, func(t client.ServiceAccountToken) bool { return t.Id == id })
+	if i < 0 {
+		return notFound("service account token", id)
+	}
+	sa.Tokens = slices.Delete(slices.Clone(sa.Tokens), i, i+1)
+	c.serviceAccounts[account] = sa
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The session policy always exists, with the defaults of the API until set.
+var defaultSessionPolicy = client.SessionPolicy{
+	MaxSessionAgeHours: 24,
+	IdleTimeoutMinutes: 60,
+}
+
+func (c *Client) GetSessionPolicy() (*client.SessionPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sp := defaultSessionPolicy
+	if c.sessionPolicy != nil {
+		sp = *c.sessionPolicy
+	}
+
+	return &sp, nil
+}
+
+func (c *Client) UpdateSessionPolicy(opts client.SessionPolicyOptions) (*client.SessionPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessionPolicy = &client.SessionPolicy{
+		MaxSessionAgeHours: opts.MaxSessionAgeHours,
+		IdleTimeoutMinutes: opts.IdleTimeoutMinutes,
+		MaxTokenTtlDays:    opts.MaxTokenTtlDays,
+	}
+
+	sp := *c.sessionPolicy
+	return &sp, nil
+}
+
+func (c *Client) DeleteSessionPolicy() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.sessionPolicy = nil
+
+	return nil
+}
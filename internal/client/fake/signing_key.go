@@ -0,0 +1,130 @@
+package fake
+
+import (
+	"cmp"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Package types whose metadata is signed
+var signedPackageTypes = []string{"debian", "rpm", "terraform-provider"}
+
+// signingKeyRepositories checks the repositories signed by a key. It must be
+// called with the lock held.
+func (c *Client) signingKeyRepositories(ws *client.Workspace, repositoryIds []string) error {
+	for _, repositoryId := range repositoryIds {
+		rp, err := c.repository(ws.Id, repositoryId)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(signedPackageTypes, rp.PackageType) {
+			return fmt.Errorf("repository %s stores %s packages, their metadata is not signed", rp.Name, rp.PackageType)
+		}
+	}
+
+	return nil
+}
+
+func (c *Client) CreateSigningKey(workspace string, opts client.SigningKeyOptions) (*client.SigningKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.signingKeyRepositories(ws, opts.RepositoryIds); err != nil {
+		return nil, err
+	}
+
+	sk := client.SigningKey{
+		Id:            c.newId(),
+		Name:          opts.Name,
+		Algorithm:     cmp.Or(opts.Algorithm, "rsa4096"),
+		RepositoryIds: slices.Clone(opts.RepositoryIds),
+		Generated:     opts.PrivateKey == nil,
+		CreatedAt:     *now(),
+	}
+
+	// The fake does not speak OpenPGP, derive stable values from the key material
+	material := sk.Id
+	if opts.PrivateKey != nil {
+		material = *opts.PrivateKey
+		sk.Algorithm = "imported"
+	}
+	sum := sha256.Sum256([]byte(material))
+	sk.Fingerprint = strings.ToUpper(hex.EncodeToString(sum[:20]))
+	sk.PublicKey = fmt.Sprintf("-----BEGIN PGP PUBLIC KEY BLOCK-----\n\n%s\n-----END PGP PUBLIC KEY BLOCK-----\n", base64.StdEncoding.EncodeToString(sum[:]))
+
+	c.signingKeys[ws.Id+"/"+sk.Id] = sk
+
+	return &sk, nil
+}
+
+func (c *Client) GetSigningKey(workspace string, id string) (*client.SigningKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	sk, ok := c.signingKeys[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("signing key", id)
+	}
+	sk.RepositoryIds = slices.Clone(sk.RepositoryIds)
+
+	return &sk, nil
+}
+
+func (c *Client) UpdateSigningKey(workspace string, id string, opts client.SigningKeyUpdateOptions) (*client.SigningKey, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	sk, ok := c.signingKeys[ws.Id+"/"+id]
+	if !ok {
+		return nil, notFound("signing key", id)
+	}
+
+	if err := c.signingKeyRepositories(ws, opts.RepositoryIds); err != nil {
+		return nil, err
+	}
+
+	sk.Name = opts.Name
+	sk.RepositoryIds = slices.Clone(opts.RepositoryIds)
+	c.signingKeys[ws.Id+"/"+id] = sk
+	sk.RepositoryIds = slices.Clone(sk.RepositoryIds)
+
+	return &sk, nil
+}
+
+func (c *Client) DeleteSigningKey(workspace string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.signingKeys[ws.Id+"/"+id]; !ok {
+		return notFound("signing key", id)
+	}
+	delete(c.signingKeys, ws.Id+"/"+id)
+
+	return nil
+}
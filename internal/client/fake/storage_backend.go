@@ -0,0 +1,99 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// storageBackend checks that a storage backend exists. It must be called with
+// the lock held.
+func (c *Client) storageBackend(id *string) error {
+	if id == nil {
+		return nil
+	}
+	if _, ok := c.storageBackends[*id]; !ok {
+		return notFound("storage backend", *id)
+	}
+	return nil
+}
+
+func (c *Client) CreateStorageBackend(opts client.StorageBackendOptions) (*client.StorageBackend, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, sb := range c.storageBackends {
+		if sb.Name == opts.Name {
+			return nil, conflict("storage backend", opts.Name)
+		}
+	}
+
+	sb := client.StorageBackend{
+		Id:          c.newId(),
+		Name:        opts.Name,
+		BackendType: opts.BackendType,
+		Bucket:      opts.Bucket,
+		Region:      opts.Region,
+		Endpoint:    opts.Endpoint,
+		Path:        opts.Path,
+	}
+	c.storageBackends[sb.Id] = sb
+
+	return &sb, nil
+}
+
+func (c *Client) GetStorageBackend(id string) (*client.StorageBackend, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sb, ok := c.storageBackends[id]
+	if !ok {
+		return nil, notFound("storage backend", id)
+	}
+
+	return &sb, nil
+}
+
+func (c *Client) UpdateStorageBackend(id string, opts client.StorageBackendOptions) (*client.StorageBackend, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sb, ok := c.storageBackends[id]
+	if !ok {
+		return nil, notFound("storage backend", id)
+	}
+
+	sb.Name = opts.Name
+	sb.Bucket = opts.Bucket
+	sb.Region = opts.Region
+	sb.Endpoint = opts.Endpoint
+	sb.Path = opts.Path
+	c.storageBackends[id] = sb
+
+	return &sb, nil
+}
+
+func (c *Client) DeleteStorageBackend(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sb, ok := c.storageBackends[id]
+	if !ok {
+		return notFound("storage backend", id)
+	}
+
+	for _, ws := range c.workspaces {
+		if ws.StorageBackendId != nil && *ws.StorageBackendId == id {
+			return fmt.Errorf("storage backend %s is used by workspace %s", sb.Name, ws.Name)
+		}
+	}
+	for _, rp := range c.repositories {
+		if rp.StorageBackendId != nil && *rp.StorageBackendId == id {
+			return fmt.Errorf("storage backend %s is used by repository %s", sb.Name, rp.Name)
+		}
+	}
+
+	delete(c.storageBackends, id)
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package fake
+
+import (
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// System tasks run synchronously, they are over as soon as started.
+
+func (c *Client) StartSystemTask(opts client.SystemTaskOptions) (*client.SystemTask, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	workspaceId := ""
+	if opts.WorkspaceId != nil {
+		ws, err := c.workspace(*opts.WorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+		workspaceId = ws.Id
+	}
+
+	total := 0
+	for _, rp := range c.repositories {
+		if rp.PackageType == opts.PackageType && (workspaceId == "" || rp.WorkspaceId == workspaceId) {
+			total++
+		}
+	}
+
+	now := time.Now().UTC().Format(time.RFC3339)
+	st := client.SystemTask{
+		Id:          c.newId(),
+		Task:        opts.Task,
+		Status:      client.SystemTaskSucceeded,
+		Processed:   total,
+		Total:       total,
+		StartedAt:   &now,
+		CompletedAt: &now,
+	}
+	c.systemTasks[st.Id] = st
+
+	return &st, nil
+}
+
+func (c *Client) GetSystemTask(id string) (*client.SystemTask, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	st, ok := c.systemTasks[id]
+	if !ok {
+		return nil, notFound("system task", id)
+	}
+
+	return &st, nil
+}
@@ -0,0 +1,100 @@
+package fake
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// parseCertificate fills the certificate details computed by the API from the
+// leaf certificate of the PEM chain.
+func parseCertificate(cert *client.TlsCertificate) error {
+	block, _ := pem.Decode([]byte(cert.Certificate))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("certificate %s is not a PEM encoded certificate", cert.Name)
+	}
+
+	leaf, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("certificate %s: %w", cert.Name, err)
+	}
+
+	sum := sha256.Sum256(leaf.Raw)
+	cert.Fingerprint = hex.EncodeToString(sum[:])
+	cert.NotBefore = leaf.NotBefore.UTC().Format(time.RFC3339)
+	cert.ExpiresAt = leaf.NotAfter.UTC().Format(time.RFC3339)
+
+	return nil
+}
+
+func (c *Client) CreateTlsCertificate(opts client.TlsCertificateOptions) (*client.TlsCertificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if opts.PrivateKey == "" {
+		return nil, fmt.Errorf("certificate %s has no private key", opts.Name)
+	}
+
+	cert := client.TlsCertificate{
+		Name:        opts.Name,
+		Certificate: opts.Certificate,
+	}
+	if err := parseCertificate(&cert); err != nil {
+		return nil, err
+	}
+	cert.Id = c.newId()
+	c.tlsCertificates[cert.Id] = cert
+
+	return &cert, nil
+}
+
+func (c *Client) GetTlsCertificate(id string) (*client.TlsCertificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cert, ok := c.tlsCertificates[id]
+	if !ok {
+		return nil, notFound("TLS certificate", id)
+	}
+
+	return &cert, nil
+}
+
+func (c *Client) UpdateTlsCertificate(id string, opts client.TlsCertificateOptions) (*client.TlsCertificate, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cert, ok := c.tlsCertificates[id]
+	if !ok {
+		return nil, notFound("TLS certificate", id)
+	}
+
+	if opts.PrivateKey == "" {
+		return nil, fmt.Errorf("certificate %s has no private key", cert.Name)
+	}
+
+	cert.Certificate = opts.Certificate
+	if err := parseCertificate(&cert); err != nil {
+		return nil, err
+	}
+	c.tlsCertificates[id] = cert
+
+	return &cert, nil
+}
+
+func (c *Client) DeleteTlsCertificate(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.tlsCertificates[id]; !ok {
+		return notFound("TLS certificate", id)
+	}
+	delete(c.tlsCertificates, id)
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package fake
+
+import (
+	"fmt"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// trustedPublisher builds a trusted publisher of a local repository.
+// It must be called with the lock held.
+func (c *Client) trustedPublisher(rp *client.Repository, id string, opts client.TrustedPublisherOptions) (client.TrustedPublisher, error) {
+	if rp.RepositoryType != "local" {
+		return client.TrustedPublisher{}, fmt.Errorf("repository %s is not a local repository", rp.Name)
+	}
+
+	// Like the API, expect the instance URL by default
+	audience := "https://repoflow.fake"
+	if opts.Audience != nil {
+		audience = *opts.Audience
+	}
+
+	return client.TrustedPublisher{
+		Id:              id,
+		Issuer:          opts.Issuer,
+		Audience:        audience,
+		SubjectPatterns: opts.SubjectPatterns,
+		RequiredClaims:  opts.RequiredClaims,
+		Description:     opts.Description,
+		IsEnabled:       opts.IsEnabled,
+	}, nil
+}
+
+func (c *Client) CreateTrustedPublisher(workspace string, repository string, opts client.TrustedPublisherOptions) (*client.TrustedPublisher, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	tp, err := c.trustedPublisher(rp, c.newId(), opts)
+	if err != nil {
+		return nil, err
+	}
+	c.trustedPublishers[rp.Id+"/"+tp.Id] = tp
+
+	return &tp, nil
+}
+
+func (c *Client) GetTrustedPublisher(workspace string, repository string, id string) (*client.TrustedPublisher, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	tp, ok := c.trustedPublishers[rp.Id+"/"+id]
+	if !ok {
+		return nil, notFound("trusted publisher", id)
+	}
+
+	return &tp, nil
+}
+
+func (c *Client) UpdateTrustedPublisher(workspace string, repository string, id string, opts client.TrustedPublisherOptions) (*client.TrustedPublisher, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := c.trustedPublishers[rp.Id+"/"+id]; !ok {
+		return nil, notFound("trusted publisher", id)
+	}
+
+	tp, err := c.trustedPublisher(rp, id, opts)
+	if err != nil {
+		return nil, err
+	}
+	c.trustedPublishers[rp.Id+"/"+id] = tp
+
+	return &tp, nil
+}
+
+func (c *Client) DeleteTrustedPublisher(workspace string, repository string, id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	rp, err := c.repository(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.trustedPublishers[rp.Id+"/"+id]; !ok {
+		return notFound("trusted publisher", id)
+	}
+	delete(c.trustedPublishers, rp.Id+"/"+id)
+
+	return nil
+}
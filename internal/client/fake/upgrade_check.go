@@ -0,0 +1,17 @@
+package fake
+
+import (
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetUpgradeCheck() (*client.UpgradeCheck, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	uc := c.UpgradeCheck
+	uc.BreakingChanges = slices.Clone(uc.BreakingChanges)
+
+	return &uc, nil
+}
@@ -0,0 +1,62 @@
+package fake
+
+import (
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) GetUploadPolicy(workspace string, repository string) (*client.UploadPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	up, ok := c.uploadPolicies[key]
+	if !ok {
+		return nil, notFound("upload policy", key)
+	}
+
+	return &up, nil
+}
+
+func (c *Client) PutUploadPolicy(workspace string, repository string, opts client.UploadPolicyOptions) (*client.UploadPolicy, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, workspaceId, repositoryId, err := c.scope(workspace, repository)
+	if err != nil {
+		return nil, err
+	}
+
+	up := client.UploadPolicy{
+		WorkspaceId:          workspaceId,
+		RepositoryId:         repositoryId,
+		MaxArtifactSizeBytes: opts.MaxArtifactSizeBytes,
+		AllowedExtensions:    opts.AllowedExtensions,
+		IsChecksumRequired:   opts.IsChecksumRequired,
+		BlockedPathPatterns:  opts.BlockedPathPatterns,
+	}
+	c.uploadPolicies[key] = up
+
+	return &up, nil
+}
+
+func (c *Client) DeleteUploadPolicy(workspace string, repository string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key, _, _, err := c.scope(workspace, repository)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := c.uploadPolicies[key]; !ok {
+		return notFound("upload policy", key)
+	}
+
+	delete(c.uploadPolicies, key)
+
+	return nil
+}
@@ -0,0 +1,87 @@
+package fake
+
+import (
+	"time"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// lapsed reports whether an allowed vulnerability expired, like the API which
+// no longer returns it.
+func lapsed(av client.AllowedVulnerability) bool {
+	expiry, err := time.Parse(time.RFC3339, av.ExpiresAt)
+	return err == nil && !expiry.After(time.Now())
+}
+
+func (c *Client) CreateAllowedVulnerability(opts client.AllowedVulnerabilityOptions) (*client.AllowedVulnerability, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	av := client.AllowedVulnerability{
+		Id:              c.newId(),
+		VulnerabilityId: opts.VulnerabilityId,
+		PackageName:     opts.PackageName,
+		Justification:   opts.Justification,
+		ExpiresAt:       opts.ExpiresAt,
+	}
+
+	// Stored with the workspace and repository Ids, even when given by name
+	if opts.WorkspaceId != nil {
+		ws, err := c.workspace(*opts.WorkspaceId)
+		if err != nil {
+			return nil, err
+		}
+		av.WorkspaceId = &ws.Id
+
+		if opts.RepositoryId != nil {
+			rp, err := c.repository(ws.Id, *opts.RepositoryId)
+			if err != nil {
+				return nil, err
+			}
+			av.RepositoryId = &rp.Id
+		}
+	}
+	c.allowedVulns[av.Id] = av
+
+	return &av, nil
+}
+
+func (c *Client) GetAllowedVulnerability(id string) (*client.AllowedVulnerability, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	av, ok := c.allowedVulns[id]
+	if !ok || lapsed(av) {
+		return nil, notFound("allowed vulnerability", id)
+	}
+
+	return &av, nil
+}
+
+func (c *Client) UpdateAllowedVulnerability(id string, opts client.AllowedVulnerabilityUpdateOptions) (*client.AllowedVulnerability, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	av, ok := c.allowedVulns[id]
+	if !ok || lapsed(av) {
+		return nil, notFound("allowed vulnerability", id)
+	}
+
+	av.Justification = opts.Justification
+	av.ExpiresAt = opts.ExpiresAt
+	c.allowedVulns[id] = av
+
+	return &av, nil
+}
+
+func (c *Client) DeleteAllowedVulnerability(id string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, ok := c.allowedVulns[id]; !ok {
+		return notFound("allowed vulnerability", id)
+	}
+	delete(c.allowedVulns, id)
+
+	return nil
+}
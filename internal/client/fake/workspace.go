@@ -0,0 +1,157 @@
+package fake
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// workspace finds a workspace by Id or name. It must be called with the lock held.
+func (c *Client) workspace(id string) (*client.Workspace, error) {
+	if ws, ok := c.workspaces[id]; ok {
+		return ws, nil
+	}
+
+	for _, ws := range c.workspaces {
+		if ws.Name == id {
+			return ws, nil
+		}
+	}
+
+	return nil, notFound("workspace", id)
+}
+
+// copyWorkspace returns a copy of the workspace safe to hand to the caller.
+func copyWorkspace(ws *client.Workspace) *client.Workspace {
+	cp := *ws
+	cp.Labels = maps.Clone(ws.Labels)
+	return &cp
+}
+
+func (c *Client) ListWorkspaces() (*[]repoflow.Workspaces, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	wss := []repoflow.Workspaces{}
+	for _, ws := range c.workspaces {
+		wss = append(wss, repoflow.Workspaces{Id: ws.Id, Name: ws.Name})
+	}
+	sort.Slice(wss, func(i, j int) bool { return wss[i].Name < wss[j].Name })
+
+	return &wss, nil
+}
+
+func (c *Client) CreateWorkspace(opts client.WorkspaceOptions) (*client.Workspace, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if _, err := c.workspace(opts.Name); err == nil {
+		return nil, conflict("workspace", opts.Name)
+	}
+	if err := c.storageBackend(opts.StorageBackendId); err != nil {
+		return nil, err
+	}
+
+	ws := &client.Workspace{
+		Workspace: repoflow.Workspace{
+			Id:                  c.newId(),
+			Name:                opts.Name,
+			StorageLimitInByte:  opts.StorageLimit,
+			TransferLimitInByte: opts.BandwidthLimit,
+			PackageLimit:        opts.PackageLimit,
+		},
+		Description:               opts.Comments,
+		Labels:                    maps.Clone(opts.Labels),
+		DefaultRemoteCacheEnabled: opts.DefaultRemoteCacheEnabled,
+		DefaultFileCacheTtl:       opts.DefaultFileCacheTtl,
+		DefaultMetadataCacheTtl:   opts.DefaultMetadataCacheTtl,
+		DefaultMemberRole:         opts.DefaultMemberRole,
+		AuditRetentionDays:        opts.AuditRetentionDays,
+		StorageBackendId:          opts.StorageBackendId,
+		ManagedBy:                 stringOrNil(opts.ManagedBy),
+		UpdatedAt:                 now(),
+	}
+	c.workspaces[ws.Id] = ws
+
+	return copyWorkspace(ws), nil
+}
+
+func (c *Client) GetWorkspace(id string) (*client.Workspace, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyWorkspace(ws), nil
+}
+
+func (c *Client) UpdateWorkspace(id string, opts client.WorkspaceUpdateOptions) (*client.Workspace, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.storageBackend(opts.StorageBackendId); err != nil {
+		return nil, err
+	}
+
+	// The update is a full replacement of the managed settings
+	ws.Description = opts.Description
+	ws.Labels = maps.Clone(opts.Labels)
+	ws.StorageLimitInByte = opts.StorageLimit
+	ws.DefaultRemoteCacheEnabled = opts.DefaultRemoteCacheEnabled
+	ws.DefaultFileCacheTtl = opts.DefaultFileCacheTtl
+	ws.DefaultMetadataCacheTtl = opts.DefaultMetadataCacheTtl
+	ws.DefaultMemberRole = opts.DefaultMemberRole
+	ws.AuditRetentionDays = opts.AuditRetentionDays
+	ws.StorageBackendId = opts.StorageBackendId
+	ws.ManagedBy = opts.ManagedBy
+	ws.UpdatedAt = now()
+
+	return copyWorkspace(ws), nil
+}
+
+func (c *Client) DeleteWorkspace(id string) (*repoflow.Workspace, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Like the API, refuse to delete a workspace which still holds repositories
+	for _, rp := range c.repositories {
+		if rp.WorkspaceId == ws.Id {
+			return nil, fmt.Errorf("workspace %s is not empty", ws.Name)
+		}
+	}
+
+	delete(c.workspaces, ws.Id)
+	deletePrefix(c.credentials, ws.Id+"/")
+	deletePrefix(c.cleanupPolicies, ws.Id+"/")
+	deletePrefix(c.retentionPolicies, ws.Id+"/")
+	deletePrefix(c.quarantineRules, ws.Id+"/")
+	deletePrefix(c.promotionPipelines, ws.Id+"/")
+	deletePrefix(c.buildInfos, ws.Id+"/")
+	deletePrefix(c.signingKeys, ws.Id+"/")
+	delete(c.ipAllowlists, ws.Id)
+	delete(c.anonymousAccess, ws.Id)
+	delete(c.scanPolicies, ws.Id)
+	delete(c.licensePolicies, ws.Id)
+	delete(c.uploadPolicies, ws.Id)
+	delete(c.defaultPermissions, ws.Id)
+	delete(c.workspaceSettings, ws.Id)
+
+	return &ws.Workspace, nil
+}
@@ -0,0 +1,126 @@
+package fake
+
+import (
+	"cmp"
+	"encoding/json"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// workspaceDocument is the configuration document of a workspace. The
+// repositories are referenced by name, so a document can be imported in
+// another workspace.
+type workspaceDocument struct {
+	FormatVersion     int                      `json:"formatVersion"`
+	Workspace         documentWorkspace        `json:"workspace"`
+	Repositories      []client.Repository      `json:"repositories"`
+	CleanupPolicies   []client.CleanupPolicy   `json:"cleanupPolicies"`
+	RetentionPolicies []client.RetentionPolicy `json:"retentionPolicies"`
+	Permissions       []documentPermission     `json:"permissions"`
+}
+
+type documentWorkspace struct {
+	Name        string            `json:"name"`
+	Description *string           `json:"description"`
+	Labels      map[string]string `json:"labels"`
+}
+
+type documentPermission struct {
+	Repository string `json:"repository"`
+	Principal  string `json:"principal"`
+	Role       string `json:"role"`
+}
+
+func (c *Client) ExportWorkspace(workspace string) (json.RawMessage, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(c.exportDocument(ws))
+}
+
+// exportDocument returns the configuration document of a workspace. It must be
+// called with the lock held.
+func (c *Client) exportDocument(ws *client.Workspace) workspaceDocument {
+	doc := workspaceDocument{
+		FormatVersion: 1,
+		Workspace: documentWorkspace{
+			Name:        ws.Name,
+			Description: ws.Description,
+			Labels:      maps.Clone(ws.Labels),
+		},
+		Repositories:      []client.Repository{},
+		CleanupPolicies:   []client.CleanupPolicy{},
+		RetentionPolicies: []client.RetentionPolicy{},
+		Permissions:       []documentPermission{},
+	}
+
+	names := map[string]string{}
+	for _, rp := range c.repositories {
+		if rp.WorkspaceId != ws.Id {
+			continue
+		}
+		names[rp.Id] = rp.Name
+		doc.Repositories = append(doc.Repositories, documentRepository(rp))
+	}
+	slices.SortFunc(doc.Repositories, func(a, b client.Repository) int { return strings.Compare(a.Name, b.Name) })
+
+	for key, cp := range c.cleanupPolicies {
+		if strings.HasPrefix(key, ws.Id+"/") {
+			cp.Id = ""
+			cp.RepositoryIds = repositoryNames(cp.RepositoryIds, names)
+			doc.CleanupPolicies = append(doc.CleanupPolicies, cp)
+		}
+	}
+	slices.SortFunc(doc.CleanupPolicies, func(a, b client.CleanupPolicy) int { return strings.Compare(a.Name, b.Name) })
+
+	for key, rt := range c.retentionPolicies {
+		if strings.HasPrefix(key, ws.Id+"/") {
+			rt.Id = ""
+			rt.RepositoryIds = repositoryNames(rt.RepositoryIds, names)
+			doc.RetentionPolicies = append(doc.RetentionPolicies, rt)
+		}
+	}
+	slices.SortFunc(doc.RetentionPolicies, func(a, b client.RetentionPolicy) int { return strings.Compare(a.Name, b.Name) })
+
+	for key, perm := range c.permissions {
+		id, _, _ := strings.Cut(key, "/")
+		if name, ok := names[id]; ok {
+			doc.Permissions = append(doc.Permissions, documentPermission{Repository: name, Principal: perm.Principal, Role: perm.Role})
+		}
+	}
+	slices.SortFunc(doc.Permissions, func(a, b documentPermission) int {
+		return cmp.Or(strings.Compare(a.Repository, b.Repository), strings.Compare(a.Principal, b.Principal))
+	})
+
+	return doc
+}
+
+// documentRepository returns the settings of a repository without the values
+// tied to the instance, like its Id.
+func documentRepository(rp *client.Repository) client.Repository {
+	cp := *copyRepository(rp)
+	cp.Id = ""
+	cp.WorkspaceId = ""
+	cp.Status = ""
+	cp.UpdatedAt = nil
+	cp.TerraformRegistry = nil
+	return cp
+}
+
+// repositoryNames replaces repository Ids with their names.
+func repositoryNames(ids []string, names map[string]string) []string {
+	out := []string{}
+	for _, id := range ids {
+		out = append(out, cmp.Or(names[id], id))
+	}
+	slices.Sort(out)
+	return out
+}
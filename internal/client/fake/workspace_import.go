@@ -0,0 +1,168 @@
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func (c *Client) ImportWorkspace(workspace string, opts client.WorkspaceImportOptions) (*client.WorkspaceImport, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc workspaceDocument
+	if err := json.Unmarshal(opts.Document, &doc); err != nil {
+		return nil, fmt.Errorf("invalid document: %w", err)
+	}
+	if doc.FormatVersion != 1 {
+		return nil, fmt.Errorf("unsupported document format version %d", doc.FormatVersion)
+	}
+
+	// Objects are matched by name with the export of the workspace
+	current := c.exportDocument(ws)
+	result := &client.WorkspaceImport{Changes: []client.WorkspaceImportChange{}}
+
+	change := func(kind string, name string, found bool) {
+		action := "update"
+		if !found {
+			action = "create"
+		}
+		result.Changes = append(result.Changes, client.WorkspaceImportChange{Kind: kind, Name: name, Action: action})
+	}
+
+	if !reflect.DeepEqual(doc.Workspace.Description, current.Workspace.Description) || !maps.Equal(doc.Workspace.Labels, current.Workspace.Labels) {
+		change("workspace", ws.Name, true)
+		if !opts.DryRun {
+			ws.Description = doc.Workspace.Description
+			ws.Labels = maps.Clone(doc.Workspace.Labels)
+			ws.UpdatedAt = now()
+		}
+	}
+
+	for _, rp := range doc.Repositories {
+		old, found := findByName(current.Repositories, rp.Name, func(r client.Repository) string { return r.Name })
+		if found && reflect.DeepEqual(old, rp) {
+			continue
+		}
+		change("repository", rp.Name, found)
+		if opts.DryRun {
+			continue
+		}
+
+		if !found {
+			if _, err := c.addRepository(ws.Id, copyRepository(&rp)); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		existing, _ := c.repository(ws.Id, rp.Name)
+		updated := copyRepository(&rp)
+		updated.Id = existing.Id
+		updated.WorkspaceId = existing.WorkspaceId
+		updated.Status = existing.Status
+		updated.TerraformRegistry = existing.TerraformRegistry
+		updated.UpdatedAt = now()
+		c.repositories[existing.Id] = updated
+	}
+
+	for _, cp := range doc.CleanupPolicies {
+		old, found := findByName(current.CleanupPolicies, cp.Name, func(p client.CleanupPolicy) string { return p.Name })
+		if found && reflect.DeepEqual(old, cp) {
+			continue
+		}
+		change("cleanup_policy", cp.Name, found)
+		if opts.DryRun {
+			continue
+		}
+
+		if cp.RepositoryIds, err = c.repositoryIds(ws.Id, cp.RepositoryIds); err != nil {
+			return nil, err
+		}
+		cp.Id = policyId(c.cleanupPolicies, ws.Id, cp.Name, func(p client.CleanupPolicy) string { return p.Name }, c.newId)
+		c.cleanupPolicies[ws.Id+"/"+cp.Id] = cp
+	}
+
+	for _, rt := range doc.RetentionPolicies {
+		old, found := findByName(current.RetentionPolicies, rt.Name, func(p client.RetentionPolicy) string { return p.Name })
+		if found && reflect.DeepEqual(old, rt) {
+			continue
+		}
+		change("retention_policy", rt.Name, found)
+		if opts.DryRun {
+			continue
+		}
+
+		if rt.RepositoryIds, err = c.repositoryIds(ws.Id, rt.RepositoryIds); err != nil {
+			return nil, err
+		}
+		rt.Id = policyId(c.retentionPolicies, ws.Id, rt.Name, func(p client.RetentionPolicy) string { return p.Name }, c.newId)
+		c.retentionPolicies[ws.Id+"/"+rt.Id] = rt
+	}
+
+	for _, perm := range doc.Permissions {
+		name := perm.Repository + "/" + perm.Principal
+		old, found := findByName(current.Permissions, name, func(p documentPermission) string { return p.Repository + "/" + p.Principal })
+		if found && old == perm {
+			continue
+		}
+		change("permission", name, found)
+		if opts.DryRun {
+			continue
+		}
+
+		rp, err := c.repository(ws.Id, perm.Repository)
+		if err != nil {
+			return nil, err
+		}
+		c.permissions[rp.Id+"/"+perm.Principal] = client.RepositoryPermission{Principal: perm.Principal, Role: perm.Role}
+	}
+
+	return result, nil
+}
+
+// findByName returns the object of the given name.
+func findByName[T any](items []T, name string, nameOf func(T) string) (T, bool) {
+	for _, item := range items {
+		if nameOf(item) == name {
+			return item, true
+		}
+	}
+
+	var zero T
+	return zero, false
+}
+
+// repositoryIds replaces repository names with their Ids. It must be called
+// with the lock held.
+func (c *Client) repositoryIds(workspace string, names []string) ([]string, error) {
+	ids := []string{}
+	for _, name := range names {
+		rp, err := c.repository(workspace, name)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, rp.Id)
+	}
+	return ids, nil
+}
+
+// policyId returns the Id of the policy of a workspace with the given name,
+// or a new one.
+func policyId[T any](policies map[string]T, workspace string, name string, nameOf func(T) string, newId func() string) string {
+	for key, policy := range policies {
+		if id, ok := strings.CutPrefix(key, workspace+"/"); ok && nameOf(policy) == name {
+			return id
+		}
+	}
+	return newId()
+}
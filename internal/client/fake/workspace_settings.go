@@ -0,0 +1,87 @@
+package fake
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// checkWorkspaceSettings refuses a new repository breaking the settings of its
+// workspace. It must be called with the lock held.
+func (c *Client) checkWorkspaceSettings(workspaceId string, rp *client.Repository) error {
+	st, ok := c.workspaceSettings[workspaceId]
+	if !ok {
+		return nil
+	}
+
+	if len(st.AllowedPackageTypes) > 0 && !slices.Contains(st.AllowedPackageTypes, rp.PackageType) {
+		return fmt.Errorf("package type %s is not allowed in the workspace", rp.PackageType)
+	}
+	if st.RepositoryNamePattern != nil && !regexp.MustCompile(*st.RepositoryNamePattern).MatchString(rp.Name) {
+		return fmt.Errorf("repository name %s does not match %s", rp.Name, *st.RepositoryNamePattern)
+	}
+
+	return nil
+}
+
+func (c *Client) GetWorkspaceSettings(workspace string) (*client.WorkspaceSettings, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	// The settings always exist, without any rule until set
+	st, ok := c.workspaceSettings[ws.Id]
+	if !ok {
+		st = client.WorkspaceSettings{WorkspaceId: ws.Id, AllowedPackageTypes: []string{}}
+	}
+
+	st.AllowedPackageTypes = slices.Clone(st.AllowedPackageTypes)
+	return &st, nil
+}
+
+func (c *Client) PutWorkspaceSettings(workspace string, opts client.WorkspaceSettingsOptions) (*client.WorkspaceSettings, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.RepositoryNamePattern != nil {
+		if _, err := regexp.Compile(*opts.RepositoryNamePattern); err != nil {
+			return nil, fmt.Errorf("invalid repository name pattern: %w", err)
+		}
+	}
+
+	st := client.WorkspaceSettings{
+		WorkspaceId:                ws.Id,
+		AllowedPackageTypes:        slices.Clone(opts.AllowedPackageTypes),
+		RepositoryNamePattern:      opts.RepositoryNamePattern,
+		DefaultRetentionUnusedDays: opts.DefaultRetentionUnusedDays,
+	}
+	c.workspaceSettings[ws.Id] = st
+
+	st.AllowedPackageTypes = slices.Clone(st.AllowedPackageTypes)
+	return &st, nil
+}
+
+func (c *Client) DeleteWorkspaceSettings(workspace string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ws, err := c.workspace(workspace)
+	if err != nil {
+		return err
+	}
+
+	delete(c.workspaceSettings, ws.Id)
+
+	return nil
+}
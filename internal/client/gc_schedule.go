@@ -0,0 +1,63 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	GcSchedulesEndpoint = "/1/system/gc-schedules"
+)
+
+// Garbage collection tasks of the instance
+const (
+	GcTaskBlobs            = "blobs"
+	GcTaskOrphanedMetadata = "orphaned_metadata"
+)
+
+// GcSchedule defines when a garbage collection task of the instance runs. There
+// is one schedule per task.
+type GcSchedule struct {
+	Task string `json:"task"`
+	// Cron expression (UTC)
+	Schedule string `json:"schedule"`
+	// Number of workers of a run
+	Concurrency int  `json:"concurrency"`
+	IsEnabled   bool `json:"isEnabled"`
+	// Stop a run after this number of minutes, the next run resumes it
+	MaxDurationMinutes *int `json:"maxDurationMinutes"`
+}
+
+// GcScheduleOptions defines the payload for replacing the schedule of a garbage collection task
+type GcScheduleOptions struct {
+	Schedule           string `json:"schedule"`
+	Concurrency        int    `json:"concurrency"`
+	IsEnabled          bool   `json:"isEnabled"`
+	MaxDurationMinutes *int   `json:"maxDurationMinutes"`
+}
+
+// GetGcSchedule retrieves the schedule of a garbage collection task
+// GET /1/system/gc-schedules/:task
+func (c *Client) GetGcSchedule(task string) (*GcSchedule, error) {
+	var gs GcSchedule
+	endpoint := fmt.Sprintf("%s/%s", GcSchedulesEndpoint, task)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &gs)
+	return &gs, err
+}
+
+// PutGcSchedule replaces the schedule of a garbage collection task
+// PUT /1/system/gc-schedules/:task
+func (c *Client) PutGcSchedule(task string, opts GcScheduleOptions) (*GcSchedule, error) {
+	var gs GcSchedule
+	endpoint := fmt.Sprintf("%s/%s", GcSchedulesEndpoint, task)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &gs)
+	return &gs, err
+}
+
+// DeleteGcSchedule resets the schedule of a garbage collection task to the instance defaults
+// DELETE /1/system/gc-schedules/:task
+func (c *Client) DeleteGcSchedule(task string) error {
+	endpoint := fmt.Sprintf("%s/%s", GcSchedulesEndpoint, task)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,29 @@
+package client
+
+import (
+	"net/http"
+)
+
+// RunIdHeader tags the requests with the Terraform run sending them, so the
+// audit logs of RepoFlow can be correlated to the runs.
+const RunIdHeader = "X-Terraform-Run-ID"
+
+// headerTransport adds headers to every request.
+type headerTransport struct {
+	base    http.RoundTripper
+	headers http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if len(t.headers) == 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	// A RoundTripper must not modify the request
+	r := req.Clone(req.Context())
+	for k, v := range t.headers {
+		r.Header[k] = v
+	}
+
+	return t.base.RoundTrip(r)
+}
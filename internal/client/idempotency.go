@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"io"
+	"net/http"
+	"syscall"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// IdempotencyKeyHeader lets RepoFlow recognize a creation sent twice and
+// answer the retry with the object created by the first attempt instead of a
+// duplicate.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader is set by RepoFlow on the responses to a key it
+// already processed, the object returned being the one it created then.
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// Number of times a creation is resent, and the delay before the first retry,
+// doubled on each one.
+const (
+	idempotencyRetries = 2
+	idempotencyBackoff = time.Second
+)
+
+// create sends a creation to an endpoint, with an idempotency key and the
+// retries of the lost responses. Only the creations go through it: the other
+// POST requests, like actions or the tokens only returned once, may not be
+// sent twice.
+func (c *Client) create(endpoint string, opts any, result any) error {
+	return c.creator.DoRequest(http.MethodPost, endpoint, opts, result)
+}
+
+// SetLogContext sends the logs of the client, like the creations deduplicated
+// by RepoFlow, to the Terraform logs of the context.
+func (c *Client) SetLogContext(ctx context.Context) {
+	c.idempotency.logCtx = ctx
+}
+
+// idempotencyTransport sends the creations with an idempotency key, and
+// resends them with the same key when the response was lost, like a gateway
+// timing out while RepoFlow went on with the creation. The state then gets the
+// object of the first attempt, as if no retry happened.
+type idempotencyTransport struct {
+	base http.RoundTripper
+
+	// Context of the logs of the deduplicated creations
+	logCtx context.Context
+}
+
+func (t *idempotencyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := rand.Text()
+	backoff := idempotencyBackoff
+
+	for attempt := 0; ; attempt++ {
+		// A RoundTripper must not modify the request, clone it with a fresh body
+		r, err := rewriteRequest(req, req.URL.String())
+		if err != nil {
+			return nil, err
+		}
+		r.Header.Set(IdempotencyKeyHeader, key)
+
+		// A streamed body cannot be sent again
+		replayable := req.Body == nil || req.GetBody != nil
+
+		resp, err := t.base.RoundTrip(r)
+		if err == nil && resp.Header.Get(IdempotentReplayedHeader) == "true" {
+			tflog.Info(t.logCtx, "RepoFlow answered a resent creation with the object of a previous attempt", map[string]interface{}{
+				"method":          req.Method,
+				"url":             req.URL.Redacted(),
+				"idempotency_key": key,
+				"attempt":         attempt + 1,
+			})
+		}
+		if attempt == idempotencyRetries || !replayable || !isLostResponse(resp, err) {
+			return resp, err
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+// isLostResponse reports whether the request may have been processed without
+// its response reaching us.
+func isLostResponse(resp *http.Response, err error) bool {
+	if err != nil {
+		return errors.Is(err, syscall.ECONNRESET) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+func TestIdempotencyRetry(t *testing.T) {
+	var mu sync.Mutex
+	var keys []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		key := r.Header.Get(IdempotencyKeyHeader)
+		keys = append(keys, key)
+
+		// The gateway times out on the first attempt while RepoFlow creates the workspace
+		if len(keys) == 1 {
+			w.WriteHeader(http.StatusGatewayTimeout)
+			return
+		}
+		w.Header().Set(IdempotentReplayedHeader, "true")
+		_, _ = w.Write([]byte(`{"id": "ws-1", "name": "platform"}`))
+	}))
+	defer srv.Close()
+
+	var logs bytes.Buffer
+	c := NewClient([]string{srv.URL}, "token")
+	c.SetLogContext(tflogtest.RootLogger(context.Background(), &logs))
+
+	ws, err := c.CreateWorkspace(WorkspaceOptions{WorkspaceOptions: repoflow.WorkspaceOptions{Name: "platform"}})
+	if err != nil {
+		t.Fatalf("CreateWorkspace: %s", err)
+	}
+	if ws.Id != "ws-1" {
+		t.Errorf("id = %q, want ws-1", ws.Id)
+	}
+
+	if len(keys) != 2 {
+		t.Fatalf("got %d attempts, want 2", len(keys))
+	}
+	if keys[0] == "" || keys[0] != keys[1] {
+		t.Errorf("idempotency keys = %q, want the same key on each attempt", keys)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&logs)
+	if err != nil {
+		t.Fatalf("MultilineJSONDecode: %s", err)
+	}
+	if len(entries) != 1 || entries[0]["idempotency_key"] != keys[0] {
+		t.Errorf("logs = %v, want the deduplicated creation with key %q", entries, keys[0])
+	}
+}
+
+func TestIdempotencyNotCreation(t *testing.T) {
+	var mu sync.Mutex
+	var requests []*http.Request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests = append(requests, r)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	c := NewClient([]string{srv.URL}, "token")
+
+	// Actions and tokens are never resent
+	for name, send := range map[string]func() error{
+		"CloneRepository": func() error {
+			_, err := c.CloneRepository("platform", "npm-local", RepositoryCloneOptions{})
+			return err
+		},
+		"StartSystemTask": func() error {
+			_, err := c.StartSystemTask(SystemTaskOptions{})
+			return err
+		},
+		"CreateAccessToken": func() error {
+			_, err := c.CreateAccessToken(AccessTokenOptions{})
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			requests = nil
+
+			if err := send(); err == nil || !strings.Contains(err.Error(), "502") {
+				t.Errorf("error = %v, want the 502", err)
+			}
+			if len(requests) != 1 {
+				t.Fatalf("got %d attempts, want 1", len(requests))
+			}
+			if key := requests[0].Header.Get(IdempotencyKeyHeader); key != "" {
+				t.Errorf("%s sent with idempotency key %q", name, key)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	ImmutableTagRulesEndpoint = "/immutable-tag-rules"
+)
+
+// ImmutableTagRule prevents the tags of a container repository matching a
+// pattern from being overwritten or deleted once pushed.
+type ImmutableTagRule struct {
+	Id string `json:"id"`
+	// Regular expression matched against the whole tag
+	TagPattern  string  `json:"tagPattern"`
+	Description *string `json:"description"`
+	IsEnabled   bool    `json:"isEnabled"`
+}
+
+// ImmutableTagRuleOptions defines the payload for creating or replacing an immutable tag rule
+type ImmutableTagRuleOptions struct {
+	TagPattern  string  `json:"tagPattern"`
+	Description *string `json:"description"`
+	IsEnabled   bool    `json:"isEnabled"`
+}
+
+func immutableTagRulesEndpoint(workspace string, repository string) string {
+	return fmt.Sprintf("%s%s", repositoryEndpoint(workspace, repository), ImmutableTagRulesEndpoint)
+}
+
+// CreateImmutableTagRule creates a new immutable tag rule on a container repository
+// POST /1/workspaces/:workspace/repositories/:repository/immutable-tag-rules
+func (c *Client) CreateImmutableTagRule(workspace string, repository string, opts ImmutableTagRuleOptions) (*ImmutableTagRule, error) {
+	var it ImmutableTagRule
+	err := c.create(immutableTagRulesEndpoint(workspace, repository), opts, &it)
+	return &it, err
+}
+
+// GetImmutableTagRule retrieves an immutable tag rule of a repository
+// GET /1/workspaces/:workspace/repositories/:repository/immutable-tag-rules/:id
+func (c *Client) GetImmutableTagRule(workspace string, repository string, id string) (*ImmutableTagRule, error) {
+	var it ImmutableTagRule
+	endpoint := fmt.Sprintf("%s/%s", immutableTagRulesEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &it)
+	return &it, err
+}
+
+// UpdateImmutableTagRule replaces an immutable tag rule of a repository
+// PUT /1/workspaces/:workspace/repositories/:repository/immutable-tag-rules/:id
+func (c *Client) UpdateImmutableTagRule(workspace string, repository string, id string, opts ImmutableTagRuleOptions) (*ImmutableTagRule, error) {
+	var it ImmutableTagRule
+	endpoint := fmt.Sprintf("%s/%s", immutableTagRulesEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &it)
+	return &it, err
+}
+
+// DeleteImmutableTagRule deletes an immutable tag rule of a repository, the
+// matching tags can then be overwritten again
+// DELETE /1/workspaces/:workspace/repositories/:repository/immutable-tag-rules/:id
+func (c *Client) DeleteImmutableTagRule(workspace string, repository string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", immutableTagRulesEndpoint(workspace, repository), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,64 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	SystemIpAllowlistEndpoint = "/1/system/ip-allowlist"
+	IpAllowlistEndpoint       = "/ip-allowlist"
+)
+
+// IpAllowlist restricts the addresses reaching the instance or a workspace.
+// The denied addresses win over the allowed ones.
+type IpAllowlist struct {
+	// Unset for the allowlist of the instance
+	WorkspaceId *string `json:"workspaceId"`
+	// Every address is allowed when empty
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// IpAllowlistOptions defines the payload for replacing an allowlist
+type IpAllowlistOptions struct {
+	Allow []string `json:"allow"`
+	Deny  []string `json:"deny"`
+}
+
+// ipAllowlistEndpoint returns the endpoint of the allowlist of a workspace, or
+// of the instance when the workspace is empty.
+func ipAllowlistEndpoint(workspace string) string {
+	if workspace == "" {
+		return SystemIpAllowlistEndpoint
+	}
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, IpAllowlistEndpoint)
+}
+
+// GetIpAllowlist retrieves the allowlist of a workspace, or of the instance when the workspace is empty
+// GET /1/system/ip-allowlist
+// GET /1/workspaces/:workspace/ip-allowlist
+func (c *Client) GetIpAllowlist(workspace string) (*IpAllowlist, error) {
+	var al IpAllowlist
+	err := c.DoRequest(http.MethodGet, ipAllowlistEndpoint(workspace), nil, &al)
+	return &al, err
+}
+
+// PutIpAllowlist replaces the allowlist of a workspace, or of the instance when the workspace is empty
+// PUT /1/system/ip-allowlist
+// PUT /1/workspaces/:workspace/ip-allowlist
+func (c *Client) PutIpAllowlist(workspace string, opts IpAllowlistOptions) (*IpAllowlist, error) {
+	var al IpAllowlist
+	err := c.DoRequest(http.MethodPut, ipAllowlistEndpoint(workspace), opts, &al)
+	return &al, err
+}
+
+// DeleteIpAllowlist lifts the restrictions of a workspace, or of the instance when the workspace is empty
+// DELETE /1/system/ip-allowlist
+// DELETE /1/workspaces/:workspace/ip-allowlist
+func (c *Client) DeleteIpAllowlist(workspace string) error {
+	return c.DoRequest(http.MethodDelete, ipAllowlistEndpoint(workspace), nil, nil)
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Endpoints definitions
+const (
+	LabelTaxonomyEndpoint = "/1/label-taxonomy"
+)
+
+type LabelKey struct {
+	Key           string   `json:"key"`
+	Description   string   `json:"description"`
+	AllowedValues []string `json:"allowedValues"`
+}
+
+// LabelKeyOptions defines the payload for creating or updating a label key
+type LabelKeyOptions struct {
+	Description   string   `json:"description,omitempty"`
+	AllowedValues []string `json:"allowedValues"`
+}
+
+// ListLabelKeys retrieves every label key allowed on the instance
+// GET /1/label-taxonomy
+func (c *Client) ListLabelKeys() (*[]LabelKey, error) {
+	var keys []LabelKey
+	err := c.DoRequest(http.MethodGet, LabelTaxonomyEndpoint, nil, &keys)
+	return &keys, err
+}
+
+// GetLabelKey retrieves a specific label key
+// GET /1/label-taxonomy/:key
+func (c *Client) GetLabelKey(key string) (*LabelKey, error) {
+	var lk LabelKey
+	endpoint := fmt.Sprintf("%s/%s", LabelTaxonomyEndpoint, url.PathEscape(key))
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &lk)
+	return &lk, err
+}
+
+// PutLabelKey creates or replaces a label key with the given options
+// PUT /1/label-taxonomy/:key
+func (c *Client) PutLabelKey(key string, opts LabelKeyOptions) (*LabelKey, error) {
+	var lk LabelKey
+	endpoint := fmt.Sprintf("%s/%s", LabelTaxonomyEndpoint, url.PathEscape(key))
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &lk)
+	return &lk, err
+}
+
+// DeleteLabelKey removes a label key
+// DELETE /1/label-taxonomy/:key
+func (c *Client) DeleteLabelKey(key string) error {
+	endpoint := fmt.Sprintf("%s/%s", LabelTaxonomyEndpoint, url.PathEscape(key))
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	LdapConfigEndpoint = "/1/settings/ldap"
+)
+
+type LdapConfig struct {
+	IsEnabled         bool              `json:"isEnabled"`
+	ServerUrl         string            `json:"serverUrl"`
+	StartTls          bool              `json:"startTls"`
+	BindDn            string            `json:"bindDn"`
+	UserSearchBase    string            `json:"userSearchBase"`
+	UserSearchFilter  string            `json:"userSearchFilter"`
+	GroupSearchBase   *string           `json:"groupSearchBase"`
+	GroupSearchFilter string            `json:"groupSearchFilter"`
+	GroupMappings     map[string]string `json:"groupMappings"`
+}
+
+// LdapConfigOptions defines the payload for updating the LDAP integration
+type LdapConfigOptions struct {
+	IsEnabled         bool              `json:"isEnabled"`
+	ServerUrl         string            `json:"serverUrl"`
+	StartTls          bool              `json:"startTls"`
+	BindDn            string            `json:"bindDn"`
+	UserSearchBase    string            `json:"userSearchBase"`
+	UserSearchFilter  string            `json:"userSearchFilter"`
+	GroupSearchBase   *string           `json:"groupSearchBase"`
+	GroupSearchFilter string            `json:"groupSearchFilter"`
+	GroupMappings     map[string]string `json:"groupMappings"`
+	// The current password is kept when unset
+	BindPassword *string `json:"bindPassword,omitempty"`
+}
+
+// GetLdapConfig retrieves the LDAP integration of the instance, not found until set
+// GET /1/settings/ldap
+func (c *Client) GetLdapConfig() (*LdapConfig, error) {
+	var lc LdapConfig
+	err := c.DoRequest(http.MethodGet, LdapConfigEndpoint, nil, &lc)
+	return &lc, err
+}
+
+// UpdateLdapConfig replaces the LDAP integration of the instance with the given options
+// PUT /1/settings/ldap
+func (c *Client) UpdateLdapConfig(opts LdapConfigOptions) (*LdapConfig, error) {
+	var lc LdapConfig
+	err := c.DoRequest(http.MethodPut, LdapConfigEndpoint, opts, &lc)
+	return &lc, err
+}
+
+// DeleteLdapConfig removes the LDAP integration, the LDAP users can no longer log in
+// DELETE /1/settings/ldap
+func (c *Client) DeleteLdapConfig() error {
+	return c.DoRequest(http.MethodDelete, LdapConfigEndpoint, nil, nil)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	LicensePolicyEndpoint = "/license-policy"
+)
+
+// LicensePolicy defines which package licenses are accepted in a workspace or
+// a repository. The policy of a repository wins over the one of its workspace.
+type LicensePolicy struct {
+	WorkspaceId string `json:"workspaceId"`
+	// Unset for the policy of the workspace
+	RepositoryId *string `json:"repositoryId"`
+	// SPDX identifiers, any other license is a violation when not empty
+	AllowedLicenses []string `json:"allowedLicenses"`
+	// SPDX identifiers always a violation
+	DeniedLicenses []string `json:"deniedLicenses"`
+	// What a violation does: warn (reported) or block
+	Action string `json:"action"`
+	// When the policy is checked: download or upload
+	EnforceOn string `json:"enforceOn"`
+}
+
+// LicensePolicyOptions defines the payload for replacing a license policy
+type LicensePolicyOptions struct {
+	AllowedLicenses []string `json:"allowedLicenses"`
+	DeniedLicenses  []string `json:"deniedLicenses"`
+	Action          string   `json:"action"`
+	EnforceOn       string   `json:"enforceOn"`
+}
+
+// GetLicensePolicy retrieves the license policy of a repository, or of the workspace when the repository is empty
+// GET /1/workspaces/:workspace/license-policy
+// GET /1/workspaces/:workspace/repositories/:repository/license-policy
+func (c *Client) GetLicensePolicy(workspace string, repository string) (*LicensePolicy, error) {
+	var lp LicensePolicy
+	err := c.DoRequest(http.MethodGet, scopedEndpoint(workspace, repository, LicensePolicyEndpoint), nil, &lp)
+	return &lp, err
+}
+
+// PutLicensePolicy replaces the license policy of a repository, or of the workspace when the repository is empty
+// PUT /1/workspaces/:workspace/license-policy
+// PUT /1/workspaces/:workspace/repositories/:repository/license-policy
+func (c *Client) PutLicensePolicy(workspace string, repository string, opts LicensePolicyOptions) (*LicensePolicy, error) {
+	var lp LicensePolicy
+	err := c.DoRequest(http.MethodPut, scopedEndpoint(workspace, repository, LicensePolicyEndpoint), opts, &lp)
+	return &lp, err
+}
+
+// DeleteLicensePolicy removes the license policy of a repository, which then
+// follows its workspace, or of the workspace when the repository is empty
+// DELETE /1/workspaces/:workspace/license-policy
+// DELETE /1/workspaces/:workspace/repositories/:repository/license-policy
+func (c *Client) DeleteLicensePolicy(workspace string, repository string) error {
+	return c.DoRequest(http.MethodDelete, scopedEndpoint(workspace, repository, LicensePolicyEndpoint), nil, nil)
+}
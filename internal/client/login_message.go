@@ -0,0 +1,47 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	LoginMessageEndpoint = "/1/settings/login-message"
+)
+
+type LoginMessage struct {
+	Title                  string `json:"title"`
+	Message                string `json:"message"`
+	IsEnabled              bool   `json:"isEnabled"`
+	RequireAcknowledgement bool   `json:"requireAcknowledgement"`
+}
+
+// LoginMessageOptions defines the payload for updating the login message
+type LoginMessageOptions struct {
+	Title                  string `json:"title,omitempty"`
+	Message                string `json:"message"`
+	IsEnabled              bool   `json:"isEnabled"`
+	RequireAcknowledgement bool   `json:"requireAcknowledgement"`
+}
+
+// GetLoginMessage retrieves the instance login message
+// GET /1/settings/login-message
+func (c *Client) GetLoginMessage() (*LoginMessage, error) {
+	var lm LoginMessage
+	err := c.DoRequest(http.MethodGet, LoginMessageEndpoint, nil, &lm)
+	return &lm, err
+}
+
+// UpdateLoginMessage replaces the instance login message with the given options
+// PUT /1/settings/login-message
+func (c *Client) UpdateLoginMessage(opts LoginMessageOptions) (*LoginMessage, error) {
+	var lm LoginMessage
+	err := c.DoRequest(http.MethodPut, LoginMessageEndpoint, opts, &lm)
+	return &lm, err
+}
+
+// DeleteLoginMessage removes the instance login message
+// DELETE /1/settings/login-message
+func (c *Client) DeleteLoginMessage() error {
+	return c.DoRequest(http.MethodDelete, LoginMessageEndpoint, nil, nil)
+}
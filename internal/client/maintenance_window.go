@@ -0,0 +1,74 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	MaintenanceWindowsEndpoint = "/1/system/maintenance-windows"
+)
+
+// Background jobs held until a maintenance window
+const (
+	MaintenanceJobGc          = "gc"
+	MaintenanceJobReindex     = "reindex"
+	MaintenanceJobReplication = "replication"
+	MaintenanceJobCleanup     = "cleanup"
+)
+
+// MaintenanceWindow defines when the heavy background jobs of the instance
+// are allowed to run. Once a window is defined for a job, the job only runs
+// during the windows allowing it.
+type MaintenanceWindow struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+	// Cron expression (UTC) of the start of the window
+	Schedule        string   `json:"schedule"`
+	DurationMinutes int      `json:"durationMinutes"`
+	Jobs            []string `json:"jobs"`
+	IsEnabled       bool     `json:"isEnabled"`
+}
+
+// MaintenanceWindowOptions defines the payload for creating or replacing a maintenance window
+type MaintenanceWindowOptions struct {
+	Name            string   `json:"name"`
+	Schedule        string   `json:"schedule"`
+	DurationMinutes int      `json:"durationMinutes"`
+	Jobs            []string `json:"jobs"`
+	IsEnabled       bool     `json:"isEnabled"`
+}
+
+// CreateMaintenanceWindow creates a new maintenance window
+// POST /1/system/maintenance-windows
+func (c *Client) CreateMaintenanceWindow(opts MaintenanceWindowOptions) (*MaintenanceWindow, error) {
+	var mw MaintenanceWindow
+	err := c.create(MaintenanceWindowsEndpoint, opts, &mw)
+	return &mw, err
+}
+
+// GetMaintenanceWindow retrieves a maintenance window by its ID
+// GET /1/system/maintenance-windows/:id
+func (c *Client) GetMaintenanceWindow(id string) (*MaintenanceWindow, error) {
+	var mw MaintenanceWindow
+	endpoint := fmt.Sprintf("%s/%s", MaintenanceWindowsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &mw)
+	return &mw, err
+}
+
+// UpdateMaintenanceWindow replaces a maintenance window
+// PUT /1/system/maintenance-windows/:id
+func (c *Client) UpdateMaintenanceWindow(id string, opts MaintenanceWindowOptions) (*MaintenanceWindow, error) {
+	var mw MaintenanceWindow
+	endpoint := fmt.Sprintf("%s/%s", MaintenanceWindowsEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &mw)
+	return &mw, err
+}
+
+// DeleteMaintenanceWindow deletes a maintenance window by its ID
+// DELETE /1/system/maintenance-windows/:id
+func (c *Client) DeleteMaintenanceWindow(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", MaintenanceWindowsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,60 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	MalwareFeedsEndpoint = "/1/security/malware-feeds"
+)
+
+type MalwareFeedSubscription struct {
+	Id           string  `json:"id"`
+	Feed         string  `json:"feed"`
+	Enabled      bool    `json:"enabled"`
+	Action       string  `json:"action"`
+	LastSyncedAt *string `json:"lastSyncedAt"`
+}
+
+// MalwareFeedSubscriptionOptions defines the payload for subscribing to a feed
+type MalwareFeedSubscriptionOptions struct {
+	Feed    string `json:"feed,omitempty"`
+	ApiKey  string `json:"apiKey,omitempty"`
+	Enabled bool   `json:"enabled"`
+	Action  string `json:"action"`
+}
+
+// CreateMalwareFeedSubscription subscribes the instance to a malicious package feed
+// POST /1/security/malware-feeds
+func (c *Client) CreateMalwareFeedSubscription(opts MalwareFeedSubscriptionOptions) (*MalwareFeedSubscription, error) {
+	var sub MalwareFeedSubscription
+	err := c.create(MalwareFeedsEndpoint, opts, &sub)
+	return &sub, err
+}
+
+// GetMalwareFeedSubscription retrieves a feed subscription, the API key is never returned
+// GET /1/security/malware-feeds/:id
+func (c *Client) GetMalwareFeedSubscription(id string) (*MalwareFeedSubscription, error) {
+	var sub MalwareFeedSubscription
+	endpoint := fmt.Sprintf("%s/%s", MalwareFeedsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &sub)
+	return &sub, err
+}
+
+// UpdateMalwareFeedSubscription updates a feed subscription, the API key is kept when omitted
+// PUT /1/security/malware-feeds/:id
+func (c *Client) UpdateMalwareFeedSubscription(id string, opts MalwareFeedSubscriptionOptions) (*MalwareFeedSubscription, error) {
+	var sub MalwareFeedSubscription
+	endpoint := fmt.Sprintf("%s/%s", MalwareFeedsEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &sub)
+	return &sub, err
+}
+
+// DeleteMalwareFeedSubscription unsubscribes the instance from a feed
+// DELETE /1/security/malware-feeds/:id
+func (c *Client) DeleteMalwareFeedSubscription(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", MalwareFeedsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,61 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	MetricsExporterEndpoint = "/1/settings/metrics-exporter"
+)
+
+// Groups of metrics exposed by the Prometheus endpoint
+const (
+	MetricGroupHttp         = "http"
+	MetricGroupStorage      = "storage"
+	MetricGroupRepositories = "repositories"
+	MetricGroupJobs         = "jobs"
+	MetricGroupRuntime      = "runtime"
+)
+
+// MetricsExporter exposes the metrics of the instance on a Prometheus
+// endpoint. The scrape token is never returned by the API.
+type MetricsExporter struct {
+	IsEnabled bool `json:"isEnabled"`
+	// Every group is exposed when empty
+	MetricGroups []string `json:"metricGroups"`
+	// URL scraped by Prometheus
+	EndpointUrl string `json:"endpointUrl"`
+	// Whether the scrapes must send the token as a bearer token
+	IsTokenRequired bool `json:"isTokenRequired"`
+}
+
+// MetricsExporterOptions defines the payload for updating the metrics exporter
+type MetricsExporterOptions struct {
+	IsEnabled    bool     `json:"isEnabled"`
+	MetricGroups []string `json:"metricGroups"`
+	// The current token is kept when unset
+	ScrapeToken *string `json:"scrapeToken,omitempty"`
+}
+
+// GetMetricsExporter retrieves the metrics exporter of the instance
+// GET /1/settings/metrics-exporter
+func (c *Client) GetMetricsExporter() (*MetricsExporter, error) {
+	var me MetricsExporter
+	err := c.DoRequest(http.MethodGet, MetricsExporterEndpoint, nil, &me)
+	return &me, err
+}
+
+// UpdateMetricsExporter replaces the metrics exporter of the instance with the given options
+// PUT /1/settings/metrics-exporter
+func (c *Client) UpdateMetricsExporter(opts MetricsExporterOptions) (*MetricsExporter, error) {
+	var me MetricsExporter
+	err := c.DoRequest(http.MethodPut, MetricsExporterEndpoint, opts, &me)
+	return &me, err
+}
+
+// DeleteMetricsExporter disables the metrics exporter and removes its scrape token
+// DELETE /1/settings/metrics-exporter
+func (c *Client) DeleteMetricsExporter() error {
+	return c.DoRequest(http.MethodDelete, MetricsExporterEndpoint, nil, nil)
+}
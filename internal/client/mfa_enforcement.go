@@ -0,0 +1,58 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	MfaEnforcementEndpoint = "/1/settings/mfa-enforcement"
+)
+
+// Scopes of the MFA enforcement
+const (
+	MfaScopeInstance = "instance"
+	MfaScopeGroups   = "groups"
+)
+
+// MfaEnforcement requires a second factor at login for every user of the
+// instance, or for the members of some groups.
+type MfaEnforcement struct {
+	Scope string `json:"scope"`
+	// Groups whose members need a second factor, with the groups scope
+	Groups []string `json:"groups"`
+	// Users and groups never asked for a second factor, e.g. user:<name> or group:<name>
+	ExemptPrincipals []string `json:"exemptPrincipals"`
+	// Days the users without a second factor can still log in to enroll one
+	GracePeriodDays int `json:"gracePeriodDays"`
+}
+
+// MfaEnforcementOptions defines the payload for updating the MFA enforcement
+type MfaEnforcementOptions struct {
+	Scope            string   `json:"scope"`
+	Groups           []string `json:"groups"`
+	ExemptPrincipals []string `json:"exemptPrincipals"`
+	GracePeriodDays  int      `json:"gracePeriodDays"`
+}
+
+// GetMfaEnforcement retrieves the MFA enforcement of the instance, not found until set
+// GET /1/settings/mfa-enforcement
+func (c *Client) GetMfaEnforcement() (*MfaEnforcement, error) {
+	var me MfaEnforcement
+	err := c.DoRequest(http.MethodGet, MfaEnforcementEndpoint, nil, &me)
+	return &me, err
+}
+
+// UpdateMfaEnforcement replaces the MFA enforcement of the instance with the given options
+// PUT /1/settings/mfa-enforcement
+func (c *Client) UpdateMfaEnforcement(opts MfaEnforcementOptions) (*MfaEnforcement, error) {
+	var me MfaEnforcement
+	err := c.DoRequest(http.MethodPut, MfaEnforcementEndpoint, opts, &me)
+	return &me, err
+}
+
+// DeleteMfaEnforcement removes the MFA enforcement, a second factor is then optional for every user
+// DELETE /1/settings/mfa-enforcement
+func (c *Client) DeleteMfaEnforcement() error {
+	return c.DoRequest(http.MethodDelete, MfaEnforcementEndpoint, nil, nil)
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	OidcConfigEndpoint = "/1/settings/oidc"
+)
+
+type OidcConfig struct {
+	IsEnabled     bool              `json:"isEnabled"`
+	IssuerUrl     string            `json:"issuerUrl"`
+	ClientId      string            `json:"clientId"`
+	Scopes        []string          `json:"scopes"`
+	GroupsClaim   string            `json:"groupsClaim"`
+	GroupMappings map[string]string `json:"groupMappings"`
+	// Callback URL to register on the identity provider
+	RedirectUri string `json:"redirectUri"`
+}
+
+// OidcConfigOptions defines the payload for updating the OIDC single sign-on
+type OidcConfigOptions struct {
+	IsEnabled     bool              `json:"isEnabled"`
+	IssuerUrl     string            `json:"issuerUrl"`
+	ClientId      string            `json:"clientId"`
+	Scopes        []string          `json:"scopes"`
+	GroupsClaim   string            `json:"groupsClaim"`
+	GroupMappings map[string]string `json:"groupMappings"`
+	// The current secret is kept when unset
+	ClientSecret *string `json:"clientSecret,omitempty"`
+}
+
+// GetOidcConfig retrieves the OIDC single sign-on of the instance, not found until set
+// GET /1/settings/oidc
+func (c *Client) GetOidcConfig() (*OidcConfig, error) {
+	var oc OidcConfig
+	err := c.DoRequest(http.MethodGet, OidcConfigEndpoint, nil, &oc)
+	return &oc, err
+}
+
+// UpdateOidcConfig replaces the OIDC single sign-on of the instance with the given options
+// PUT /1/settings/oidc
+func (c *Client) UpdateOidcConfig(opts OidcConfigOptions) (*OidcConfig, error) {
+	var oc OidcConfig
+	err := c.DoRequest(http.MethodPut, OidcConfigEndpoint, opts, &oc)
+	return &oc, err
+}
+
+// DeleteOidcConfig removes the OIDC single sign-on, the users can no longer log in through it
+// DELETE /1/settings/oidc
+func (c *Client) DeleteOidcConfig() error {
+	return c.DoRequest(http.MethodDelete, OidcConfigEndpoint, nil, nil)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	OutboundProxyEndpoint = "/1/settings/outbound-proxy"
+)
+
+// OutboundProxy is the proxy used by the instance to reach the upstreams of
+// the remote repositories.
+type OutboundProxy struct {
+	IsEnabled  bool    `json:"isEnabled"`
+	HttpProxy  *string `json:"httpProxy"`
+	HttpsProxy *string `json:"httpsProxy"`
+	// Hosts, domains (.example.com) or CIDR ranges reached directly
+	NoProxy  []string `json:"noProxy"`
+	Username *string  `json:"username"`
+}
+
+// OutboundProxyOptions defines the payload for updating the outbound proxy
+type OutboundProxyOptions struct {
+	IsEnabled  bool     `json:"isEnabled"`
+	HttpProxy  *string  `json:"httpProxy"`
+	HttpsProxy *string  `json:"httpsProxy"`
+	NoProxy    []string `json:"noProxy"`
+	Username   *string  `json:"username"`
+	// The current password is kept when unset
+	Password *string `json:"password,omitempty"`
+}
+
+// GetOutboundProxy retrieves the outbound proxy of the instance, not found until set
+// GET /1/settings/outbound-proxy
+func (c *Client) GetOutboundProxy() (*OutboundProxy, error) {
+	var op OutboundProxy
+	err := c.DoRequest(http.MethodGet, OutboundProxyEndpoint, nil, &op)
+	return &op, err
+}
+
+// UpdateOutboundProxy replaces the outbound proxy of the instance with the given options
+// PUT /1/settings/outbound-proxy
+func (c *Client) UpdateOutboundProxy(opts OutboundProxyOptions) (*OutboundProxy, error) {
+	var op OutboundProxy
+	err := c.DoRequest(http.MethodPut, OutboundProxyEndpoint, opts, &op)
+	return &op, err
+}
+
+// DeleteOutboundProxy removes the outbound proxy, the upstreams are then reached directly
+// DELETE /1/settings/outbound-proxy
+func (c *Client) DeleteOutboundProxy() error {
+	return c.DoRequest(http.MethodDelete, OutboundProxyEndpoint, nil, nil)
+}
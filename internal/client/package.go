@@ -0,0 +1,30 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+type Package struct {
+	Name          string `json:"name"`
+	LatestVersion string `json:"latestVersion"`
+	DownloadCount int64  `json:"downloadCount"`
+	UpdatedAt     string `json:"updatedAt"`
+}
+
+// Packages is a page of the packages of a repository, ordered by name
+type Packages struct {
+	Total    int       `json:"total"`
+	Offset   int       `json:"offset"`
+	Limit    int       `json:"limit"`
+	Packages []Package `json:"packages"`
+}
+
+// ListPackages retrieves a page of the packages of a repository
+// GET /1/workspaces/:workspace/repositories/:repository/packages?offset=:offset&limit=:limit
+func (c *Client) ListPackages(workspace string, repository string, offset int, limit int) (*Packages, error) {
+	var pkgs Packages
+	endpoint := fmt.Sprintf("%s%s?offset=%d&limit=%d", repositoryEndpoint(workspace, repository), PackagesEndpoint, offset, limit)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &pkgs)
+	return &pkgs, err
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	DeprecationsEndpoint = "/deprecations"
+)
+
+type PackageDeprecation struct {
+	Id          string `json:"id"`
+	PackageName string `json:"packageName"`
+	Version     string `json:"version"`
+	Message     string `json:"message"`
+}
+
+// PackageDeprecationOptions defines the payload for deprecating package versions
+type PackageDeprecationOptions struct {
+	PackageName string `json:"packageName,omitempty"`
+	Version     string `json:"version,omitempty"`
+	Message     string `json:"message"`
+}
+
+func deprecationsEndpoint(workspace string, repository string) string {
+	return repositoryEndpoint(workspace, repository) + DeprecationsEndpoint
+}
+
+// CreatePackageDeprecation deprecates the versions of a package matching a version range
+// POST /1/workspaces/:workspace/repositories/:repository/deprecations
+func (c *Client) CreatePackageDeprecation(workspace string, repository string, opts PackageDeprecationOptions) (*PackageDeprecation, error) {
+	var pd PackageDeprecation
+	err := c.create(deprecationsEndpoint(workspace, repository), opts, &pd)
+	return &pd, err
+}
+
+// GetPackageDeprecation retrieves a package deprecation
+// GET /1/workspaces/:workspace/repositories/:repository/deprecations/:id
+func (c *Client) GetPackageDeprecation(workspace string, repository string, id string) (*PackageDeprecation, error) {
+	var pd PackageDeprecation
+	endpoint := fmt.Sprintf("%s/%s", deprecationsEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &pd)
+	return &pd, err
+}
+
+// UpdatePackageDeprecation updates the message surfaced to the clients
+// PATCH /1/workspaces/:workspace/repositories/:repository/deprecations/:id
+func (c *Client) UpdatePackageDeprecation(workspace string, repository string, id string, opts PackageDeprecationOptions) (*PackageDeprecation, error) {
+	var pd PackageDeprecation
+	endpoint := fmt.Sprintf("%s/%s", deprecationsEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &pd)
+	return &pd, err
+}
+
+// DeletePackageDeprecation removes a package deprecation, the versions are no longer deprecated
+// DELETE /1/workspaces/:workspace/repositories/:repository/deprecations/:id
+func (c *Client) DeletePackageDeprecation(workspace string, repository string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", deprecationsEndpoint(workspace, repository), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Endpoints definitions
+const (
+	PackagesEndpoint = "/packages"
+)
+
+type PackageVersion struct {
+	PackageName string            `json:"packageName"`
+	Version     string            `json:"version"`
+	SizeBytes   int64             `json:"sizeBytes"`
+	Properties  map[string]string `json:"properties"`
+	CreatedAt   string            `json:"createdAt"`
+}
+
+// PackageVersions is a page of the versions of a package, ordered by publication date
+type PackageVersions struct {
+	Total    int              `json:"total"`
+	Offset   int              `json:"offset"`
+	Limit    int              `json:"limit"`
+	Versions []PackageVersion `json:"versions"`
+}
+
+// PackageVersionOptions defines the payload for updating a package version,
+// the properties replace the existing ones
+type PackageVersionOptions struct {
+	Properties map[string]string `json:"properties"`
+}
+
+// The package names can hold a slash, like the npm scoped packages
+func packageVersionEndpoint(workspace string, repository string, packageName string, version string) string {
+	return fmt.Sprintf("%s%s/%s/versions/%s",
+		repositoryEndpoint(workspace, repository), PackagesEndpoint, url.PathEscape(packageName), url.PathEscape(version),
+	)
+}
+
+// ListPackageVersions retrieves a page of the versions of a package
+// GET /1/workspaces/:workspace/repositories/:repository/packages/:package/versions?offset=:offset&limit=:limit
+func (c *Client) ListPackageVersions(workspace string, repository string, packageName string, offset int, limit int) (*PackageVersions, error) {
+	var pvs PackageVersions
+	endpoint := fmt.Sprintf("%s%s/%s/versions?offset=%d&limit=%d",
+		repositoryEndpoint(workspace, repository), PackagesEndpoint, url.PathEscape(packageName), offset, limit,
+	)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &pvs)
+	return &pvs, err
+}
+
+// GetPackageVersion retrieves a version of a package
+// GET /1/workspaces/:workspace/repositories/:repository/packages/:package/versions/:version
+func (c *Client) GetPackageVersion(workspace string, repository string, packageName string, version string) (*PackageVersion, error) {
+	var pv PackageVersion
+	err := c.DoRequest(http.MethodGet, packageVersionEndpoint(workspace, repository, packageName, version), nil, &pv)
+	return &pv, err
+}
+
+// UpdatePackageVersion replaces the properties of a package version
+// PATCH /1/workspaces/:workspace/repositories/:repository/packages/:package/versions/:version
+func (c *Client) UpdatePackageVersion(workspace string, repository string, packageName string, version string, opts PackageVersionOptions) (*PackageVersion, error) {
+	var pv PackageVersion
+	err := c.DoRequest(http.MethodPatch, packageVersionEndpoint(workspace, repository, packageName, version), opts, &pv)
+	return &pv, err
+}
+
+// DeletePackageVersion deletes a version of a package and its files
+// DELETE /1/workspaces/:workspace/repositories/:repository/packages/:package/versions/:version
+func (c *Client) DeletePackageVersion(workspace string, repository string, packageName string, version string) error {
+	return c.DoRequest(http.MethodDelete, packageVersionEndpoint(workspace, repository, packageName, version), nil, nil)
+}
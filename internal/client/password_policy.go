@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	PasswordPolicyEndpoint = "/1/settings/password-policy"
+)
+
+// PasswordPolicy defines the passwords accepted for the local users of the
+// instance, the users of the LDAP and OIDC directories are not concerned.
+type PasswordPolicy struct {
+	MinLength        int  `json:"minLength"`
+	RequireUppercase bool `json:"requireUppercase"`
+	RequireLowercase bool `json:"requireLowercase"`
+	RequireDigit     bool `json:"requireDigit"`
+	RequireSymbol    bool `json:"requireSymbol"`
+	// Days before a password must be changed, unset when it never expires
+	MaxAgeDays *int `json:"maxAgeDays"`
+	// Failed logins in a row locking the account, unset when never locked
+	LockoutThreshold       *int `json:"lockoutThreshold"`
+	LockoutDurationMinutes int  `json:"lockoutDurationMinutes"`
+}
+
+// PasswordPolicyOptions defines the payload for updating the password policy
+type PasswordPolicyOptions struct {
+	MinLength              int  `json:"minLength"`
+	RequireUppercase       bool `json:"requireUppercase"`
+	RequireLowercase       bool `json:"requireLowercase"`
+	RequireDigit           bool `json:"requireDigit"`
+	RequireSymbol          bool `json:"requireSymbol"`
+	MaxAgeDays             *int `json:"maxAgeDays"`
+	LockoutThreshold       *int `json:"lockoutThreshold"`
+	LockoutDurationMinutes int  `json:"lockoutDurationMinutes"`
+}
+
+// GetPasswordPolicy retrieves the password policy of the instance
+// GET /1/settings/password-policy
+func (c *Client) GetPasswordPolicy() (*PasswordPolicy, error) {
+	var pp PasswordPolicy
+	err := c.DoRequest(http.MethodGet, PasswordPolicyEndpoint, nil, &pp)
+	return &pp, err
+}
+
+// UpdatePasswordPolicy replaces the password policy of the instance with the given options
+// PUT /1/settings/password-policy
+func (c *Client) UpdatePasswordPolicy(opts PasswordPolicyOptions) (*PasswordPolicy, error) {
+	var pp PasswordPolicy
+	err := c.DoRequest(http.MethodPut, PasswordPolicyEndpoint, opts, &pp)
+	return &pp, err
+}
+
+// DeletePasswordPolicy resets the password policy of the instance to its defaults
+// DELETE /1/settings/password-policy
+func (c *Client) DeletePasswordPolicy() error {
+	return c.DoRequest(http.MethodDelete, PasswordPolicyEndpoint, nil, nil)
+}
@@ -0,0 +1,148 @@
+package client
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// permissionBatchWindow is how long a grant waits for the grants of the same
+// repository before they are sent together.
+const permissionBatchWindow = 50 * time.Millisecond
+
+// permissionBatcher coalesces the grants of a repository sent concurrently,
+// like the permission resources of a large access review applied at once, into
+// a single bulk request. Terraform configures a provider once per run, so a
+// batch never mixes the grants of two applies.
+type permissionBatcher struct {
+	API
+
+	mu      sync.Mutex
+	pending map[string]*permissionBatch
+
+	// Set once the instance answered that it does not serve the bulk endpoint,
+	// the grants are then sent one by one.
+	unsupported atomic.Bool
+}
+
+// permissionBatch holds the grants of a repository waiting to be sent.
+type permissionBatch struct {
+	workspace  string
+	repository string
+	grants     map[string]string
+	done       chan struct{}
+	results    map[string]permissionResult
+}
+
+type permissionResult struct {
+	perm *RepositoryPermission
+	err  error
+}
+
+// WithPermissionBatching returns an API sending the concurrent grants of a
+// repository through the bulk endpoint, every other call goes to api as is.
+func WithPermissionBatching(api API) API {
+	return &permissionBatcher{
+		API:     api,
+		pending: map[string]*permissionBatch{},
+	}
+}
+
+func (b *permissionBatcher) PutRepositoryPermission(workspace string, repository string, principal string, opts RepositoryPermissionOptions) (*RepositoryPermission, error) {
+	if b.unsupported.Load() {
+		return b.API.PutRepositoryPermission(workspace, repository, principal, opts)
+	}
+
+	key := workspace + "/" + repository
+
+	b.mu.Lock()
+	batch, ok := b.pending[key]
+	if !ok {
+		batch = &permissionBatch{
+			workspace:  workspace,
+			repository: repository,
+			grants:     map[string]string{},
+			done:       make(chan struct{}),
+		}
+		b.pending[key] = batch
+		time.AfterFunc(permissionBatchWindow, func() { b.flush(key, batch) })
+	}
+	if _, ok := batch.grants[principal]; ok {
+		b.mu.Unlock()
+		return nil, fmt.Errorf("conflicting grants of %s on repository %s in the same apply", principal, repository)
+	}
+	batch.grants[principal] = opts.Role
+	b.mu.Unlock()
+
+	<-batch.done
+
+	result := batch.results[principal]
+	return result.perm, result.err
+}
+
+// flush sends the grants of a batch, falling back to one request per grant
+// when the bulk endpoint is not available.
+func (b *permissionBatcher) flush(key string, batch *permissionBatch) {
+	b.mu.Lock()
+	delete(b.pending, key)
+	b.mu.Unlock()
+
+	defer close(batch.done)
+
+	if len(batch.grants) > 1 && !b.unsupported.Load() {
+		opts := RepositoryPermissionsOptions{}
+		for principal, role := range batch.grants {
+			opts.Grants = append(opts.Grants, RepositoryPermission{Principal: principal, Role: role})
+		}
+
+		perms, err := b.API.PutRepositoryPermissions(batch.workspace, batch.repository, opts)
+		if err == nil {
+			batch.results = bulkPermissionResults(batch.grants, *perms)
+			return
+		}
+
+		// Any other error, like a missing repository, fails every grant of the batch
+		if !bulkUnsupported(err) {
+			batch.results = map[string]permissionResult{}
+			for principal := range batch.grants {
+				batch.results[principal] = permissionResult{err: err}
+			}
+			return
+		}
+		b.unsupported.Store(true)
+	}
+
+	batch.results = map[string]permissionResult{}
+	for principal, role := range batch.grants {
+		perm, err := b.API.PutRepositoryPermission(batch.workspace, batch.repository, principal, RepositoryPermissionOptions{Role: role})
+		batch.results[principal] = permissionResult{perm: perm, err: err}
+	}
+}
+
+// bulkUnsupported reports whether the instance does not serve the bulk endpoint,
+// either a route it does not know or a RepoFlow error saying so.
+func bulkUnsupported(err error) bool {
+	if IsNotFound(err) {
+		return false
+	}
+	return IsUnsupported(err) || strings.Contains(strings.ToLower(err.Error()), "unsupported")
+}
+
+// bulkPermissionResults matches the permissions answered by the bulk endpoint
+// with the grants of the batch.
+func bulkPermissionResults(grants map[string]string, perms []RepositoryPermission) map[string]permissionResult {
+	results := map[string]permissionResult{}
+	for _, perm := range perms {
+		results[perm.Principal] = permissionResult{perm: &perm}
+	}
+
+	for principal := range grants {
+		if _, ok := results[principal]; !ok {
+			results[principal] = permissionResult{err: fmt.Errorf("permission of %s missing from the bulk response", principal)}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,188 @@
+package client_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/client/fake"
+)
+
+// bulkRecorder counts the permission requests reaching the fake, answering the
+// bulk ones with bulkErr when set and dropping the grant of dropped from them.
+type bulkRecorder struct {
+	*fake.Client
+
+	mu      sync.Mutex
+	bulk    int
+	single  int
+	bulkErr error
+	dropped string
+}
+
+func (c *bulkRecorder) PutRepositoryPermission(workspace string, repository string, principal string, opts client.RepositoryPermissionOptions) (*client.RepositoryPermission, error) {
+	c.mu.Lock()
+	c.single++
+	c.mu.Unlock()
+
+	return c.Client.PutRepositoryPermission(workspace, repository, principal, opts)
+}
+
+func (c *bulkRecorder) PutRepositoryPermissions(workspace string, repository string, opts client.RepositoryPermissionsOptions) (*[]client.RepositoryPermission, error) {
+	c.mu.Lock()
+	c.bulk++
+	c.mu.Unlock()
+
+	if c.bulkErr != nil {
+		return nil, c.bulkErr
+	}
+
+	perms, err := c.Client.PutRepositoryPermissions(workspace, repository, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	kept := []client.RepositoryPermission{}
+	for _, perm := range *perms {
+		if perm.Principal != c.dropped {
+			kept = append(kept, perm)
+		}
+	}
+	return &kept, nil
+}
+
+// newBatchTest returns the recorder and a batching API over it, holding the
+// platform workspace and its npm-local repository.
+func newBatchTest(t *testing.T) (*bulkRecorder, client.API) {
+	t.Helper()
+
+	recorder := &bulkRecorder{Client: fake.New()}
+	ws, err := recorder.CreateWorkspace(client.WorkspaceOptions{WorkspaceOptions: repoflow.WorkspaceOptions{Name: "platform"}})
+	if err != nil {
+		t.Fatalf("CreateWorkspace: %s", err)
+	}
+	if _, err := recorder.CreateLocalRepository(ws.Id, client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+	}); err != nil {
+		t.Fatalf("CreateLocalRepository: %s", err)
+	}
+
+	return recorder, client.WithPermissionBatching(recorder)
+}
+
+// grantAll sends the grants concurrently, like the permission resources of an
+// apply, returning the error of each principal.
+func grantAll(api client.API, repository string, principals ...string) []error {
+	errs := make([]error, len(principals))
+
+	var wg sync.WaitGroup
+	for i, principal := range principals {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			perm, err := api.PutRepositoryPermission("platform", repository, principal, client.RepositoryPermissionOptions{Role: "read"})
+			if err == nil && perm.Principal != principal {
+				err = fmt.Errorf("got the permission of %s", perm.Principal)
+			}
+			errs[i] = err
+		}()
+	}
+	wg.Wait()
+
+	return errs
+}
+
+func TestPermissionBatching(t *testing.T) {
+	recorder, api := newBatchTest(t)
+
+	for i, err := range grantAll(api, "npm-local", "user:alice", "user:bob", "group:dev") {
+		if err != nil {
+			t.Errorf("grant %d: %s", i, err)
+		}
+	}
+	if recorder.bulk != 1 || recorder.single != 0 {
+		t.Errorf("got %d bulk and %d single requests, want one bulk request", recorder.bulk, recorder.single)
+	}
+
+	for _, principal := range []string{"user:alice", "user:bob", "group:dev"} {
+		if perm, err := recorder.GetRepositoryPermission("platform", "npm-local", principal); err != nil || perm.Role != "read" {
+			t.Errorf("permission of %s = %v, %v", principal, perm, err)
+		}
+	}
+}
+
+func TestPermissionBatchingFallback(t *testing.T) {
+	recorder, api := newBatchTest(t)
+	recorder.bulkErr = fmt.Errorf("PATCH permissions answered 405: %w", client.ErrUnsupported)
+
+	for i, err := range grantAll(api, "npm-local", "user:alice", "user:bob") {
+		if err != nil {
+			t.Errorf("grant %d: %s", i, err)
+		}
+	}
+	if recorder.bulk != 1 || recorder.single != 2 {
+		t.Errorf("got %d bulk and %d single requests, want one bulk request then 2 single ones", recorder.bulk, recorder.single)
+	}
+
+	// The bulk endpoint is not tried again
+	for i, err := range grantAll(api, "npm-local", "user:carol", "user:dave") {
+		if err != nil {
+			t.Errorf("grant %d: %s", i, err)
+		}
+	}
+	if recorder.bulk != 1 || recorder.single != 4 {
+		t.Errorf("got %d bulk and %d single requests, want one bulk request then 4 single ones", recorder.bulk, recorder.single)
+	}
+}
+
+func TestPermissionBatchingMissingRepository(t *testing.T) {
+	recorder, api := newBatchTest(t)
+
+	for i, err := range grantAll(api, "missing", "user:alice", "user:bob") {
+		if !client.IsNotFound(err) {
+			t.Errorf("grant %d: error = %v, want a not found error", i, err)
+		}
+	}
+
+	// A missing repository is not an unsupported bulk endpoint
+	if recorder.bulk != 1 || recorder.single != 0 {
+		t.Errorf("got %d bulk and %d single requests, want one bulk request", recorder.bulk, recorder.single)
+	}
+}
+
+func TestPermissionBatchingMissingResult(t *testing.T) {
+	recorder, api := newBatchTest(t)
+	recorder.dropped = "user:bob"
+
+	errs := grantAll(api, "npm-local", "user:alice", "user:bob")
+	if errs[0] != nil {
+		t.Errorf("grant of user:alice: %s", errs[0])
+	}
+	if errs[1] == nil || !strings.Contains(errs[1].Error(), "user:bob missing from the bulk response") {
+		t.Errorf("grant of user:bob: error = %v, want the missing result", errs[1])
+	}
+}
+
+func TestPermissionBatchingConflict(t *testing.T) {
+	recorder, api := newBatchTest(t)
+
+	var conflicts int
+	for _, err := range grantAll(api, "npm-local", "user:alice", "user:alice") {
+		if err != nil {
+			if !strings.Contains(err.Error(), "conflicting grants of user:alice") {
+				t.Errorf("error = %s, want the conflict", err)
+			}
+			conflicts++
+		}
+	}
+	if conflicts != 1 {
+		t.Errorf("got %d conflicts, want 1", conflicts)
+	}
+	if recorder.bulk+recorder.single != 1 {
+		t.Errorf("got %d bulk and %d single requests, want the first grant only", recorder.bulk, recorder.single)
+	}
+}
@@ -0,0 +1,78 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	PromotionPipelinesEndpoint = "/promotion-pipelines"
+)
+
+// PromotionPipeline defines the path of the artifacts between repositories of a
+// workspace, e.g. dev, staging then release. An artifact is promoted from a
+// stage to the next one once it meets the requirements of the next stage.
+type PromotionPipeline struct {
+	Id     string           `json:"id"`
+	Name   string           `json:"name"`
+	Stages []PromotionStage `json:"stages"`
+}
+
+// PromotionStage is a repository of a promotion pipeline with the requirements
+// for an artifact to enter it.
+type PromotionStage struct {
+	Name         string `json:"name"`
+	RepositoryId string `json:"repositoryId"`
+	// Properties the artifact must have, with their value
+	RequiredProperties map[string]string `json:"requiredProperties"`
+	RequiredApprovals  int               `json:"requiredApprovals"`
+	// Principals (user:<name> or group:<name>) allowed to approve, anyone with
+	// write access to the repository when empty
+	Approvers []string `json:"approvers"`
+}
+
+// PromotionPipelineOptions defines the payload for creating or replacing a promotion pipeline
+type PromotionPipelineOptions struct {
+	Name   string           `json:"name"`
+	Stages []PromotionStage `json:"stages"`
+}
+
+func promotionPipelinesEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, PromotionPipelinesEndpoint)
+}
+
+// CreatePromotionPipeline creates a new promotion pipeline in a workspace
+// POST /1/workspaces/:workspace/promotion-pipelines
+func (c *Client) CreatePromotionPipeline(workspace string, opts PromotionPipelineOptions) (*PromotionPipeline, error) {
+	var pp PromotionPipeline
+	err := c.create(promotionPipelinesEndpoint(workspace), opts, &pp)
+	return &pp, err
+}
+
+// GetPromotionPipeline retrieves a promotion pipeline
+// GET /1/workspaces/:workspace/promotion-pipelines/:id
+func (c *Client) GetPromotionPipeline(workspace string, id string) (*PromotionPipeline, error) {
+	var pp PromotionPipeline
+	endpoint := fmt.Sprintf("%s/%s", promotionPipelinesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &pp)
+	return &pp, err
+}
+
+// UpdatePromotionPipeline replaces the stages of a promotion pipeline
+// PUT /1/workspaces/:workspace/promotion-pipelines/:id
+func (c *Client) UpdatePromotionPipeline(workspace string, id string, opts PromotionPipelineOptions) (*PromotionPipeline, error) {
+	var pp PromotionPipeline
+	endpoint := fmt.Sprintf("%s/%s", promotionPipelinesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &pp)
+	return &pp, err
+}
+
+// DeletePromotionPipeline deletes a promotion pipeline, the artifacts already promoted are kept
+// DELETE /1/workspaces/:workspace/promotion-pipelines/:id
+func (c *Client) DeletePromotionPipeline(workspace string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", promotionPipelinesEndpoint(workspace), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,71 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	PushMirrorsEndpoint = "/push-mirrors"
+)
+
+// PushMirror pushes the content of a local repository to an external registry on a schedule.
+// The password authenticating to the registry is never returned by the API.
+type PushMirror struct {
+	Id           string  `json:"id"`
+	Url          string  `json:"url"`
+	CredentialId *string `json:"credentialId"`
+	Username     *string `json:"username"`
+	Schedule     string  `json:"schedule"`
+	Enabled      bool    `json:"enabled"`
+	LastSyncedAt *string `json:"lastSyncedAt"`
+}
+
+// PushMirrorOptions defines the payload for creating or replacing a push mirror
+type PushMirrorOptions struct {
+	Url          string  `json:"url"`
+	CredentialId *string `json:"credentialId"`
+	Username     *string `json:"username"`
+	Schedule     string  `json:"schedule"`
+	Enabled      bool    `json:"enabled"`
+	// The current password is kept when unset
+	Password *string `json:"password,omitempty"`
+}
+
+func pushMirrorsEndpoint(workspace string, repository string) string {
+	return fmt.Sprintf("%s%s", repositoryEndpoint(workspace, repository), PushMirrorsEndpoint)
+}
+
+// CreatePushMirror creates a new push mirror on a local repository
+// POST /1/workspaces/:workspace/repositories/:repository/push-mirrors
+func (c *Client) CreatePushMirror(workspace string, repository string, opts PushMirrorOptions) (*PushMirror, error) {
+	var pm PushMirror
+	err := c.create(pushMirrorsEndpoint(workspace, repository), opts, &pm)
+	return &pm, err
+}
+
+// GetPushMirror retrieves a push mirror of a repository
+// GET /1/workspaces/:workspace/repositories/:repository/push-mirrors/:id
+func (c *Client) GetPushMirror(workspace string, repository string, id string) (*PushMirror, error) {
+	var pm PushMirror
+	endpoint := fmt.Sprintf("%s/%s", pushMirrorsEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &pm)
+	return &pm, err
+}
+
+// UpdatePushMirror replaces the settings of a push mirror of a repository
+// PUT /1/workspaces/:workspace/repositories/:repository/push-mirrors/:id
+func (c *Client) UpdatePushMirror(workspace string, repository string, id string, opts PushMirrorOptions) (*PushMirror, error) {
+	var pm PushMirror
+	endpoint := fmt.Sprintf("%s/%s", pushMirrorsEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &pm)
+	return &pm, err
+}
+
+// DeletePushMirror deletes a push mirror of a repository
+// DELETE /1/workspaces/:workspace/repositories/:repository/push-mirrors/:id
+func (c *Client) DeletePushMirror(workspace string, repository string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", pushMirrorsEndpoint(workspace, repository), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	QuarantineRulesEndpoint = "/quarantine-rules"
+)
+
+// QuarantineRule holds the upstream packages newly cached by remote repositories
+// of a workspace for a while before they can be downloaded.
+type QuarantineRule struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	RepositoryIds []string `json:"repositoryIds"`
+	// Every package when empty
+	PackagePatterns []string `json:"packagePatterns"`
+	// Counted from the upstream publication of the version
+	QuarantineHours int  `json:"quarantineHours"`
+	IsEnabled       bool `json:"isEnabled"`
+}
+
+// QuarantineRuleOptions defines the payload for creating or replacing a quarantine rule
+type QuarantineRuleOptions struct {
+	Name            string   `json:"name"`
+	RepositoryIds   []string `json:"repositoryIds"`
+	PackagePatterns []string `json:"packagePatterns"`
+	QuarantineHours int      `json:"quarantineHours"`
+	IsEnabled       bool     `json:"isEnabled"`
+}
+
+func quarantineRulesEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, QuarantineRulesEndpoint)
+}
+
+// CreateQuarantineRule creates a new quarantine rule in a workspace
+// POST /1/workspaces/:workspace/quarantine-rules
+func (c *Client) CreateQuarantineRule(workspace string, opts QuarantineRuleOptions) (*QuarantineRule, error) {
+	var qr QuarantineRule
+	err := c.create(quarantineRulesEndpoint(workspace), opts, &qr)
+	return &qr, err
+}
+
+// GetQuarantineRule retrieves a quarantine rule
+// GET /1/workspaces/:workspace/quarantine-rules/:id
+func (c *Client) GetQuarantineRule(workspace string, id string) (*QuarantineRule, error) {
+	var qr QuarantineRule
+	endpoint := fmt.Sprintf("%s/%s", quarantineRulesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &qr)
+	return &qr, err
+}
+
+// UpdateQuarantineRule replaces the settings of a quarantine rule
+// PUT /1/workspaces/:workspace/quarantine-rules/:id
+func (c *Client) UpdateQuarantineRule(workspace string, id string, opts QuarantineRuleOptions) (*QuarantineRule, error) {
+	var qr QuarantineRule
+	endpoint := fmt.Sprintf("%s/%s", quarantineRulesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &qr)
+	return &qr, err
+}
+
+// DeleteQuarantineRule deletes a quarantine rule
+// DELETE /1/workspaces/:workspace/quarantine-rules/:id
+func (c *Client) DeleteQuarantineRule(workspace string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", quarantineRulesEndpoint(workspace), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
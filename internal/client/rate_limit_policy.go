@@ -0,0 +1,70 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	RateLimitPoliciesEndpoint = "/1/system/rate-limit-policies"
+)
+
+// RateLimitPolicy limits the requests and the bandwidth of each token, user or
+// repository it applies to.
+type RateLimitPolicy struct {
+	Id    string `json:"id"`
+	Name  string `json:"name"`
+	Scope string `json:"scope"`
+	// Every token, user or repository when empty
+	Subjects          []string `json:"subjects"`
+	RequestsPerMinute *int     `json:"requestsPerMinute"`
+	// Requests allowed above the rate for a short time
+	Burst                   *int   `json:"burst"`
+	BandwidthBytesPerSecond *int64 `json:"bandwidthBytesPerSecond"`
+	IsEnabled               bool   `json:"isEnabled"`
+}
+
+// RateLimitPolicyOptions defines the payload for creating or replacing a rate limit policy
+type RateLimitPolicyOptions struct {
+	Name                    string   `json:"name"`
+	Scope                   string   `json:"scope"`
+	Subjects                []string `json:"subjects"`
+	RequestsPerMinute       *int     `json:"requestsPerMinute"`
+	Burst                   *int     `json:"burst"`
+	BandwidthBytesPerSecond *int64   `json:"bandwidthBytesPerSecond"`
+	IsEnabled               bool     `json:"isEnabled"`
+}
+
+// CreateRateLimitPolicy creates a new rate limit policy
+// POST /1/system/rate-limit-policies
+func (c *Client) CreateRateLimitPolicy(opts RateLimitPolicyOptions) (*RateLimitPolicy, error) {
+	var rl RateLimitPolicy
+	err := c.create(RateLimitPoliciesEndpoint, opts, &rl)
+	return &rl, err
+}
+
+// GetRateLimitPolicy retrieves a rate limit policy by its ID
+// GET /1/system/rate-limit-policies/:id
+func (c *Client) GetRateLimitPolicy(id string) (*RateLimitPolicy, error) {
+	var rl RateLimitPolicy
+	endpoint := fmt.Sprintf("%s/%s", RateLimitPoliciesEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &rl)
+	return &rl, err
+}
+
+// UpdateRateLimitPolicy replaces a rate limit policy
+// PUT /1/system/rate-limit-policies/:id
+func (c *Client) UpdateRateLimitPolicy(id string, opts RateLimitPolicyOptions) (*RateLimitPolicy, error) {
+	var rl RateLimitPolicy
+	endpoint := fmt.Sprintf("%s/%s", RateLimitPoliciesEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &rl)
+	return &rl, err
+}
+
+// DeleteRateLimitPolicy removes a rate limit policy by its ID
+// DELETE /1/system/rate-limit-policies/:id
+func (c *Client) DeleteRateLimitPolicy(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", RateLimitPoliciesEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
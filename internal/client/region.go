@@ -0,0 +1,145 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// SetRegion restricts the client to the workspaces of a RepoFlow cloud region, the
+// reads of the workspaces of another one failing.
+func (c *Client) SetRegion(region string) {
+	c.region.region = region
+}
+
+// regionTransport fails the reads of the workspaces outside of its region, and of
+// everything below them. Being a transport, it checks the calls of the embedded
+// upstream client too. The region of each workspace is only fetched once.
+type regionTransport struct {
+	base   http.RoundTripper
+	region string
+
+	// Region of the workspaces already checked, by id and name
+	mu      sync.Mutex
+	regions map[string]string
+}
+
+func (t *regionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.region == "" || req.Method != http.MethodGet {
+		return t.base.RoundTrip(req)
+	}
+
+	prefix, workspace, scoped, ok := workspacePath(req.URL.Path)
+	if !ok {
+		return t.base.RoundTrip(req)
+	}
+
+	// The workspace read itself tells the region
+	if !scoped {
+		resp, err := t.base.RoundTrip(req)
+		if err != nil || resp.StatusCode != http.StatusOK {
+			return resp, err
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read workspace %s: %w", workspace, err)
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		// A body the client cannot decode is reported by the client
+		var ws Workspace
+		if err := json.Unmarshal(body, &ws); err != nil {
+			return resp, nil
+		}
+		if err := t.check(workspace, t.remember(workspace, &ws)); err != nil {
+			return nil, err
+		}
+		return resp, nil
+	}
+
+	t.mu.Lock()
+	region, ok := t.regions[workspace]
+	t.mu.Unlock()
+
+	if !ok {
+		lookup := req.Clone(req.Context())
+		lookup.URL.Path = prefix + repoflow.WorkspacesEndpoint + "/" + workspace
+		lookup.URL.RawPath = ""
+
+		resp, err := t.base.RoundTrip(lookup)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to read the region of workspace %s: status %d", workspace, resp.StatusCode)
+		}
+
+		var ws Workspace
+		if err := json.NewDecoder(resp.Body).Decode(&ws); err != nil {
+			return nil, fmt.Errorf("failed to decode workspace %s: %w", workspace, err)
+		}
+		region = t.remember(workspace, &ws)
+	}
+
+	if err := t.check(workspace, region); err != nil {
+		return nil, err
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+// check fails when the region of a workspace is not the one of the transport.
+func (t *regionTransport) check(workspace string, region string) error {
+	if region != "" && region != t.region {
+		return fmt.Errorf("workspace %s is in region %s, the provider is configured for region %s", workspace, region, t.region)
+	}
+
+	return nil
+}
+
+// remember records the region of a workspace under the key it was asked with,
+// its id and its name, and returns it.
+func (t *regionTransport) remember(key string, ws *Workspace) string {
+	var region string
+	if ws.Region != nil {
+		region = *ws.Region
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, k := range []string{key, ws.Id, ws.Name} {
+		if k != "" {
+			t.regions[k] = region
+		}
+	}
+
+	return region
+}
+
+// workspacePath splits a path below /1/workspaces/:workspace into the base URL
+// path before it and the workspace, scoped being set for the paths below the
+// workspace endpoint itself.
+func workspacePath(path string) (prefix string, workspace string, scoped bool, ok bool) {
+	prefix, rest, ok := strings.Cut(path, repoflow.WorkspacesEndpoint+"/")
+	if !ok {
+		return "", "", false, false
+	}
+
+	workspace, _, scoped = strings.Cut(rest, "/")
+	if workspace == "" {
+		return "", "", false, false
+	}
+
+	return prefix, workspace, scoped, true
+}
@@ -0,0 +1,85 @@
+package client
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRegion(t *testing.T) {
+	var workspaceReads atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/1/workspaces/local":
+			workspaceReads.Add(1)
+			_, _ = w.Write([]byte(`{"id": "ws-local", "name": "local", "region": "eu-west-1"}`))
+		case "/api/1/workspaces/remote":
+			workspaceReads.Add(1)
+			_, _ = w.Write([]byte(`{"id": "ws-remote", "name": "remote", "region": "us-east-1"}`))
+		case "/api/1/workspaces/local/repositories", "/api/1/workspaces/remote/repositories":
+			_, _ = w.Write([]byte(`[]`))
+		default:
+			_, _ = w.Write([]byte(`{"id": "rp", "name": "npm-local"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient([]string{srv.URL + "/api"}, "token")
+	c.SetRegion("eu-west-1")
+
+	for name, read := range map[string]func(workspace string) error{
+		"GetWorkspace": func(workspace string) error {
+			_, err := c.GetWorkspace(workspace)
+			return err
+		},
+		"GetRepository": func(workspace string) error {
+			_, err := c.GetRepository(workspace, "npm-local")
+			return err
+		},
+		"ListRepositories": func(workspace string) error {
+			_, err := c.ListRepositories(workspace)
+			return err
+		},
+		// The calls of the upstream client are checked too
+		"ListRepositoryPackages": func(workspace string) error {
+			_, err := c.ListRepositoryPackages(workspace, "npm-local")
+			return err
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if err := read("local"); err != nil {
+				t.Errorf("workspace of the region: %s", err)
+			}
+			err := read("remote")
+			if err == nil || !strings.Contains(err.Error(), "is in region us-east-1") {
+				t.Errorf("workspace of another region: got error %v", err)
+			}
+		})
+	}
+
+	// The region of a workspace is only fetched by the first read, GetWorkspace
+	// fetching the workspace anyway
+	if n := workspaceReads.Load(); n > 4 {
+		t.Errorf("the workspaces were fetched %d times, want at most 4", n)
+	}
+}
+
+func TestRegionWrites(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			t.Errorf("unexpected read %s", r.URL.Path)
+		}
+		_, _ = w.Write([]byte(`{"id": "rp", "name": "npm-local"}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient([]string{srv.URL}, "token")
+	c.SetRegion("eu-west-1")
+
+	// The writes follow a read of the workspace, they are not checked again
+	if _, err := c.CreateRepository("remote", "npm-local", RepositoryOptions{}); err != nil {
+		t.Fatal(err)
+	}
+}
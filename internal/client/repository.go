@@ -0,0 +1,225 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Repository extends the upstream repository with the settings managed by the provider
+type Repository struct {
+	repoflow.Repository
+	DeploymentPolicy *string `json:"deploymentPolicy"`
+	// Tool managing the repository
+	ManagedBy *string `json:"managedBy"`
+	// Labels checked against the label taxonomy of the instance
+	Labels map[string]string `json:"labels,omitempty"`
+	// Date of the last change of the settings
+	UpdatedAt *string `json:"updatedAt"`
+	// Workspace credential authenticating a remote repository
+	CredentialId *string `json:"credentialId"`
+	// Service discovery of the terraform-module and terraform-provider repositories
+	TerraformRegistry *TerraformRegistry `json:"terraformRegistry"`
+	// Storage backend of the packages, the one of the workspace when not set at
+	// creation. Unset on the virtual repositories.
+	StorageBackendId *string `json:"storageBackendId"`
+	PackageSettings
+}
+
+// TerraformRegistry describes how the Terraform CLI reaches a registry repository
+type TerraformRegistry struct {
+	// Hostname of the registry in the module and provider sources
+	Hostname            string `json:"hostname"`
+	ServiceDiscoveryUrl string `json:"serviceDiscoveryUrl"`
+	// Base URL of the modules.v1 or providers.v1 API of the repository
+	ApiUrl string `json:"apiUrl"`
+}
+
+// PackageSettings holds the settings specific to a package type, only the
+// ones of the package type of the repository are set
+type PackageSettings struct {
+	ConanSettings *ConanSettings `json:"conanSettings,omitempty"`
+	OciSettings   *OciSettings   `json:"ociSettings,omitempty"`
+}
+
+// ConanSettings defines the settings of the conan repositories
+type ConanSettings struct {
+	RevisionsEnabled bool `json:"revisionsEnabled"`
+}
+
+// OciSettings defines the settings of the oci repositories, storing any OCI artifact
+type OciSettings struct {
+	// Every media type is accepted when empty
+	AllowedMediaTypes []string `json:"allowedMediaTypes"`
+}
+
+func repositoryEndpoint(workspace string, id string) string {
+	return fmt.Sprintf("%s/%s%s/%s", repoflow.WorkspacesEndpoint, workspace, repoflow.RepositoryEndpoint, id)
+}
+
+// scopedEndpoint returns the endpoint of a setting of a repository, or of the
+// workspace when the repository is empty.
+func scopedEndpoint(workspace string, repository string, endpoint string) string {
+	if repository == "" {
+		return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, endpoint)
+	}
+	return repositoryEndpoint(workspace, repository) + endpoint
+}
+
+// GetRepository retrieves metadata for a specific repository
+// GET /1/workspaces/:workspace/repositories/:id
+func (c *Client) GetRepository(workspace string, id string) (*Repository, error) {
+	var rep Repository
+	err := c.DoRequest(http.MethodGet, repositoryEndpoint(workspace, id), nil, &rep)
+	return &rep, err
+}
+
+// CreateRepository create a new repository with the given options
+// POST /1/workspaces/:workspace/repositories/:store
+func (c *Client) CreateRepository(workspace string, store string, opts any) (*Repository, error) {
+	var rep Repository
+	err := c.create(repositoryEndpoint(workspace, store), opts, &rep)
+	return &rep, err
+}
+
+// RepositoryOptions extends the payload for creating a local repository with the managed-by marker
+type RepositoryOptions struct {
+	repoflow.RepositoryOptions
+	StorageBackendId string            `json:"storageBackendId,omitempty"`
+	ManagedBy        string            `json:"managedBy,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	PackageSettings
+}
+
+// CreateLocalRepository create a new repository with the given options
+// POST /1/workspaces/:workspace/repositories/local
+func (c *Client) CreateLocalRepository(workspace string, opts RepositoryOptions) (*Repository, error) {
+	return c.CreateRepository(workspace, "local", opts)
+}
+
+// RepositoryRemoteOptions extends the payload for creating a remote repository with the header authentication
+type RepositoryRemoteOptions struct {
+	repoflow.RepositoryRemoteOptions
+	RemoteRepositoryHeaderName  string            `json:"remoteRepositoryHeaderName,omitempty"`
+	RemoteRepositoryHeaderValue string            `json:"remoteRepositoryHeaderValue,omitempty"`
+	CredentialId                string            `json:"credentialId,omitempty"`
+	StorageBackendId            string            `json:"storageBackendId,omitempty"`
+	ManagedBy                   string            `json:"managedBy,omitempty"`
+	Labels                      map[string]string `json:"labels,omitempty"`
+	PackageSettings
+}
+
+// CreateRemoteRepository create a new repository with the given options
+// POST /1/workspaces/:workspace/repositories/remote
+func (c *Client) CreateRemoteRepository(workspace string, opts RepositoryRemoteOptions) (*Repository, error) {
+	return c.CreateRepository(workspace, "remote", opts)
+}
+
+// RepositoryUpdateOptions defines the payload for updating the settings shared by every repository type
+type RepositoryUpdateOptions struct {
+	ManagedBy *string `json:"managedBy,omitempty"`
+	// Replace the labels when set, an empty map removes them
+	Labels *map[string]string `json:"labels,omitempty"`
+	PackageSettings
+}
+
+// UpdateRepository updates a repository in place with the given options
+// PATCH /1/workspaces/:workspace/repositories/:id
+func (c *Client) UpdateRepository(workspace string, id string, opts RepositoryUpdateOptions) (*Repository, error) {
+	var rep Repository
+	err := c.DoRequest(http.MethodPatch, repositoryEndpoint(workspace, id), opts, &rep)
+	return &rep, err
+}
+
+// RepositoryRemoteUpdateOptions defines the payload for updating the credentials of a remote repository
+type RepositoryRemoteUpdateOptions struct {
+	RemoteRepositoryUsername    *string `json:"remoteRepositoryUsername,omitempty"`
+	RemoteRepositoryPassword    *string `json:"remoteRepositoryPassword,omitempty"`
+	RemoteRepositoryHeaderName  *string `json:"remoteRepositoryHeaderName,omitempty"`
+	RemoteRepositoryHeaderValue *string `json:"remoteRepositoryHeaderValue,omitempty"`
+	// An empty Id detaches the credential
+	CredentialId *string `json:"credentialId,omitempty"`
+}
+
+// UpdateRemoteRepository updates a remote repository in place with the given options
+// PATCH /1/workspaces/:workspace/repositories/:id
+func (c *Client) UpdateRemoteRepository(workspace string, id string, opts RepositoryRemoteUpdateOptions) (*Repository, error) {
+	var rep Repository
+	err := c.DoRequest(http.MethodPatch, repositoryEndpoint(workspace, id), opts, &rep)
+	return &rep, err
+}
+
+// RepositoryVirtualOptions extends the payload for creating a virtual repository with its deployment policy
+type RepositoryVirtualOptions struct {
+	repoflow.RepositoryVirtualOptions
+	DeploymentPolicy string            `json:"deploymentPolicy,omitempty"`
+	ManagedBy        string            `json:"managedBy,omitempty"`
+	Labels           map[string]string `json:"labels,omitempty"`
+	PackageSettings
+}
+
+// CreateVirtualRepository create a new repository with the given options
+// POST /1/workspaces/:workspace/repositories/virtual
+func (c *Client) CreateVirtualRepository(workspace string, opts RepositoryVirtualOptions) (*Repository, error) {
+	return c.CreateRepository(workspace, "virtual", opts)
+}
+
+// RepositoryVirtualUpdateOptions defines the payload for updating the settings of a virtual repository
+type RepositoryVirtualUpdateOptions struct {
+	DeploymentPolicy *string `json:"deploymentPolicy,omitempty"`
+}
+
+// UpdateVirtualRepository updates a virtual repository in place with the given options
+// PATCH /1/workspaces/:workspace/repositories/:id
+func (c *Client) UpdateVirtualRepository(workspace string, id string, opts RepositoryVirtualUpdateOptions) (*Repository, error) {
+	var rep Repository
+	err := c.DoRequest(http.MethodPatch, repositoryEndpoint(workspace, id), opts, &rep)
+	return &rep, err
+}
+
+// RepositoryVirtualChildrenOptions defines the payload for updating the children of a virtual repository in bulk
+type RepositoryVirtualChildrenOptions struct {
+	AddChildRepositoryIds    []string `json:"addChildRepositoryIds"`
+	RemoveChildRepositoryIds []string `json:"removeChildRepositoryIds"`
+}
+
+// UpdateVirtualRepositoryChildren adds and removes children of a virtual repository in a single request
+// PATCH /1/workspaces/:workspace/repositories/:id/children
+func (c *Client) UpdateVirtualRepositoryChildren(workspace string, id string, opts RepositoryVirtualChildrenOptions) (*Repository, error) {
+	var rep Repository
+	endpoint := fmt.Sprintf("%s/children", repositoryEndpoint(workspace, id))
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &rep)
+	return &rep, err
+}
+
+// VirtualRepositoryMember defines the resolution filters of a virtual repository child
+type VirtualRepositoryMember struct {
+	RepositoryId    string   `json:"repositoryId"`
+	IncludePatterns []string `json:"includePatterns"`
+	ExcludePatterns []string `json:"excludePatterns"`
+}
+
+// VirtualRepositoryMemberOptions defines the payload for updating the resolution filters of a virtual repository child
+type VirtualRepositoryMemberOptions struct {
+	IncludePatterns []string `json:"includePatterns"`
+	ExcludePatterns []string `json:"excludePatterns"`
+}
+
+// ListVirtualRepositoryMembers retrieves the children of a virtual repository with their resolution filters
+// GET /1/workspaces/:workspace/repositories/:id/children
+func (c *Client) ListVirtualRepositoryMembers(workspace string, id string) (*[]VirtualRepositoryMember, error) {
+	var members []VirtualRepositoryMember
+	endpoint := fmt.Sprintf("%s/children", repositoryEndpoint(workspace, id))
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &members)
+	return &members, err
+}
+
+// UpdateVirtualRepositoryMember replaces the resolution filters of a virtual repository child
+// PUT /1/workspaces/:workspace/repositories/:id/children/:child
+func (c *Client) UpdateVirtualRepositoryMember(workspace string, id string, child string, opts VirtualRepositoryMemberOptions) (*VirtualRepositoryMember, error) {
+	var member VirtualRepositoryMember
+	endpoint := fmt.Sprintf("%s/children/%s", repositoryEndpoint(workspace, id), child)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &member)
+	return &member, err
+}
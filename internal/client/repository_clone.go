@@ -0,0 +1,29 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// RepositoryCloneOptions defines the payload for cloning a repository
+type RepositoryCloneOptions struct {
+	Name              string `json:"name"`
+	TargetWorkspaceId string `json:"targetWorkspaceId"`
+	IncludeContents   bool   `json:"includeContents"`
+}
+
+// RepositoryClone is the result of a clone. The configuration is copied at once,
+// the contents are copied in the background by the returned task.
+type RepositoryClone struct {
+	Repository Repository  `json:"repository"`
+	Task       *SystemTask `json:"task"`
+}
+
+// CloneRepository creates a new repository with the configuration, and optionally the contents, of another one
+// POST /1/workspaces/:workspace/repositories/:id/clone
+func (c *Client) CloneRepository(workspace string, id string, opts RepositoryCloneOptions) (*RepositoryClone, error) {
+	var clone RepositoryClone
+	endpoint := fmt.Sprintf("%s/clone", repositoryEndpoint(workspace, id))
+	err := c.DoRequest(http.MethodPost, endpoint, opts, &clone)
+	return &clone, err
+}
@@ -0,0 +1,55 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// EndpointCheck is the result of a probe of the package endpoint of a repository
+type EndpointCheck struct {
+	Url        string
+	StatusCode int
+	LatencyMs  int64
+}
+
+// RepositoryEndpointUrl returns the URL of the package endpoint of a repository, the one
+// given to the package managers, served under the base URL by package type, workspace and
+// repository names.
+func (c *Client) RepositoryEndpointUrl(packageType string, workspace string, repository string) string {
+	return fmt.Sprintf("%s/%s/%s/%s", c.BaseURL, packageType, url.PathEscape(workspace), url.PathEscape(repository))
+}
+
+// CheckRepositoryEndpoint sends a HEAD request to a path of the package endpoint of a
+// repository, served under the base URL by package type, workspace and repository names.
+// Any HTTP status is a result, only the unreachable endpoints return an error.
+// HEAD /:packageType/:workspace/:repository/:path
+func (c *Client) CheckRepositoryEndpoint(packageType string, workspace string, repository string, path string) (*EndpointCheck, error) {
+	check := EndpointCheck{
+		Url: c.RepositoryEndpointUrl(packageType, workspace, repository) + "/" + strings.TrimPrefix(path, "/"),
+	}
+
+	req, err := http.NewRequest(http.MethodHead, check.Url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	if c.Token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.Token))
+	}
+
+	start := time.Now()
+	// The package endpoints answer a HEAD 404 without a body, so the request skips
+	// the API transport turning the 404 responses into errors
+	resp, err := c.endpointClient.Do(req)
+	check.LatencyMs = time.Since(start).Milliseconds()
+	if err != nil {
+		return nil, fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	check.StatusCode = resp.StatusCode
+
+	return &check, nil
+}
@@ -0,0 +1,62 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Endpoints definitions
+const (
+	PermissionsEndpoint = "/permissions"
+)
+
+type RepositoryPermission struct {
+	Principal string `json:"principal"`
+	Role      string `json:"role"`
+}
+
+// RepositoryPermissionOptions defines the payload for granting a role on a repository
+type RepositoryPermissionOptions struct {
+	Role string `json:"role"`
+}
+
+// RepositoryPermissionsOptions defines the payload for granting roles to many
+// principals of a repository at once, the other principals are left untouched
+type RepositoryPermissionsOptions struct {
+	Grants []RepositoryPermission `json:"grants"`
+}
+
+func permissionEndpoint(workspace string, repository string, principal string) string {
+	return fmt.Sprintf("%s%s/%s", repositoryEndpoint(workspace, repository), PermissionsEndpoint, url.PathEscape(principal))
+}
+
+// GetRepositoryPermission retrieves the role of a principal (user:name or group:name) on a repository
+// GET /1/workspaces/:workspace/repositories/:repository/permissions/:principal
+func (c *Client) GetRepositoryPermission(workspace string, repository string, principal string) (*RepositoryPermission, error) {
+	var rp RepositoryPermission
+	err := c.DoRequest(http.MethodGet, permissionEndpoint(workspace, repository, principal), nil, &rp)
+	return &rp, err
+}
+
+// PutRepositoryPermission grants a role to a principal on a repository, replacing its current one
+// PUT /1/workspaces/:workspace/repositories/:repository/permissions/:principal
+func (c *Client) PutRepositoryPermission(workspace string, repository string, principal string, opts RepositoryPermissionOptions) (*RepositoryPermission, error) {
+	var rp RepositoryPermission
+	err := c.DoRequest(http.MethodPut, permissionEndpoint(workspace, repository, principal), opts, &rp)
+	return &rp, err
+}
+
+// PutRepositoryPermissions grants a role to each principal of the options on a repository
+// PATCH /1/workspaces/:workspace/repositories/:repository/permissions
+func (c *Client) PutRepositoryPermissions(workspace string, repository string, opts RepositoryPermissionsOptions) (*[]RepositoryPermission, error) {
+	var perms []RepositoryPermission
+	err := c.DoRequest(http.MethodPatch, repositoryEndpoint(workspace, repository)+PermissionsEndpoint, opts, &perms)
+	return &perms, err
+}
+
+// DeleteRepositoryPermission revokes the role of a principal on a repository
+// DELETE /1/workspaces/:workspace/repositories/:repository/permissions/:principal
+func (c *Client) DeleteRepositoryPermission(workspace string, repository string, principal string) error {
+	return c.DoRequest(http.MethodDelete, permissionEndpoint(workspace, repository, principal), nil, nil)
+}
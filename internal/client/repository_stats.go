@@ -0,0 +1,31 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+type RepositoryStats struct {
+	Period            string  `json:"period"`
+	RequestCount      int64   `json:"requestCount"`
+	ErrorCount        int64   `json:"errorCount"`
+	ErrorRate         float64 `json:"errorRate"`
+	RequestsPerSecond float64 `json:"requestsPerSecond"`
+	LatencyP50Ms      float64 `json:"latencyP50Ms"`
+	LatencyP95Ms      float64 `json:"latencyP95Ms"`
+	LatencyP99Ms      float64 `json:"latencyP99Ms"`
+}
+
+// GetRepositoryStats retrieves request latency and error metrics of a repository over the given period
+// GET /1/workspaces/:workspace/repositories/:id/stats?period=:period
+func (c *Client) GetRepositoryStats(workspace string, id string, period string) (*RepositoryStats, error) {
+	var stats RepositoryStats
+	endpoint := fmt.Sprintf("%s/%s%s/%s/stats?period=%s",
+		repoflow.WorkspacesEndpoint, workspace, repoflow.RepositoryEndpoint, id, url.QueryEscape(period),
+	)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &stats)
+	return &stats, err
+}
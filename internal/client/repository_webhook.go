@@ -0,0 +1,73 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	WebhooksEndpoint = "/webhooks"
+)
+
+// Events sent by the repository webhooks
+const (
+	WebhookArtifactUploaded = "artifact.uploaded"
+	WebhookArtifactDeleted  = "artifact.deleted"
+	WebhookCacheMiss        = "cache.miss"
+)
+
+// RepositoryWebhook is a webhook notified of the events of a single repository.
+// The secret signing the payloads is never returned by the API.
+type RepositoryWebhook struct {
+	Id      string   `json:"id"`
+	Url     string   `json:"url"`
+	Events  []string `json:"events"`
+	Enabled bool     `json:"enabled"`
+}
+
+// RepositoryWebhookOptions defines the payload for creating or replacing a repository webhook
+type RepositoryWebhookOptions struct {
+	Url     string   `json:"url"`
+	Events  []string `json:"events"`
+	Enabled bool     `json:"enabled"`
+	// The current secret is kept when unset
+	Secret *string `json:"secret,omitempty"`
+}
+
+func webhooksEndpoint(workspace string, repository string) string {
+	return fmt.Sprintf("%s%s", repositoryEndpoint(workspace, repository), WebhooksEndpoint)
+}
+
+// CreateRepositoryWebhook creates a new webhook on a repository
+// POST /1/workspaces/:workspace/repositories/:repository/webhooks
+func (c *Client) CreateRepositoryWebhook(workspace string, repository string, opts RepositoryWebhookOptions) (*RepositoryWebhook, error) {
+	var wh RepositoryWebhook
+	err := c.create(webhooksEndpoint(workspace, repository), opts, &wh)
+	return &wh, err
+}
+
+// GetRepositoryWebhook retrieves a webhook of a repository
+// GET /1/workspaces/:workspace/repositories/:repository/webhooks/:id
+func (c *Client) GetRepositoryWebhook(workspace string, repository string, id string) (*RepositoryWebhook, error) {
+	var wh RepositoryWebhook
+	endpoint := fmt.Sprintf("%s/%s", webhooksEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &wh)
+	return &wh, err
+}
+
+// UpdateRepositoryWebhook replaces the settings of a webhook of a repository
+// PUT /1/workspaces/:workspace/repositories/:repository/webhooks/:id
+func (c *Client) UpdateRepositoryWebhook(workspace string, repository string, id string, opts RepositoryWebhookOptions) (*RepositoryWebhook, error) {
+	var wh RepositoryWebhook
+	endpoint := fmt.Sprintf("%s/%s", webhooksEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &wh)
+	return &wh, err
+}
+
+// DeleteRepositoryWebhook deletes a webhook of a repository
+// DELETE /1/workspaces/:workspace/repositories/:repository/webhooks/:id
+func (c *Client) DeleteRepositoryWebhook(workspace string, repository string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", webhooksEndpoint(workspace, repository), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,68 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	RetentionPoliciesEndpoint = "/retention-policies"
+)
+
+// RetentionPolicy purges the upstream artifacts cached by remote repositories of a
+// workspace once they are not downloaded anymore.
+type RetentionPolicy struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	RepositoryIds []string `json:"repositoryIds"`
+	UnusedDays    int      `json:"unusedDays"`
+	Schedule      string   `json:"schedule"`
+}
+
+// RetentionPolicyOptions defines the payload for creating or replacing a retention policy
+type RetentionPolicyOptions struct {
+	Name          string   `json:"name"`
+	RepositoryIds []string `json:"repositoryIds"`
+	UnusedDays    int      `json:"unusedDays"`
+	Schedule      string   `json:"schedule"`
+}
+
+func retentionPoliciesEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, RetentionPoliciesEndpoint)
+}
+
+// CreateRetentionPolicy creates a new retention policy in a workspace
+// POST /1/workspaces/:workspace/retention-policies
+func (c *Client) CreateRetentionPolicy(workspace string, opts RetentionPolicyOptions) (*RetentionPolicy, error) {
+	var rp RetentionPolicy
+	err := c.create(retentionPoliciesEndpoint(workspace), opts, &rp)
+	return &rp, err
+}
+
+// GetRetentionPolicy retrieves a retention policy
+// GET /1/workspaces/:workspace/retention-policies/:id
+func (c *Client) GetRetentionPolicy(workspace string, id string) (*RetentionPolicy, error) {
+	var rp RetentionPolicy
+	endpoint := fmt.Sprintf("%s/%s", retentionPoliciesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &rp)
+	return &rp, err
+}
+
+// UpdateRetentionPolicy replaces the settings of a retention policy
+// PUT /1/workspaces/:workspace/retention-policies/:id
+func (c *Client) UpdateRetentionPolicy(workspace string, id string, opts RetentionPolicyOptions) (*RetentionPolicy, error) {
+	var rp RetentionPolicy
+	endpoint := fmt.Sprintf("%s/%s", retentionPoliciesEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &rp)
+	return &rp, err
+}
+
+// DeleteRetentionPolicy deletes a retention policy
+// DELETE /1/workspaces/:workspace/retention-policies/:id
+func (c *Client) DeleteRetentionPolicy(workspace string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", retentionPoliciesEndpoint(workspace), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
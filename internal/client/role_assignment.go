@@ -0,0 +1,65 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	RoleAssignmentsEndpoint = "/1/role-assignments"
+)
+
+type RoleAssignment struct {
+	Id           string  `json:"id"`
+	Role         string  `json:"role"`
+	Principal    string  `json:"principal"`
+	WorkspaceId  string  `json:"workspaceId"`
+	RepositoryId *string `json:"repositoryId"`
+}
+
+// RoleAssignmentOptions defines the payload for binding a role to a principal
+type RoleAssignmentOptions struct {
+	Role         string  `json:"role"`
+	Principal    string  `json:"principal"`
+	WorkspaceId  string  `json:"workspaceId"`
+	RepositoryId *string `json:"repositoryId,omitempty"`
+}
+
+// RoleAssignmentUpdateOptions defines the payload for changing the role of an assignment
+type RoleAssignmentUpdateOptions struct {
+	Role string `json:"role"`
+}
+
+// CreateRoleAssignment binds a role to a user or group on a workspace or a repository
+// POST /1/role-assignments
+func (c *Client) CreateRoleAssignment(opts RoleAssignmentOptions) (*RoleAssignment, error) {
+	var ra RoleAssignment
+	err := c.create(RoleAssignmentsEndpoint, opts, &ra)
+	return &ra, err
+}
+
+// GetRoleAssignment retrieves a role assignment by its ID
+// GET /1/role-assignments/:id
+func (c *Client) GetRoleAssignment(id string) (*RoleAssignment, error) {
+	var ra RoleAssignment
+	endpoint := fmt.Sprintf("%s/%s", RoleAssignmentsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &ra)
+	return &ra, err
+}
+
+// UpdateRoleAssignment changes the role of an assignment
+// PATCH /1/role-assignments/:id
+func (c *Client) UpdateRoleAssignment(id string, opts RoleAssignmentUpdateOptions) (*RoleAssignment, error) {
+	var ra RoleAssignment
+	endpoint := fmt.Sprintf("%s/%s", RoleAssignmentsEndpoint, id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &ra)
+	return &ra, err
+}
+
+// DeleteRoleAssignment removes a role assignment by its ID
+// DELETE /1/role-assignments/:id
+func (c *Client) DeleteRoleAssignment(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", RoleAssignmentsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
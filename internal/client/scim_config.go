@@ -0,0 +1,47 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	ScimConfigEndpoint = "/1/settings/scim"
+)
+
+type ScimConfig struct {
+	IsEnabled bool `json:"isEnabled"`
+	// Base URL of the SCIM API, to set on the identity provider
+	BaseUrl string `json:"baseUrl"`
+	// Date of the last change of the bearer token
+	TokenUpdatedAt *string `json:"tokenUpdatedAt"`
+}
+
+// ScimConfigOptions defines the payload for updating the SCIM provisioning
+type ScimConfigOptions struct {
+	IsEnabled bool `json:"isEnabled"`
+	// The current token is kept when unset
+	Token *string `json:"token,omitempty"`
+}
+
+// GetScimConfig retrieves the SCIM provisioning of the instance, not found until set
+// GET /1/settings/scim
+func (c *Client) GetScimConfig() (*ScimConfig, error) {
+	var sc ScimConfig
+	err := c.DoRequest(http.MethodGet, ScimConfigEndpoint, nil, &sc)
+	return &sc, err
+}
+
+// UpdateScimConfig replaces the SCIM provisioning of the instance with the given options
+// PUT /1/settings/scim
+func (c *Client) UpdateScimConfig(opts ScimConfigOptions) (*ScimConfig, error) {
+	var sc ScimConfig
+	err := c.DoRequest(http.MethodPut, ScimConfigEndpoint, opts, &sc)
+	return &sc, err
+}
+
+// DeleteScimConfig removes the SCIM provisioning and revokes its bearer token
+// DELETE /1/settings/scim
+func (c *Client) DeleteScimConfig() error {
+	return c.DoRequest(http.MethodDelete, ScimConfigEndpoint, nil, nil)
+}
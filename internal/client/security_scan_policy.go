@@ -0,0 +1,59 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	SecurityScanPolicyEndpoint = "/security-scan-policy"
+)
+
+// SecurityScanPolicy defines how the packages of a workspace or a repository are
+// scanned for vulnerabilities. The policy of a repository wins over the one of
+// its workspace.
+type SecurityScanPolicy struct {
+	WorkspaceId string `json:"workspaceId"`
+	// Unset for the policy of the workspace
+	RepositoryId *string `json:"repositoryId"`
+	// Lowest severity blocking the downloads, the vulnerabilities are only
+	// reported when unset
+	BlockSeverity *string `json:"blockSeverity"`
+	// Behavior for the packages not scanned yet or when the scanner fails:
+	// open (served) or closed (blocked)
+	FailMode     string `json:"failMode"`
+	ScanOnUpload bool   `json:"scanOnUpload"`
+}
+
+// SecurityScanPolicyOptions defines the payload for replacing a security scan policy
+type SecurityScanPolicyOptions struct {
+	BlockSeverity *string `json:"blockSeverity"`
+	FailMode      string  `json:"failMode"`
+	ScanOnUpload  bool    `json:"scanOnUpload"`
+}
+
+// GetSecurityScanPolicy retrieves the scan policy of a repository, or of the workspace when the repository is empty
+// GET /1/workspaces/:workspace/security-scan-policy
+// GET /1/workspaces/:workspace/repositories/:repository/security-scan-policy
+func (c *Client) GetSecurityScanPolicy(workspace string, repository string) (*SecurityScanPolicy, error) {
+	var sp SecurityScanPolicy
+	err := c.DoRequest(http.MethodGet, scopedEndpoint(workspace, repository, SecurityScanPolicyEndpoint), nil, &sp)
+	return &sp, err
+}
+
+// PutSecurityScanPolicy replaces the scan policy of a repository, or of the workspace when the repository is empty
+// PUT /1/workspaces/:workspace/security-scan-policy
+// PUT /1/workspaces/:workspace/repositories/:repository/security-scan-policy
+func (c *Client) PutSecurityScanPolicy(workspace string, repository string, opts SecurityScanPolicyOptions) (*SecurityScanPolicy, error) {
+	var sp SecurityScanPolicy
+	err := c.DoRequest(http.MethodPut, scopedEndpoint(workspace, repository, SecurityScanPolicyEndpoint), opts, &sp)
+	return &sp, err
+}
+
+// DeleteSecurityScanPolicy resets the scan policy of a repository to the one of
+// its workspace, or of the workspace to the instance defaults when the repository is empty
+// DELETE /1/workspaces/:workspace/security-scan-policy
+// DELETE /1/workspaces/:workspace/repositories/:repository/security-scan-policy
+func (c *Client) DeleteSecurityScanPolicy(workspace string, repository string) error {
+	return c.DoRequest(http.MethodDelete, scopedEndpoint(workspace, repository, SecurityScanPolicyEndpoint), nil, nil)
+}
@@ -0,0 +1,99 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	ServiceAccountsEndpoint = "/1/service-accounts"
+)
+
+type ServiceAccount struct {
+	Id          string                `json:"id"`
+	Name        string                `json:"name"`
+	Description *string               `json:"description"`
+	Scopes      []string              `json:"scopes"`
+	Tokens      []ServiceAccountToken `json:"tokens"`
+	CreatedAt   string                `json:"createdAt"`
+}
+
+type ServiceAccountToken struct {
+	Id        string  `json:"id"`
+	Name      string  `json:"name"`
+	ExpiresAt *string `json:"expiresAt"`
+	CreatedAt string  `json:"createdAt"`
+	// Token is only returned on creation
+	Token *string `json:"token,omitempty"`
+}
+
+// ServiceAccountOptions defines the payload for creating a service account
+type ServiceAccountOptions struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Scopes      []string `json:"scopes"`
+}
+
+// ServiceAccountUpdateOptions defines the payload for updating a service account
+type ServiceAccountUpdateOptions struct {
+	Description *string  `json:"description"`
+	Scopes      []string `json:"scopes"`
+}
+
+// ServiceAccountTokenOptions defines the payload for creating a service account token,
+// the token has the scopes of its account
+type ServiceAccountTokenOptions struct {
+	Name      string  `json:"name"`
+	ExpiresAt *string `json:"expiresAt,omitempty"`
+}
+
+func serviceAccountEndpoint(id string) string {
+	return fmt.Sprintf("%s/%s", ServiceAccountsEndpoint, id)
+}
+
+// CreateServiceAccount creates a new service account
+// POST /1/service-accounts
+func (c *Client) CreateServiceAccount(opts ServiceAccountOptions) (*ServiceAccount, error) {
+	var sa ServiceAccount
+	err := c.create(ServiceAccountsEndpoint, opts, &sa)
+	return &sa, err
+}
+
+// GetServiceAccount retrieves a service account and the metadata of its tokens
+// GET /1/service-accounts/:id
+func (c *Client) GetServiceAccount(id string) (*ServiceAccount, error) {
+	var sa ServiceAccount
+	err := c.DoRequest(http.MethodGet, serviceAccountEndpoint(id), nil, &sa)
+	return &sa, err
+}
+
+// UpdateServiceAccount updates the description and scopes of a service account
+// PATCH /1/service-accounts/:id
+func (c *Client) UpdateServiceAccount(id string, opts ServiceAccountUpdateOptions) (*ServiceAccount, error) {
+	var sa ServiceAccount
+	err := c.DoRequest(http.MethodPatch, serviceAccountEndpoint(id), opts, &sa)
+	return &sa, err
+}
+
+// DeleteServiceAccount deletes a service account and revokes its tokens
+// DELETE /1/service-accounts/:id
+func (c *Client) DeleteServiceAccount(id string) error {
+	return c.DoRequest(http.MethodDelete, serviceAccountEndpoint(id), nil, nil)
+}
+
+// CreateServiceAccountToken creates a new token for a service account, its value is only returned once
+// POST /1/service-accounts/:id/tokens
+func (c *Client) CreateServiceAccountToken(account string, opts ServiceAccountTokenOptions) (*ServiceAccountToken, error) {
+	var t ServiceAccountToken
+	endpoint := fmt.Sprintf("%s/tokens", serviceAccountEndpoint(account))
+	err := c.DoRequest(http.MethodPost, endpoint, opts, &t)
+	return &t, err
+}
+
+// DeleteServiceAccountToken revokes a token of a service account
+// DELETE /1/service-accounts/:id/tokens/:tokenId
+func (c *Client) DeleteServiceAccountToken(account string, id string) error {
+	endpoint := fmt.Sprintf("%s/tokens/%s", serviceAccountEndpoint(account), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
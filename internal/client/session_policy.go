@@ -0,0 +1,50 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	SessionPolicyEndpoint = "/1/settings/session-policy"
+)
+
+// SessionPolicy defines how long the web sessions and the API tokens of the
+// instance stay valid.
+type SessionPolicy struct {
+	// Hours after the login ending a session, whatever its activity
+	MaxSessionAgeHours int `json:"maxSessionAgeHours"`
+	// Minutes without activity ending a session
+	IdleTimeoutMinutes int `json:"idleTimeoutMinutes"`
+	// Longest lifetime of the new access tokens, unset when unlimited
+	MaxTokenTtlDays *int `json:"maxTokenTtlDays"`
+}
+
+// SessionPolicyOptions defines the payload for updating the session policy
+type SessionPolicyOptions struct {
+	MaxSessionAgeHours int  `json:"maxSessionAgeHours"`
+	IdleTimeoutMinutes int  `json:"idleTimeoutMinutes"`
+	MaxTokenTtlDays    *int `json:"maxTokenTtlDays"`
+}
+
+// GetSessionPolicy retrieves the session policy of the instance
+// GET /1/settings/session-policy
+func (c *Client) GetSessionPolicy() (*SessionPolicy, error) {
+	var sp SessionPolicy
+	err := c.DoRequest(http.MethodGet, SessionPolicyEndpoint, nil, &sp)
+	return &sp, err
+}
+
+// UpdateSessionPolicy replaces the session policy of the instance with the given options
+// PUT /1/settings/session-policy
+func (c *Client) UpdateSessionPolicy(opts SessionPolicyOptions) (*SessionPolicy, error) {
+	var sp SessionPolicy
+	err := c.DoRequest(http.MethodPut, SessionPolicyEndpoint, opts, &sp)
+	return &sp, err
+}
+
+// DeleteSessionPolicy resets the session policy of the instance to its defaults
+// DELETE /1/settings/session-policy
+func (c *Client) DeleteSessionPolicy() error {
+	return c.DoRequest(http.MethodDelete, SessionPolicyEndpoint, nil, nil)
+}
@@ -0,0 +1,82 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	SigningKeysEndpoint = "/signing-keys"
+)
+
+// SigningKey is a GPG key of a workspace signing the metadata of its repositories,
+// like the Release files of Debian and the repomd.xml of RPM repositories.
+// The private key is never returned by the API.
+type SigningKey struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	Algorithm     string   `json:"algorithm"`
+	Fingerprint   string   `json:"fingerprint"`
+	PublicKey     string   `json:"publicKey"`
+	RepositoryIds []string `json:"repositoryIds"`
+	// Whether the key was generated by RepoFlow rather than uploaded
+	Generated bool   `json:"generated"`
+	CreatedAt string `json:"createdAt"`
+}
+
+// SigningKeyOptions defines the payload for creating a signing key, a key is
+// generated with the algorithm when no private key is given
+type SigningKeyOptions struct {
+	Name          string   `json:"name"`
+	Algorithm     string   `json:"algorithm,omitempty"`
+	PrivateKey    *string  `json:"privateKey,omitempty"`
+	Passphrase    *string  `json:"passphrase,omitempty"`
+	RepositoryIds []string `json:"repositoryIds"`
+}
+
+// SigningKeyUpdateOptions defines the payload for updating a signing key, the
+// key itself never changes
+type SigningKeyUpdateOptions struct {
+	Name          string   `json:"name"`
+	RepositoryIds []string `json:"repositoryIds"`
+}
+
+func signingKeysEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, SigningKeysEndpoint)
+}
+
+// CreateSigningKey uploads or generates a signing key in a workspace
+// POST /1/workspaces/:workspace/signing-keys
+func (c *Client) CreateSigningKey(workspace string, opts SigningKeyOptions) (*SigningKey, error) {
+	var sk SigningKey
+	err := c.create(signingKeysEndpoint(workspace), opts, &sk)
+	return &sk, err
+}
+
+// GetSigningKey retrieves a signing key, without its private key
+// GET /1/workspaces/:workspace/signing-keys/:id
+func (c *Client) GetSigningKey(workspace string, id string) (*SigningKey, error) {
+	var sk SigningKey
+	endpoint := fmt.Sprintf("%s/%s", signingKeysEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &sk)
+	return &sk, err
+}
+
+// UpdateSigningKey updates the name and the repositories signed by a key
+// PATCH /1/workspaces/:workspace/signing-keys/:id
+func (c *Client) UpdateSigningKey(workspace string, id string, opts SigningKeyUpdateOptions) (*SigningKey, error) {
+	var sk SigningKey
+	endpoint := fmt.Sprintf("%s/%s", signingKeysEndpoint(workspace), id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &sk)
+	return &sk, err
+}
+
+// DeleteSigningKey deletes a signing key, the metadata of its repositories is no longer signed
+// DELETE /1/workspaces/:workspace/signing-keys/:id
+func (c *Client) DeleteSigningKey(workspace string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", signingKeysEndpoint(workspace), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
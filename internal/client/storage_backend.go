@@ -0,0 +1,69 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	StorageBackendsEndpoint = "/1/system/storage-backends"
+)
+
+type StorageBackend struct {
+	Id          string  `json:"id"`
+	Name        string  `json:"name"`
+	BackendType string  `json:"backendType"`
+	Bucket      *string `json:"bucket"`
+	Region      *string `json:"region"`
+	Endpoint    *string `json:"endpoint"`
+	Path        *string `json:"path"`
+	// Size of the packages stored in the backend
+	UsedBytes int64 `json:"usedBytes"`
+}
+
+// StorageBackendOptions defines the payload for creating or replacing a storage backend
+type StorageBackendOptions struct {
+	Name        string  `json:"name"`
+	BackendType string  `json:"backendType"`
+	Bucket      *string `json:"bucket,omitempty"`
+	Region      *string `json:"region,omitempty"`
+	Endpoint    *string `json:"endpoint,omitempty"`
+	Path        *string `json:"path,omitempty"`
+	// The current credentials are kept when unset
+	Credentials *string `json:"credentials,omitempty"`
+}
+
+// CreateStorageBackend registers a new storage backend
+// POST /1/system/storage-backends
+func (c *Client) CreateStorageBackend(opts StorageBackendOptions) (*StorageBackend, error) {
+	var sb StorageBackend
+	err := c.create(StorageBackendsEndpoint, opts, &sb)
+	return &sb, err
+}
+
+// GetStorageBackend retrieves a storage backend by its ID
+// GET /1/system/storage-backends/:id
+func (c *Client) GetStorageBackend(id string) (*StorageBackend, error) {
+	var sb StorageBackend
+	endpoint := fmt.Sprintf("%s/%s", StorageBackendsEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &sb)
+	return &sb, err
+}
+
+// UpdateStorageBackend replaces a storage backend
+// PUT /1/system/storage-backends/:id
+func (c *Client) UpdateStorageBackend(id string, opts StorageBackendOptions) (*StorageBackend, error) {
+	var sb StorageBackend
+	endpoint := fmt.Sprintf("%s/%s", StorageBackendsEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &sb)
+	return &sb, err
+}
+
+// DeleteStorageBackend removes a storage backend by its ID, it must not be used
+// by a workspace or a repository
+// DELETE /1/system/storage-backends/:id
+func (c *Client) DeleteStorageBackend(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", StorageBackendsEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,54 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	SystemTasksEndpoint = "/1/system/tasks"
+)
+
+// Status of a system task, the task is over once succeeded or failed
+const (
+	SystemTaskPending   = "pending"
+	SystemTaskRunning   = "running"
+	SystemTaskSucceeded = "succeeded"
+	SystemTaskFailed    = "failed"
+)
+
+type SystemTask struct {
+	Id          string  `json:"id"`
+	Task        string  `json:"task"`
+	Status      string  `json:"status"`
+	Processed   int     `json:"processed"`
+	Total       int     `json:"total"`
+	Message     *string `json:"message"`
+	StartedAt   *string `json:"startedAt"`
+	CompletedAt *string `json:"completedAt"`
+}
+
+// SystemTaskOptions defines the payload for starting a system task
+type SystemTaskOptions struct {
+	Task        string  `json:"task"`
+	PackageType string  `json:"packageType"`
+	WorkspaceId *string `json:"workspaceId,omitempty"`
+}
+
+// StartSystemTask starts a maintenance task running in the background
+// POST /1/system/tasks
+func (c *Client) StartSystemTask(opts SystemTaskOptions) (*SystemTask, error) {
+	var st SystemTask
+	err := c.DoRequest(http.MethodPost, SystemTasksEndpoint, opts, &st)
+	return &st, err
+}
+
+// GetSystemTask retrieves the progress of a system task
+// GET /1/system/tasks/:id
+func (c *Client) GetSystemTask(id string) (*SystemTask, error) {
+	var st SystemTask
+	endpoint := fmt.Sprintf("%s/%s", SystemTasksEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &st)
+	return &st, err
+}
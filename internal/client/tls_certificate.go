@@ -0,0 +1,60 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	TlsCertificatesEndpoint = "/1/tls-certificates"
+)
+
+type TlsCertificate struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Certificate string `json:"certificate"`
+	Fingerprint string `json:"fingerprint"`
+	NotBefore   string `json:"notBefore"`
+	ExpiresAt   string `json:"expiresAt"`
+}
+
+// TlsCertificateOptions defines the payload for uploading a certificate
+type TlsCertificateOptions struct {
+	Name        string `json:"name,omitempty"`
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+// CreateTlsCertificate uploads a new certificate and its private key
+// POST /1/tls-certificates
+func (c *Client) CreateTlsCertificate(opts TlsCertificateOptions) (*TlsCertificate, error) {
+	var cert TlsCertificate
+	err := c.create(TlsCertificatesEndpoint, opts, &cert)
+	return &cert, err
+}
+
+// GetTlsCertificate retrieves metadata for a specific certificate, the private key is never returned
+// GET /1/tls-certificates/:id
+func (c *Client) GetTlsCertificate(id string) (*TlsCertificate, error) {
+	var cert TlsCertificate
+	endpoint := fmt.Sprintf("%s/%s", TlsCertificatesEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &cert)
+	return &cert, err
+}
+
+// UpdateTlsCertificate rotates the certificate and its private key
+// PUT /1/tls-certificates/:id
+func (c *Client) UpdateTlsCertificate(id string, opts TlsCertificateOptions) (*TlsCertificate, error) {
+	var cert TlsCertificate
+	endpoint := fmt.Sprintf("%s/%s", TlsCertificatesEndpoint, id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &cert)
+	return &cert, err
+}
+
+// DeleteTlsCertificate removes a certificate by its ID
+// DELETE /1/tls-certificates/:id
+func (c *Client) DeleteTlsCertificate(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", TlsCertificatesEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
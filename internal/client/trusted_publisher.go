@@ -0,0 +1,76 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	TrustedPublishersEndpoint = "/trusted-publishers"
+)
+
+// TrustedPublisher allows the workloads of an OIDC issuer, like the GitHub
+// Actions workflows, to publish to a repository with their identity token
+// instead of a long-lived token.
+type TrustedPublisher struct {
+	Id string `json:"id"`
+	// URL of the issuer of the identity tokens
+	Issuer string `json:"issuer"`
+	// Expected aud claim of the identity tokens
+	Audience string `json:"audience"`
+	// Glob patterns, the sub claim of the token must match one of them
+	SubjectPatterns []string `json:"subjectPatterns"`
+	// Other claims the token must hold with the exact value
+	RequiredClaims map[string]string `json:"requiredClaims"`
+	Description    *string           `json:"description"`
+	IsEnabled      bool              `json:"isEnabled"`
+}
+
+// TrustedPublisherOptions defines the payload for creating or replacing a trusted publisher
+type TrustedPublisherOptions struct {
+	Issuer string `json:"issuer"`
+	// The instance URL is expected when unset
+	Audience        *string           `json:"audience"`
+	SubjectPatterns []string          `json:"subjectPatterns"`
+	RequiredClaims  map[string]string `json:"requiredClaims"`
+	Description     *string           `json:"description"`
+	IsEnabled       bool              `json:"isEnabled"`
+}
+
+func trustedPublishersEndpoint(workspace string, repository string) string {
+	return fmt.Sprintf("%s%s", repositoryEndpoint(workspace, repository), TrustedPublishersEndpoint)
+}
+
+// CreateTrustedPublisher creates a new trusted publisher on a local repository
+// POST /1/workspaces/:workspace/repositories/:repository/trusted-publishers
+func (c *Client) CreateTrustedPublisher(workspace string, repository string, opts TrustedPublisherOptions) (*TrustedPublisher, error) {
+	var tp TrustedPublisher
+	err := c.create(trustedPublishersEndpoint(workspace, repository), opts, &tp)
+	return &tp, err
+}
+
+// GetTrustedPublisher retrieves a trusted publisher of a repository
+// GET /1/workspaces/:workspace/repositories/:repository/trusted-publishers/:id
+func (c *Client) GetTrustedPublisher(workspace string, repository string, id string) (*TrustedPublisher, error) {
+	var tp TrustedPublisher
+	endpoint := fmt.Sprintf("%s/%s", trustedPublishersEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &tp)
+	return &tp, err
+}
+
+// UpdateTrustedPublisher replaces a trusted publisher of a repository
+// PUT /1/workspaces/:workspace/repositories/:repository/trusted-publishers/:id
+func (c *Client) UpdateTrustedPublisher(workspace string, repository string, id string, opts TrustedPublisherOptions) (*TrustedPublisher, error) {
+	var tp TrustedPublisher
+	endpoint := fmt.Sprintf("%s/%s", trustedPublishersEndpoint(workspace, repository), id)
+	err := c.DoRequest(http.MethodPut, endpoint, opts, &tp)
+	return &tp, err
+}
+
+// DeleteTrustedPublisher deletes a trusted publisher of a repository
+// DELETE /1/workspaces/:workspace/repositories/:repository/trusted-publishers/:id
+func (c *Client) DeleteTrustedPublisher(workspace string, repository string, id string) error {
+	endpoint := fmt.Sprintf("%s/%s", trustedPublishersEndpoint(workspace, repository), id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
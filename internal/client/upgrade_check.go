@@ -0,0 +1,31 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	UpgradeCheckEndpoint = "/1/system/upgrade-check"
+)
+
+type BreakingChange struct {
+	Version     string `json:"version"`
+	Area        string `json:"area"`
+	Description string `json:"description"`
+}
+
+type UpgradeCheck struct {
+	CurrentVersion   string           `json:"currentVersion"`
+	LatestVersion    string           `json:"latestVersion"`
+	UpgradeAvailable bool             `json:"upgradeAvailable"`
+	BreakingChanges  []BreakingChange `json:"breakingChanges"`
+}
+
+// GetUpgradeCheck retrieves the latest server version and the breaking changes since the running one
+// GET /1/system/upgrade-check
+func (c *Client) GetUpgradeCheck() (*UpgradeCheck, error) {
+	var uc UpgradeCheck
+	err := c.DoRequest(http.MethodGet, UpgradeCheckEndpoint, nil, &uc)
+	return &uc, err
+}
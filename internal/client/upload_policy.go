@@ -0,0 +1,60 @@
+package client
+
+import (
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	UploadPolicyEndpoint = "/upload-policy"
+)
+
+// UploadPolicy defines the constraints on the artifacts uploaded in a workspace
+// or a repository. The policy of a repository wins over the one of its workspace.
+type UploadPolicy struct {
+	WorkspaceId string `json:"workspaceId"`
+	// Unset for the policy of the workspace
+	RepositoryId *string `json:"repositoryId"`
+	// Unset for no limit
+	MaxArtifactSizeBytes *int64 `json:"maxArtifactSizeBytes"`
+	// File extensions without the leading dot, any extension is accepted when empty
+	AllowedExtensions []string `json:"allowedExtensions"`
+	// Whether the uploads must send a checksum of the artifact
+	IsChecksumRequired bool `json:"isChecksumRequired"`
+	// Glob patterns of the paths refused
+	BlockedPathPatterns []string `json:"blockedPathPatterns"`
+}
+
+// UploadPolicyOptions defines the payload for replacing an upload policy
+type UploadPolicyOptions struct {
+	MaxArtifactSizeBytes *int64   `json:"maxArtifactSizeBytes"`
+	AllowedExtensions    []string `json:"allowedExtensions"`
+	IsChecksumRequired   bool     `json:"isChecksumRequired"`
+	BlockedPathPatterns  []string `json:"blockedPathPatterns"`
+}
+
+// GetUploadPolicy retrieves the upload policy of a repository, or of the workspace when the repository is empty
+// GET /1/workspaces/:workspace/upload-policy
+// GET /1/workspaces/:workspace/repositories/:repository/upload-policy
+func (c *Client) GetUploadPolicy(workspace string, repository string) (*UploadPolicy, error) {
+	var up UploadPolicy
+	err := c.DoRequest(http.MethodGet, scopedEndpoint(workspace, repository, UploadPolicyEndpoint), nil, &up)
+	return &up, err
+}
+
+// PutUploadPolicy replaces the upload policy of a repository, or of the workspace when the repository is empty
+// PUT /1/workspaces/:workspace/upload-policy
+// PUT /1/workspaces/:workspace/repositories/:repository/upload-policy
+func (c *Client) PutUploadPolicy(workspace string, repository string, opts UploadPolicyOptions) (*UploadPolicy, error) {
+	var up UploadPolicy
+	err := c.DoRequest(http.MethodPut, scopedEndpoint(workspace, repository, UploadPolicyEndpoint), opts, &up)
+	return &up, err
+}
+
+// DeleteUploadPolicy removes the upload policy of a repository, which then
+// follows its workspace, or of the workspace when the repository is empty
+// DELETE /1/workspaces/:workspace/upload-policy
+// DELETE /1/workspaces/:workspace/repositories/:repository/upload-policy
+func (c *Client) DeleteUploadPolicy(workspace string, repository string) error {
+	return c.DoRequest(http.MethodDelete, scopedEndpoint(workspace, repository, UploadPolicyEndpoint), nil, nil)
+}
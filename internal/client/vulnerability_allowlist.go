@@ -0,0 +1,75 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// Endpoints definitions
+const (
+	VulnerabilityAllowlistEndpoint = "/1/security/vulnerability-allowlist"
+)
+
+// AllowedVulnerability waives a vulnerability in the security scan policies
+// until it expires.
+type AllowedVulnerability struct {
+	Id string `json:"id"`
+	// CVE or GHSA identifier
+	VulnerabilityId string `json:"vulnerabilityId"`
+	// The whole instance when unset
+	WorkspaceId  *string `json:"workspaceId"`
+	RepositoryId *string `json:"repositoryId"`
+	// Every package affected by the vulnerability when unset
+	PackageName   *string `json:"packageName"`
+	Justification string  `json:"justification"`
+	ExpiresAt     string  `json:"expiresAt"`
+}
+
+// AllowedVulnerabilityOptions defines the payload for allowing a vulnerability
+type AllowedVulnerabilityOptions struct {
+	VulnerabilityId string  `json:"vulnerabilityId"`
+	WorkspaceId     *string `json:"workspaceId,omitempty"`
+	RepositoryId    *string `json:"repositoryId,omitempty"`
+	PackageName     *string `json:"packageName,omitempty"`
+	Justification   string  `json:"justification"`
+	ExpiresAt       string  `json:"expiresAt"`
+}
+
+// AllowedVulnerabilityUpdateOptions defines the payload for updating an allowed vulnerability
+type AllowedVulnerabilityUpdateOptions struct {
+	Justification string `json:"justification"`
+	ExpiresAt     string `json:"expiresAt"`
+}
+
+// CreateAllowedVulnerability allows a vulnerability until it expires
+// POST /1/security/vulnerability-allowlist
+func (c *Client) CreateAllowedVulnerability(opts AllowedVulnerabilityOptions) (*AllowedVulnerability, error) {
+	var av AllowedVulnerability
+	err := c.create(VulnerabilityAllowlistEndpoint, opts, &av)
+	return &av, err
+}
+
+// GetAllowedVulnerability retrieves an allowed vulnerability, expired ones are not found
+// GET /1/security/vulnerability-allowlist/:id
+func (c *Client) GetAllowedVulnerability(id string) (*AllowedVulnerability, error) {
+	var av AllowedVulnerability
+	endpoint := fmt.Sprintf("%s/%s", VulnerabilityAllowlistEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &av)
+	return &av, err
+}
+
+// UpdateAllowedVulnerability updates the justification or the expiry of an allowed vulnerability
+// PATCH /1/security/vulnerability-allowlist/:id
+func (c *Client) UpdateAllowedVulnerability(id string, opts AllowedVulnerabilityUpdateOptions) (*AllowedVulnerability, error) {
+	var av AllowedVulnerability
+	endpoint := fmt.Sprintf("%s/%s", VulnerabilityAllowlistEndpoint, id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &av)
+	return &av, err
+}
+
+// DeleteAllowedVulnerability revokes an allowed vulnerability by its ID
+// DELETE /1/security/vulnerability-allowlist/:id
+func (c *Client) DeleteAllowedVulnerability(id string) error {
+	endpoint := fmt.Sprintf("%s/%s", VulnerabilityAllowlistEndpoint, id)
+	return c.DoRequest(http.MethodDelete, endpoint, nil, nil)
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Workspace extends the upstream workspace with the settings managed by the provider
+type Workspace struct {
+	repoflow.Workspace
+	Description *string           `json:"comment,omitempty"`
+	Labels      map[string]string `json:"labels,omitempty"`
+
+	// Defaults inherited by the remote repositories
+	DefaultRemoteCacheEnabled *bool `json:"defaultRemoteCacheEnabled"`
+	DefaultFileCacheTtl       *int  `json:"defaultFileCacheTimeTillRevalidation"`
+	DefaultMetadataCacheTtl   *int  `json:"defaultMetadataCacheTimeTillRevalidation"`
+
+	DefaultMemberRole  *string `json:"defaultMemberRole"`
+	AuditRetentionDays *int    `json:"auditRetentionDays"`
+
+	// Tool managing the workspace
+	ManagedBy *string `json:"managedBy"`
+	// Date of the last change of the settings
+	UpdatedAt *string `json:"updatedAt"`
+	// RepoFlow cloud region hosting the workspace, unset on self-hosted instances
+	Region *string `json:"region"`
+	// Storage backend of the new packages, the instance default one when unset
+	StorageBackendId *string `json:"storageBackendId"`
+}
+
+// WorkspaceOptions defines the payload for creating a workspace
+type WorkspaceOptions struct {
+	repoflow.WorkspaceOptions
+	Labels                    map[string]string `json:"labels,omitempty"`
+	DefaultRemoteCacheEnabled *bool             `json:"defaultRemoteCacheEnabled,omitempty"`
+	DefaultFileCacheTtl       *int              `json:"defaultFileCacheTimeTillRevalidation,omitempty"`
+	DefaultMetadataCacheTtl   *int              `json:"defaultMetadataCacheTimeTillRevalidation,omitempty"`
+	DefaultMemberRole         *string           `json:"defaultMemberRole,omitempty"`
+	AuditRetentionDays        *int              `json:"auditRetentionDays,omitempty"`
+	StorageBackendId          *string           `json:"storageBackendId,omitempty"`
+	ManagedBy                 string            `json:"managedBy,omitempty"`
+}
+
+// WorkspaceUpdateOptions defines the payload for updating a workspace
+type WorkspaceUpdateOptions struct {
+	Description  *string           `json:"comment"`
+	Labels       map[string]string `json:"labels"`
+	StorageLimit *int              `json:"storageLimit"`
+
+	DefaultRemoteCacheEnabled *bool `json:"defaultRemoteCacheEnabled"`
+	DefaultFileCacheTtl       *int  `json:"defaultFileCacheTimeTillRevalidation"`
+	DefaultMetadataCacheTtl   *int  `json:"defaultMetadataCacheTimeTillRevalidation"`
+
+	DefaultMemberRole  *string `json:"defaultMemberRole"`
+	AuditRetentionDays *int    `json:"auditRetentionDays"`
+	StorageBackendId   *string `json:"storageBackendId"`
+
+	ManagedBy *string `json:"managedBy"`
+}
+
+// CreateWorkspace creates a new workspace with the given options
+// POST /1/workspaces
+func (c *Client) CreateWorkspace(opts WorkspaceOptions) (*Workspace, error) {
+	var ws Workspace
+	err := c.create(repoflow.WorkspacesEndpoint, opts, &ws)
+	return &ws, err
+}
+
+// GetWorkspace retrieves metadata for a specific workspace
+// GET /1/workspaces/:id
+func (c *Client) GetWorkspace(id string) (*Workspace, error) {
+	var ws Workspace
+	endpoint := fmt.Sprintf("%s/%s", repoflow.WorkspacesEndpoint, id)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &ws)
+	return &ws, err
+}
+
+// UpdateWorkspace updates a workspace in place with the given options
+// PATCH /1/workspaces/:id
+func (c *Client) UpdateWorkspace(id string, opts WorkspaceUpdateOptions) (*Workspace, error) {
+	var ws Workspace
+	endpoint := fmt.Sprintf("%s/%s", repoflow.WorkspacesEndpoint, id)
+	err := c.DoRequest(http.MethodPatch, endpoint, opts, &ws)
+	return &ws, err
+}
@@ -0,0 +1,20 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// ExportWorkspace retrieves the configuration document of a workspace: its
+// settings, repositories, policies and permissions. The document is kept as
+// sent by RepoFlow, so it can be imported back as is.
+// GET /1/workspaces/:workspace/export
+func (c *Client) ExportWorkspace(workspace string) (json.RawMessage, error) {
+	var doc json.RawMessage
+	endpoint := fmt.Sprintf("%s/%s/export", repoflow.WorkspacesEndpoint, workspace)
+	err := c.DoRequest(http.MethodGet, endpoint, nil, &doc)
+	return doc, err
+}
@@ -0,0 +1,42 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// WorkspaceImportOptions defines the payload for applying a configuration
+// document to a workspace
+type WorkspaceImportOptions struct {
+	// Document as returned by ExportWorkspace
+	Document json.RawMessage `json:"document"`
+	// Only report the changes, without applying them
+	DryRun bool `json:"dryRun"`
+}
+
+// WorkspaceImport lists the changes made, or that would be made, by an import.
+// The objects missing from the document are left untouched.
+type WorkspaceImport struct {
+	Changes []WorkspaceImportChange `json:"changes"`
+}
+
+type WorkspaceImportChange struct {
+	// Kind of object: workspace, repository, cleanup_policy, retention_policy or permission
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// create or update
+	Action string `json:"action"`
+}
+
+// ImportWorkspace applies a configuration document to a workspace, creating
+// the missing objects and updating the others
+// POST /1/workspaces/:workspace/import
+func (c *Client) ImportWorkspace(workspace string, opts WorkspaceImportOptions) (*WorkspaceImport, error) {
+	var result WorkspaceImport
+	endpoint := fmt.Sprintf("%s/%s/import", repoflow.WorkspacesEndpoint, workspace)
+	err := c.DoRequest(http.MethodPost, endpoint, opts, &result)
+	return &result, err
+}
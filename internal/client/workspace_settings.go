@@ -0,0 +1,59 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Endpoints definitions
+const (
+	WorkspaceSettingsEndpoint = "/settings"
+)
+
+// WorkspaceSettings holds the rules applied to the repositories of a workspace.
+// The repositories existing when they change are left untouched.
+type WorkspaceSettings struct {
+	WorkspaceId string `json:"workspaceId"`
+	// Package types of the new repositories, every type when empty
+	AllowedPackageTypes []string `json:"allowedPackageTypes"`
+	// Regular expression the names of the new repositories must match, unset when free
+	RepositoryNamePattern *string `json:"repositoryNamePattern"`
+	// Days without download after which the artifacts cached by the remote repositories
+	// without retention policy are purged, unset when kept forever
+	DefaultRetentionUnusedDays *int `json:"defaultRetentionUnusedDays"`
+}
+
+// WorkspaceSettingsOptions defines the payload for replacing the settings of a workspace
+type WorkspaceSettingsOptions struct {
+	AllowedPackageTypes        []string `json:"allowedPackageTypes"`
+	RepositoryNamePattern      *string  `json:"repositoryNamePattern"`
+	DefaultRetentionUnusedDays *int     `json:"defaultRetentionUnusedDays"`
+}
+
+func workspaceSettingsEndpoint(workspace string) string {
+	return fmt.Sprintf("%s/%s%s", repoflow.WorkspacesEndpoint, workspace, WorkspaceSettingsEndpoint)
+}
+
+// GetWorkspaceSettings retrieves the settings of a workspace
+// GET /1/workspaces/:workspace/settings
+func (c *Client) GetWorkspaceSettings(workspace string) (*WorkspaceSettings, error) {
+	var st WorkspaceSettings
+	err := c.DoRequest(http.MethodGet, workspaceSettingsEndpoint(workspace), nil, &st)
+	return &st, err
+}
+
+// PutWorkspaceSettings replaces the settings of a workspace
+// PUT /1/workspaces/:workspace/settings
+func (c *Client) PutWorkspaceSettings(workspace string, opts WorkspaceSettingsOptions) (*WorkspaceSettings, error) {
+	var st WorkspaceSettings
+	err := c.DoRequest(http.MethodPut, workspaceSettingsEndpoint(workspace), opts, &st)
+	return &st, err
+}
+
+// DeleteWorkspaceSettings resets the settings of a workspace to their defaults, lifting every rule
+// DELETE /1/workspaces/:workspace/settings
+func (c *Client) DeleteWorkspaceSettings(workspace string) error {
+	return c.DoRequest(http.MethodDelete, workspaceSettingsEndpoint(workspace), nil, nil)
+}
@@ -19,3 +19,19 @@ func Int64ToPtr(v types.Int64) *int {
 	i := int(v.ValueInt64())
 	return &i
 }
+
+// Difference returns the elements of a which are not in b.
+func Difference(a []string, b []string) []string {
+	seen := make(map[string]struct{}, len(b))
+	for _, v := range b {
+		seen[v] = struct{}{}
+	}
+
+	diff := []string{}
+	for _, v := range a {
+		if _, ok := seen[v]; !ok {
+			diff = append(diff, v)
+		}
+	}
+	return diff
+}
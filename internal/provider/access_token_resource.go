@@ -0,0 +1,400 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Scopes granted to an access token
+var tokenScopes = []string{
+	"packages:read",
+	"packages:write",
+	"packages:delete",
+	"repositories:manage",
+	"workspaces:manage",
+	"admin",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AccessTokenResource{}
+var _ resource.ResourceWithImportState = &AccessTokenResource{}
+var _ resource.ResourceWithModifyPlan = &AccessTokenResource{}
+
+func NewAccessTokenResource() resource.Resource {
+	return &AccessTokenResource{}
+}
+
+// AccessTokenResource defines the resource implementation.
+type AccessTokenResource struct {
+	client client.CredentialsAPI
+}
+
+// AccessTokenResourceModel describes the resource data model.
+type AccessTokenResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	Scopes           types.Set    `tfsdk:"scopes"`
+	ExpiresAt        types.String `tfsdk:"expires_at"`
+	RotationTriggers types.Map    `tfsdk:"rotation_triggers"`
+	Token            types.String `tfsdk:"token"`
+	CreatedAt        types.String `tfsdk:"created_at"`
+	LastUsedAt       types.String `tfsdk:"last_used_at"`
+}
+
+func (r *AccessTokenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_access_token"
+}
+
+func (r *AccessTokenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Access token resource. Create an API token, like the credentials of a CI pipeline. Tokens are immutable, any change creates a new token. Change a value of `rotation_triggers` to rotate the token in place: the new token is created before the previous one is revoked, so its consumers can be updated in the same apply. The token value is only known after its creation and is stored in the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the token.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the token, among `packages:read`, `packages:write`, `packages:delete`, `repositories:manage`, `workspaces:manage` and `admin`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(tokenScopes...)),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry date of the token (RFC 3339, e.g. `2026-12-31T00:00:00Z`). The token never expires when unset. Change it to issue a new token once expired.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"rotation_triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values rotating the token when changed, e.g. `{ quarter = \"2026-Q4\" }` or the timestamp of a `time_rotating` resource. The previous token is revoked once the new one is created.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Value of the token. Unset for imported tokens.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation date of the token (RFC 3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_used_at": schema.StringAttribute{
+				MarkdownDescription: "Date of the last request authenticated with the token (RFC 3339).",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Access token identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AccessTokenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AccessTokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AccessTokenResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	at, err := r.client.CreateAccessToken(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create access token, got error: %s", err))
+		return
+	}
+
+	// The value is only returned on creation
+	data.Token = types.StringPointerValue(at.Token)
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, at)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow access token resource", map[string]interface{}{
+		"id":         at.Id,
+		"expires_at": at.ExpiresAt,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessTokenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AccessTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenId := data.Id.ValueString()
+
+	at, err := r.client.GetAccessToken(tokenId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get access token %s, got error: %s", tokenId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, at)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AccessTokenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AccessTokenResourceModel
+
+	// Every other argument requires a new token, only the rotation triggers change in place
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.RotationTriggers.Equal(state.RotationTriggers) {
+		data.LastUsedAt = state.LastUsedAt
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	at, err := r.client.CreateAccessToken(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate access token %s, got error: %s", state.Id.ValueString(), err))
+		return
+	}
+
+	data.Token = types.StringPointerValue(at.Token)
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, at)...)
+
+	// Save the new token before revoking the previous one, so it is never lost
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if err := r.client.DeleteAccessToken(state.Id.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Client Error",
+			fmt.Sprintf("Access token rotated to %s, but unable to revoke the previous token %s, revoke it manually, got error: %s", at.Id, state.Id.ValueString(), err),
+		)
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "rotated a repoflow access token resource", map[string]interface{}{
+		"id":          at.Id,
+		"previous_id": state.Id.ValueString(),
+	})
+}
+
+func (r *AccessTokenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AccessTokenResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tokenId := data.Id.ValueString()
+
+	if err := r.client.DeleteAccessToken(tokenId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke access token, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "revoked a repoflow access token resource", map[string]interface{}{
+		"id": tokenId,
+	})
+}
+
+func (r *AccessTokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *AccessTokenResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var expiresAt types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new token replaces the current one when the triggers change
+	rotating := false
+	if !req.State.Raw.IsNull() {
+		var planned, prior types.Map
+
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("rotation_triggers"), &planned)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("rotation_triggers"), &prior)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if !planned.Equal(prior) {
+			rotating = true
+			for _, name := range []string{"id", "token", "created_at", "last_used_at"} {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+			}
+		}
+	}
+
+	if expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Invalid Expiry Date",
+			fmt.Sprintf("The expiry date must be a RFC 3339 timestamp, got error: %s", err),
+		)
+		return
+	}
+
+	if expiry.After(time.Now()) {
+		return
+	}
+
+	if req.State.Raw.IsNull() || rotating {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Expired Access Token",
+			fmt.Sprintf("The access token would expire on %s, before its creation. Set expires_at in the future.", expiresAt.ValueString()),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("expires_at"),
+		"Expired Access Token",
+		fmt.Sprintf("The access token expired on %s, change expires_at to issue a new one.", expiresAt.ValueString()),
+	)
+}
+
+func (r *AccessTokenResource) buildOptions(ctx context.Context, data *AccessTokenResourceModel) (client.AccessTokenOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := client.AccessTokenOptions{
+		Description: data.Description.ValueString(),
+		ExpiresAt:   data.ExpiresAt.ValueStringPointer(),
+	}
+	diags.Append(data.Scopes.ElementsAs(ctx, &opts.Scopes, false)...)
+
+	return opts, diags
+}
+
+func (r *AccessTokenResource) mapResponseToModel(ctx context.Context, data *AccessTokenResourceModel, at *client.AccessToken) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(at.Id)
+	data.Description = types.StringValue(at.Description)
+
+	scopes, setDiags := types.SetValueFrom(ctx, types.StringType, at.Scopes)
+	diags.Append(setDiags...)
+	data.Scopes = scopes
+
+	// The API may normalize the timestamp, keep the configured value when it is the same instant
+	if at.ExpiresAt == nil || !sameInstant(data.ExpiresAt.ValueString(), *at.ExpiresAt) {
+		data.ExpiresAt = types.StringPointerValue(at.ExpiresAt)
+	}
+	data.CreatedAt = types.StringValue(at.CreatedAt)
+	data.LastUsedAt = types.StringPointerValue(at.LastUsedAt)
+
+	return diags
+}
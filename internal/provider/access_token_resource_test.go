@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func TestAccessTokenResource(t *testing.T) {
+	p := newTestProvider(t)
+
+	config := func(triggers map[string]string) tftypes.Value {
+		return p.config("repoflow_access_token", map[string]tftypes.Value{
+			"description":       str("ci"),
+			"scopes":            stringSet("packages:read", "packages:write"),
+			"rotation_triggers": stringMap(triggers),
+		})
+	}
+
+	state := p.apply("repoflow_access_token", p.nullState("repoflow_access_token"), config(map[string]string{"quarter": "2026-Q3"}))
+
+	id := stringAttr(t, state, "id")
+	if stringAttr(t, state, "token") == "" {
+		t.Error("token not set after creation")
+	}
+	if _, err := p.client.GetAccessToken(id); err != nil {
+		t.Fatalf("token not created: %s", err)
+	}
+
+	// The token is kept on refresh
+	if got := stringAttr(t, p.read("repoflow_access_token", state), "token"); got != stringAttr(t, state, "token") {
+		t.Error("token lost on refresh")
+	}
+
+	// A new trigger value rotates the token in place, revoking the previous one
+	rotated := p.applyInPlace("repoflow_access_token", state, config(map[string]string{"quarter": "2026-Q4"}))
+
+	if got := stringAttr(t, rotated, "id"); got == id || got == "" {
+		t.Errorf("id after rotation = %q, previous %q", got, id)
+	}
+	if got := stringAttr(t, rotated, "token"); got == stringAttr(t, state, "token") || got == "" {
+		t.Error("token not rotated")
+	}
+	if _, err := p.client.GetAccessToken(id); !client.IsNotFound(err) {
+		t.Errorf("previous token not revoked: %v", err)
+	}
+
+	p.destroy("repoflow_access_token", rotated)
+	if _, err := p.client.GetAccessToken(stringAttr(t, rotated, "id")); !client.IsNotFound(err) {
+		t.Errorf("token not revoked: %v", err)
+	}
+}
+
+func TestAccessTokenResourceExpired(t *testing.T) {
+	p := newTestProvider(t)
+
+	_, diags := p.tryApply("repoflow_access_token", p.nullState("repoflow_access_token"), p.config("repoflow_access_token", map[string]tftypes.Value{
+		"description": str("ci"),
+		"scopes":      stringSet("packages:read"),
+		"expires_at":  str(time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)),
+	}))
+	if !hasError(diags) {
+		t.Error("token created with an expiry date in the past")
+	}
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AnonymousAccessResource{}
+var _ resource.ResourceWithImportState = &AnonymousAccessResource{}
+var _ resource.ResourceWithModifyPlan = &AnonymousAccessResource{}
+
+func NewAnonymousAccessResource() resource.Resource {
+	return &AnonymousAccessResource{}
+}
+
+// AnonymousAccessResource defines the resource implementation.
+type AnonymousAccessResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PermissionsAPI
+	}
+}
+
+// AnonymousAccessResourceModel describes the resource data model.
+type AnonymousAccessResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	WorkspaceId  types.String `tfsdk:"workspace"`
+	Repository   types.String `tfsdk:"repository"`
+	Mode         types.String `tfsdk:"mode"`
+	PackageTypes types.Set    `tfsdk:"package_types"`
+}
+
+func (r *AnonymousAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_anonymous_access"
+}
+
+func (r *AnonymousAccessResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Anonymous access resource. Define what the requests without credentials can read in a workspace, or in a repository overriding the setting of its workspace. The anonymous access of a workspace is disabled until set, destroying the resource disables it again, or makes the repository follow its workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository of the workspace (name or Id). The setting applies to the whole workspace when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mode": schema.StringAttribute{
+				MarkdownDescription: "Anonymous access mode, `disabled` or `read_only` (download and search, never upload).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("disabled", "read_only"),
+				},
+			},
+			"package_types": schema.SetAttribute{
+				MarkdownDescription: "Package types readable anonymously in the workspace, e.g. `[\"helm\", \"docker\"]`. Every package type is readable when unset. Only for the `read_only` mode of a workspace.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(packageTypes...)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Anonymous access identifier, in the form `workspaceId` or `workspaceId/repositoryId`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AnonymousAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AnonymousAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AnonymousAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	aa, err := r.client.PutAnonymousAccess(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set anonymous access, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, aa)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow anonymous access resource", map[string]interface{}{
+		"id":   data.Id.ValueString(),
+		"mode": aa.Mode,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnonymousAccessResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AnonymousAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	aa, err := r.client.GetAnonymousAccess(workspaceId, repositoryId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get anonymous access %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, aa)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnonymousAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data AnonymousAccessResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	aa, err := r.client.PutAnonymousAccess(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update anonymous access, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, aa)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AnonymousAccessResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AnonymousAccessResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	if err := r.client.DeleteAnonymousAccess(workspaceId, repositoryId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete anonymous access, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow anonymous access resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *AnonymousAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repository, _ := strings.Cut(req.ID, "/")
+
+	if workspace == "" || strings.Contains(repository, "/") {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace or workspace/repository. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, workspace, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scopeId(workspaceId, repositoryId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	if repository != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	}
+}
+
+func (r *AnonymousAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var repository, mode types.String
+	var packageTypes types.Set
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("repository"), &repository)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("mode"), &mode)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("package_types"), &packageTypes)...)
+
+	if resp.Diagnostics.HasError() || packageTypes.IsNull() {
+		return
+	}
+
+	// A repository only holds a single package type
+	if !repository.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("package_types"),
+			"Invalid parameter",
+			"`package_types` is only supported by the anonymous access of a workspace.",
+		)
+	}
+
+	if mode.ValueString() == "disabled" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("package_types"),
+			"Invalid parameter",
+			"`package_types` is not supported by the disabled mode.",
+		)
+	}
+}
+
+func (r *AnonymousAccessResource) buildOptions(ctx context.Context, data *AnonymousAccessResourceModel) (client.AnonymousAccessOptions, diag.Diagnostics) {
+	// An empty list makes every package type readable
+	packageTypes := []string{}
+	diags := data.PackageTypes.ElementsAs(ctx, &packageTypes, false)
+
+	return client.AnonymousAccessOptions{
+		Mode:         data.Mode.ValueString(),
+		PackageTypes: packageTypes,
+	}, diags
+}
+
+func (r *AnonymousAccessResource) mapResponseToModel(ctx context.Context, data *AnonymousAccessResourceModel, aa *client.AnonymousAccess) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	repositoryId := ""
+	if aa.RepositoryId != nil {
+		repositoryId = *aa.RepositoryId
+	}
+
+	// The workspace and the repository are kept as configured (name or Id)
+	data.Id = types.StringValue(scopeId(aa.WorkspaceId, repositoryId))
+	data.Mode = types.StringValue(aa.Mode)
+
+	// Keep the package types null when every one is readable to avoid a diff with the configuration
+	if len(aa.PackageTypes) > 0 {
+		data.PackageTypes, diags = types.SetValueFrom(ctx, types.StringType, aa.PackageTypes)
+	} else {
+		data.PackageTypes = types.SetNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,321 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArtifactPropertiesResource{}
+var _ resource.ResourceWithImportState = &ArtifactPropertiesResource{}
+
+func NewArtifactPropertiesResource() resource.Resource {
+	return &ArtifactPropertiesResource{}
+}
+
+// ArtifactPropertiesResource defines the resource implementation.
+type ArtifactPropertiesResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+// ArtifactPropertiesResourceModel describes the resource data model.
+type ArtifactPropertiesResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	Path        types.String `tfsdk:"path"`
+	Properties  types.Map    `tfsdk:"properties"`
+}
+
+func (r *ArtifactPropertiesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_artifact_properties"
+}
+
+func (r *ArtifactPropertiesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Artifact properties resource. Attach key/value properties to an existing artifact, e.g. `approved = \"true\"` or `build = \"1234\"` to drive a promotion. The resource owns every property of the artifact, and removes them on destroy while the artifact is kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository holding the artifact (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the artifact in the repository, e.g. `libs/acme-core-1.4.2.jar`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "Properties of the artifact, replacing the existing ones.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Artifact properties identifier, in the form `workspaceId/repositoryId/path`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ArtifactPropertiesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ArtifactPropertiesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArtifactPropertiesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	artifactPath := strings.TrimPrefix(data.Path.ValueString(), "/")
+
+	ap, err := r.client.PutArtifactProperties(workspaceId, repositoryId, artifactPath, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set properties of artifact %s, got error: %s", artifactPath, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, artifactPath}, "/"))
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ap)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow artifact properties resource", map[string]interface{}{
+		"id":         data.Id.ValueString(),
+		"properties": len(ap.Properties),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactPropertiesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArtifactPropertiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.SplitN(data.Id.ValueString(), "/", 3)
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected artifact properties identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	ap, err := r.client.GetArtifactProperties(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get artifact properties %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ap)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactPropertiesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ArtifactPropertiesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.SplitN(data.Id.ValueString(), "/", 3)
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected artifact properties identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ap, err := r.client.PutArtifactProperties(idParts[0], idParts[1], idParts[2], opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update artifact properties %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ap)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactPropertiesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArtifactPropertiesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.SplitN(data.Id.ValueString(), "/", 3)
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected artifact properties identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	// The artifact may be deleted first, like by a repoflow_artifact of the same configuration
+	if err := r.client.DeleteArtifactProperties(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete artifact properties, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow artifact properties resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ArtifactPropertiesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data ArtifactPropertiesResourceModel
+
+	idParts := strings.SplitN(req.ID, "/", 3)
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/path. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	ap, err := r.client.GetArtifactProperties(workspaceId, repositoryId, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import artifact properties %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, idParts[2]}, "/"))
+	data.WorkspaceId = types.StringValue(idParts[0])
+	data.Repository = types.StringValue(idParts[1])
+	data.Path = types.StringValue(idParts[2])
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ap)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactPropertiesResource) buildOptions(ctx context.Context, data *ArtifactPropertiesResourceModel) (client.ArtifactPropertiesOptions, diag.Diagnostics) {
+	opts := client.ArtifactPropertiesOptions{
+		Properties: map[string]string{},
+	}
+
+	diags := data.Properties.ElementsAs(ctx, &opts.Properties, false)
+
+	return opts, diags
+}
+
+func (r *ArtifactPropertiesResource) mapResponseToModel(ctx context.Context, data *ArtifactPropertiesResourceModel, ap *client.ArtifactProperties) diag.Diagnostics {
+	properties := ap.Properties
+	if properties == nil {
+		properties = map[string]string{}
+	}
+
+	mapValue, diags := types.MapValueFrom(ctx, types.StringType, properties)
+	data.Properties = mapValue
+
+	return diags
+}
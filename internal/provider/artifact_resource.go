@@ -0,0 +1,449 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Hex encoded SHA-256 checksums
+var sha256Regexp = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// Files are only downloaded over HTTP
+var artifactUrlRegexp = regexp.MustCompile(`^https?://`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ArtifactResource{}
+var _ resource.ResourceWithModifyPlan = &ArtifactResource{}
+
+func NewArtifactResource() resource.Resource {
+	return &ArtifactResource{}
+}
+
+// ArtifactResource defines the resource implementation.
+type ArtifactResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+// ArtifactResourceModel describes the resource data model.
+type ArtifactResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	WorkspaceId  types.String `tfsdk:"workspace"`
+	Repository   types.String `tfsdk:"repository"`
+	Path         types.String `tfsdk:"path"`
+	Source       types.String `tfsdk:"source"`
+	SourceUrl    types.String `tfsdk:"source_url"`
+	SourceSha256 types.String `tfsdk:"source_sha256"`
+	Sha256       types.String `tfsdk:"sha256"`
+	Sha1         types.String `tfsdk:"sha1"`
+	Md5          types.String `tfsdk:"md5"`
+	SizeBytes    types.Int64  `tfsdk:"size_bytes"`
+}
+
+func (r *ArtifactResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_artifact"
+}
+
+func (r *ArtifactResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Artifact resource. Upload a local file, or a file downloaded from a URL, to a path of a local repository, e.g. to seed an internal CA bundle or an installer tarball. The artifact is uploaded again when its content changes, on either side.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Local repository name or Id.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path of the artifact in the repository, e.g. `certs/internal-ca.pem`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"source": schema.StringAttribute{
+				MarkdownDescription: "Path of the local file to upload. Its checksum is computed on each plan, a new content uploads it again.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("source_url")),
+				},
+			},
+			"source_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the file to upload, downloaded by the provider on apply. Set `source_sha256` to verify it and upload it again when it changes, otherwise the file is only uploaded again when the URL changes.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(artifactUrlRegexp, "must be an http:// or https:// URL"),
+				},
+			},
+			"source_sha256": schema.StringAttribute{
+				MarkdownDescription: "Expected SHA-256 checksum of the file of `source_url` (hex encoded).",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("source")),
+					stringvalidator.RegexMatches(sha256Regexp, "must be a hex encoded SHA-256 checksum"),
+				},
+			},
+			"sha256": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 checksum of the artifact.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha1": schema.StringAttribute{
+				MarkdownDescription: "SHA-1 checksum of the artifact.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"md5": schema.StringAttribute{
+				MarkdownDescription: "MD5 checksum of the artifact.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the artifact in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Artifact identifier, in the form `workspaceId/repositoryId/path`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ArtifactResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ArtifactResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ArtifactResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	content, err := r.openSource(ctx, &data)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Source", err.Error())
+		return
+	}
+	defer content.Close()
+
+	artifact, err := r.client.UploadArtifact(workspaceId, repositoryId, data.Path.ValueString(), content)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload artifact %s on repository %s, got error: %s", data.Path.ValueString(), repositoryId, err))
+		return
+	}
+
+	// The file may have changed between the plan and the apply
+	if !data.Sha256.IsUnknown() && data.Sha256.ValueString() != artifact.Sha256 {
+		resp.Diagnostics.AddError(
+			"Checksum Mismatch",
+			fmt.Sprintf("The uploaded artifact has the SHA-256 checksum %s, %s was planned. The source changed during the apply, run it again.", artifact.Sha256, data.Sha256.ValueString()),
+		)
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, artifact.Path}, "/"))
+	r.mapResponseToModel(&data, artifact)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow artifact resource", map[string]interface{}{
+		"id":     data.Id.ValueString(),
+		"sha256": artifact.Sha256,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ArtifactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The path may hold slashes
+	idParts := strings.SplitN(data.Id.ValueString(), "/", 3)
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected artifact identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	artifact, err := r.client.GetArtifact(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get artifact %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	// A checksum different from the source one uploads it again on the next apply
+	r.mapResponseToModel(&data, artifact)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ArtifactResourceModel
+
+	// A new content replaces the artifact, only keep the planned source
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ArtifactResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ArtifactResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.SplitN(data.Id.ValueString(), "/", 3)
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected artifact identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeleteArtifact(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete artifact, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow artifact resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ArtifactResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan ArtifactResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Checksum of the content to upload, unknown for an unverified URL
+	sha256Sum := types.StringUnknown()
+
+	switch {
+	case plan.Source.IsUnknown() || plan.SourceUrl.IsUnknown() || plan.SourceSha256.IsUnknown():
+	case !plan.Source.IsNull():
+		sum, err := fileSha256(plan.Source.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source"), "Invalid Source", err.Error())
+			return
+		}
+		sha256Sum = types.StringValue(sum)
+	case !plan.SourceSha256.IsNull():
+		sha256Sum = plan.SourceSha256
+	}
+
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sha256"), sha256Sum)...)
+		return
+	}
+
+	var state ArtifactResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case !sha256Sum.IsUnknown():
+		// A new content, or the artifact changed in the repository
+		if sha256Sum.ValueString() != state.Sha256.ValueString() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sha256"), sha256Sum)...)
+			resp.RequiresReplace = append(resp.RequiresReplace, path.Root("sha256"))
+		}
+	case !plan.SourceUrl.IsNull() && !plan.SourceUrl.Equal(state.SourceUrl):
+		// Without checksum, only a new URL is known to change the content
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("source_url"))
+	}
+}
+
+// openSource returns the content to upload, the file of the URL being downloaded
+// to a temporary file first to verify its checksum.
+func (r *ArtifactResource) openSource(ctx context.Context, data *ArtifactResourceModel) (io.ReadSeekCloser, error) {
+	if !data.Source.IsNull() {
+		return os.Open(data.Source.ValueString())
+	}
+
+	sourceUrl := data.SourceUrl.ValueString()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, sourceUrl, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to download %s, got error: %w", sourceUrl, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 400 {
+		return nil, fmt.Errorf("unable to download %s, got status %d", sourceUrl, resp.StatusCode)
+	}
+
+	tmp, err := os.CreateTemp("", "repoflow-artifact-*")
+	if err != nil {
+		return nil, err
+	}
+	f := &tempFile{tmp}
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(f, h), resp.Body); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("unable to download %s, got error: %w", sourceUrl, err)
+	}
+
+	if sum := hex.EncodeToString(h.Sum(nil)); !data.SourceSha256.IsNull() && sum != data.SourceSha256.ValueString() {
+		f.Close()
+		return nil, fmt.Errorf("the file of %s has the SHA-256 checksum %s, %s is expected", sourceUrl, sum, data.SourceSha256.ValueString())
+	}
+
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (r *ArtifactResource) mapResponseToModel(data *ArtifactResourceModel, artifact *client.Artifact) {
+	data.Sha256 = types.StringValue(artifact.Sha256)
+	data.Sha1 = types.StringValue(artifact.Sha1)
+	data.Md5 = types.StringValue(artifact.Md5)
+	data.SizeBytes = types.Int64Value(artifact.SizeBytes)
+}
+
+// tempFile is a temporary file removed once closed.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	err := f.File.Close()
+	if rmErr := os.Remove(f.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+// fileSha256 returns the hex encoded SHA-256 checksum of a file.
+func fileSha256(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
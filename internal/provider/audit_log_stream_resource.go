@@ -0,0 +1,410 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Destination expected by each sink type
+var auditLogDestinations = map[string]struct {
+	regexp *regexp.Regexp
+	format string
+}{
+	"s3":     {regexp.MustCompile(`^s3://[^/]+`), "s3://<bucket>[/<prefix>]"},
+	"syslog": {regexp.MustCompile(`^(tcp|udp|tls)://[^/:]+:[0-9]+$`), "tcp://, udp:// or tls://<host>:<port>"},
+	"https":  {regexp.MustCompile(`^https://`), "https://<collector URL>"},
+}
+
+// Categories of the audit events
+var auditLogCategories = []string{"auth", "workspace", "repository", "package", "permission", "settings"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AuditLogStreamResource{}
+var _ resource.ResourceWithImportState = &AuditLogStreamResource{}
+var _ resource.ResourceWithModifyPlan = &AuditLogStreamResource{}
+
+func NewAuditLogStreamResource() resource.Resource {
+	return &AuditLogStreamResource{}
+}
+
+// AuditLogStreamResource defines the resource implementation.
+type AuditLogStreamResource struct {
+	client client.InstanceAPI
+}
+
+// AuditLogStreamResourceModel describes the resource data model.
+type AuditLogStreamResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	SinkType        types.String `tfsdk:"sink_type"`
+	Destination     types.String `tfsdk:"destination"`
+	Region          types.String `tfsdk:"region"`
+	Format          types.String `tfsdk:"format"`
+	Categories      types.Set    `tfsdk:"categories"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	SecretWo        types.String `tfsdk:"secret_wo"`
+	SecretWoVersion types.Int64  `tfsdk:"secret_wo_version"`
+	Status          types.String `tfsdk:"status"`
+	LastDeliveredAt types.String `tfsdk:"last_delivered_at"`
+}
+
+func (r *AuditLogStreamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_log_stream"
+}
+
+func (r *AuditLogStreamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Audit log stream resource. Send the audit events of the instance to an external sink as they happen: an S3 bucket, a syslog endpoint or an HTTPS collector like a SIEM.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the stream, unique on the instance.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"sink_type": schema.StringAttribute{
+				MarkdownDescription: "Type of sink, one of `s3`, `syslog` or `https`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("s3", "syslog", "https"),
+				},
+			},
+			"destination": schema.StringAttribute{
+				MarkdownDescription: "Where the events are sent: `s3://<bucket>[/<prefix>]` for `s3`, `tcp://`, `udp://` or `tls://<host>:<port>` for `syslog`, the URL of the collector for `https`.",
+				Required:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region of the S3 bucket (only for the `s3` sink type).",
+				Optional:            true,
+			},
+			"format": schema.StringAttribute{
+				MarkdownDescription: "Format of the events, one of `json`, `cef` or `leef` (default `json`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("json"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("json", "cef", "leef"),
+				},
+			},
+			"categories": schema.SetAttribute{
+				MarkdownDescription: "Categories of the events sent, among `auth`, `workspace`, `repository`, `package`, `permission` and `settings`. Every event is sent when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(auditLogCategories...)),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the events are sent (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Secret authenticating to the sink, never stored in the state: the bearer token of an HTTPS collector, or `<access key id>:<secret access key>` of an S3 bucket (the instance role is used when unset).",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("secret_wo_version")),
+				},
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the secret. Change it to send a new `secret_wo`.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Delivery status: `active`, `failing` or `disabled`.",
+				Computed:            true,
+			},
+			"last_delivered_at": schema.StringAttribute{
+				MarkdownDescription: "Date of the last event delivered (RFC 3339).",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Audit log stream identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AuditLogStreamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *AuditLogStreamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data AuditLogStreamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	secret, diags := r.readSecret(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts.Secret = secret
+
+	s, err := r.client.CreateAuditLogStream(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create audit log stream, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, s)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow audit log stream resource", map[string]interface{}{
+		"id":        s.Id,
+		"sink_type": s.SinkType,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditLogStreamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data AuditLogStreamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	streamId := data.Id.ValueString()
+
+	s, err := r.client.GetAuditLogStream(streamId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get audit log stream %s, got error: %s", streamId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, s)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditLogStreamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state AuditLogStreamResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the secret when its version changed
+	if !data.SecretWoVersion.Equal(state.SecretWoVersion) {
+		secret, diags := r.readSecret(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.Secret = secret
+	}
+
+	s, err := r.client.UpdateAuditLogStream(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update audit log stream, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, s)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditLogStreamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data AuditLogStreamResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	streamId := data.Id.ValueString()
+
+	if err := r.client.DeleteAuditLogStream(streamId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete audit log stream, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow audit log stream resource", map[string]interface{}{
+		"id": streamId,
+	})
+}
+
+func (r *AuditLogStreamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *AuditLogStreamResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var sinkType, destination, region types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("sink_type"), &sinkType)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("destination"), &destination)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("region"), &region)...)
+
+	if resp.Diagnostics.HasError() || sinkType.IsUnknown() {
+		return
+	}
+
+	expected, ok := auditLogDestinations[sinkType.ValueString()]
+	if !ok {
+		return
+	}
+
+	if !destination.IsUnknown() && !expected.regexp.MatchString(destination.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("destination"),
+			"Invalid parameter",
+			fmt.Sprintf("The destination of the %s sink type must be in the form %s.", sinkType.ValueString(), expected.format),
+		)
+	}
+
+	if !region.IsNull() && sinkType.ValueString() != "s3" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Invalid parameter",
+			fmt.Sprintf("`region` is not supported by the %s sink type.", sinkType.ValueString()),
+		)
+	}
+}
+
+func (r *AuditLogStreamResource) buildOptions(ctx context.Context, data *AuditLogStreamResourceModel) (client.AuditLogStreamOptions, diag.Diagnostics) {
+	// An empty list sends every event
+	categories := []string{}
+	diags := data.Categories.ElementsAs(ctx, &categories, false)
+
+	return client.AuditLogStreamOptions{
+		Name:        data.Name.ValueString(),
+		SinkType:    data.SinkType.ValueString(),
+		Destination: data.Destination.ValueString(),
+		Region:      data.Region.ValueStringPointer(),
+		Format:      data.Format.ValueString(),
+		Categories:  categories,
+		IsEnabled:   data.Enabled.ValueBool(),
+	}, diags
+}
+
+// readSecret returns the secret of the sink, the write-only value being only
+// available in the configuration.
+func (r *AuditLogStreamResource) readSecret(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var secretWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("secret_wo"), &secretWo)
+
+	return secretWo.ValueStringPointer(), diags
+}
+
+func (r *AuditLogStreamResource) mapResponseToModel(ctx context.Context, data *AuditLogStreamResourceModel, s *client.AuditLogStream) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(s.Id)
+	data.Name = types.StringValue(s.Name)
+	data.SinkType = types.StringValue(s.SinkType)
+	data.Destination = types.StringValue(s.Destination)
+	data.Region = types.StringPointerValue(s.Region)
+	data.Format = types.StringValue(s.Format)
+	data.Enabled = types.BoolValue(s.IsEnabled)
+	data.Status = types.StringValue(s.Status)
+	data.LastDeliveredAt = types.StringPointerValue(s.LastDeliveredAt)
+	// Write-only values are never stored
+	data.SecretWo = types.StringNull()
+
+	// Keep the categories null when every event is sent to avoid a diff with the configuration
+	if len(s.Categories) > 0 {
+		data.Categories, diags = types.SetValueFrom(ctx, types.StringType, s.Categories)
+	} else {
+		data.Categories = types.SetNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,322 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BannerResource{}
+var _ resource.ResourceWithImportState = &BannerResource{}
+var _ resource.ResourceWithModifyPlan = &BannerResource{}
+
+func NewBannerResource() resource.Resource {
+	return &BannerResource{}
+}
+
+// BannerResource defines the resource implementation.
+type BannerResource struct {
+	client client.InstanceAPI
+}
+
+// BannerResourceModel describes the resource data model.
+type BannerResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Title            types.String `tfsdk:"title"`
+	Message          types.String `tfsdk:"message"`
+	Severity         types.String `tfsdk:"severity"`
+	StartsAt         types.String `tfsdk:"starts_at"`
+	EndsAt           types.String `tfsdk:"ends_at"`
+	ShowOnStatusPage types.Bool   `tfsdk:"show_on_status_page"`
+	Status           types.String `tfsdk:"status"`
+}
+
+func (r *BannerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_banner"
+}
+
+func (r *BannerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Banner resource. Publish a planned maintenance notice on the instance banner and status endpoint, so the change window is announced with the change itself.",
+
+		Attributes: map[string]schema.Attribute{
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the notice.",
+				Required:            true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Content of the notice, Markdown is supported.",
+				Required:            true,
+			},
+			"severity": schema.StringAttribute{
+				MarkdownDescription: "Severity of the notice, one of `info`, `warning` or `critical` (default `info`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("info"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("info", "warning", "critical"),
+				},
+			},
+			"starts_at": schema.StringAttribute{
+				MarkdownDescription: "Start of the maintenance window (RFC 3339, e.g. `2026-11-07T22:00:00Z`). The banner is displayed before it as an upcoming maintenance.",
+				Required:            true,
+			},
+			"ends_at": schema.StringAttribute{
+				MarkdownDescription: "End of the maintenance window (RFC 3339). The banner is hidden after it.",
+				Required:            true,
+			},
+			"show_on_status_page": schema.BoolAttribute{
+				MarkdownDescription: "Also publish the notice on the status endpoint (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the notice: `scheduled`, `active` or `ended`.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Banner identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *BannerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BannerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, err := r.client.CreateBanner(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create banner, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, b)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow banner resource", map[string]interface{}{
+		"id":        b.Id,
+		"starts_at": b.StartsAt,
+		"ends_at":   b.EndsAt,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BannerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bannerId := data.Id.ValueString()
+
+	b, err := r.client.GetBanner(bannerId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get banner %s, got error: %s", bannerId, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, b)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BannerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	b, err := r.client.UpdateBanner(data.Id.ValueString(), r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update banner, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, b)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BannerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BannerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bannerId := data.Id.ValueString()
+
+	if err := r.client.DeleteBanner(bannerId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete banner, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow banner resource", map[string]interface{}{
+		"id": bannerId,
+	})
+}
+
+func (r *BannerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *BannerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var startsAt, endsAt types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("starts_at"), &startsAt)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("ends_at"), &endsAt)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	start, startOk := r.parseDate(startsAt, path.Root("starts_at"), resp)
+	end, endOk := r.parseDate(endsAt, path.Root("ends_at"), resp)
+
+	if !startOk || !endOk {
+		return
+	}
+
+	if !end.After(start) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ends_at"),
+			"Invalid Maintenance Window",
+			fmt.Sprintf("The maintenance window ends on %s, before it starts on %s.", endsAt.ValueString(), startsAt.ValueString()),
+		)
+		return
+	}
+
+	if !end.After(time.Now()) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("ends_at"),
+			"Ended Maintenance Window",
+			fmt.Sprintf("The maintenance window ended on %s, the banner is no longer displayed.", endsAt.ValueString()),
+		)
+	}
+}
+
+// parseDate parses a known RFC 3339 date of the plan, reporting the invalid ones.
+func (r *BannerResource) parseDate(value types.String, attrPath path.Path, resp *resource.ModifyPlanResponse) (time.Time, bool) {
+	if value.IsNull() || value.IsUnknown() {
+		return time.Time{}, false
+	}
+
+	date, err := time.Parse(time.RFC3339, value.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			attrPath,
+			"Invalid Date",
+			fmt.Sprintf("The date must be a RFC 3339 timestamp, got error: %s", err),
+		)
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+func (r *BannerResource) buildOptions(data *BannerResourceModel) client.BannerOptions {
+	return client.BannerOptions{
+		Title:            data.Title.ValueString(),
+		Message:          data.Message.ValueString(),
+		Severity:         data.Severity.ValueString(),
+		StartsAt:         data.StartsAt.ValueString(),
+		EndsAt:           data.EndsAt.ValueString(),
+		ShowOnStatusPage: data.ShowOnStatusPage.ValueBool(),
+	}
+}
+
+func (r *BannerResource) mapResponseToModel(data *BannerResourceModel, b *client.Banner) {
+	data.Id = types.StringValue(b.Id)
+	data.Title = types.StringValue(b.Title)
+	data.Message = types.StringValue(b.Message)
+	data.Severity = types.StringValue(b.Severity)
+	// The API may normalize the timestamps, keep the configured values when they are the same instant
+	if !sameInstant(data.StartsAt.ValueString(), b.StartsAt) {
+		data.StartsAt = types.StringValue(b.StartsAt)
+	}
+	if !sameInstant(data.EndsAt.ValueString(), b.EndsAt) {
+		data.EndsAt = types.StringValue(b.EndsAt)
+	}
+	data.ShowOnStatusPage = types.BoolValue(b.ShowOnStatusPage)
+	data.Status = types.StringValue(b.Status)
+}
@@ -0,0 +1,481 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The build name and number are part of the API path and of the state identifier
+var buildKeyRegexp = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &BuildInfoResource{}
+var _ resource.ResourceWithImportState = &BuildInfoResource{}
+var _ resource.ResourceWithModifyPlan = &BuildInfoResource{}
+
+func NewBuildInfoResource() resource.Resource {
+	return &BuildInfoResource{}
+}
+
+// BuildInfoResource defines the resource implementation.
+type BuildInfoResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.PackagesAPI
+	}
+}
+
+// BuildInfoResourceModel describes the resource data model.
+type BuildInfoResourceModel struct {
+	Id          types.String   `tfsdk:"id"`
+	WorkspaceId types.String   `tfsdk:"workspace"`
+	Name        types.String   `tfsdk:"name"`
+	Number      types.String   `tfsdk:"number"`
+	StartedAt   types.String   `tfsdk:"started_at"`
+	Url         types.String   `tfsdk:"url"`
+	Modules     types.List     `tfsdk:"modules"`
+	Vcs         *BuildVcsModel `tfsdk:"vcs"`
+}
+
+// BuildVcsModel describes the vcs block data model.
+type BuildVcsModel struct {
+	Url      types.String `tfsdk:"url"`
+	Revision types.String `tfsdk:"revision"`
+	Branch   types.String `tfsdk:"branch"`
+}
+
+// BuildModuleModel describes a module of the modules list data model.
+type BuildModuleModel struct {
+	Id            types.String `tfsdk:"id"`
+	RepositoryId  types.String `tfsdk:"repository_id"`
+	ArtifactPaths types.Set    `tfsdk:"artifact_paths"`
+}
+
+var buildModuleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"id":             types.StringType,
+		"repository_id":  types.StringType,
+		"artifact_paths": types.SetType{ElemType: types.StringType},
+	},
+}
+
+func (r *BuildInfoResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_build_info"
+}
+
+func (r *BuildInfoResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Build info resource. Publish the metadata of a CI build, its modules with the artifacts they uploaded and the sources they were built from, to trace a deployed artifact back to its CI run. Destroying the resource deletes the metadata, the artifacts are kept.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the build (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the build, usually the CI job, e.g. `acme-core`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(buildKeyRegexp, "must only contain letters, digits, dots, underscores and dashes"),
+				},
+			},
+			"number": schema.StringAttribute{
+				MarkdownDescription: "Number of the build, usually the CI run number, e.g. `1234`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(buildKeyRegexp, "must only contain letters, digits, dots, underscores and dashes"),
+				},
+			},
+			"started_at": schema.StringAttribute{
+				MarkdownDescription: "Start date of the build (RFC 3339, e.g. `2026-10-16T09:30:00Z`).",
+				Optional:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the CI run, e.g. the GitHub Actions run page.",
+				Optional:            true,
+			},
+			"modules": schema.ListNestedAttribute{
+				MarkdownDescription: "Modules of the build with the artifacts they uploaded.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Identifier of the module, e.g. `com.acme:acme-core:1.4.2`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"repository_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the repository holding the artifacts of the module.",
+							Required:            true,
+						},
+						"artifact_paths": schema.SetAttribute{
+							MarkdownDescription: "Paths of the artifacts of the module in the repository, e.g. `libs/acme-core-1.4.2.jar`.",
+							Required:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Build info identifier, in the form `workspaceId/name/number`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"vcs": schema.SingleNestedBlock{
+				MarkdownDescription: "Sources of the build.",
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL of the source repository.",
+						Optional:            true,
+					},
+					"revision": schema.StringAttribute{
+						MarkdownDescription: "Commit built (required in the vcs block).",
+						Optional:            true,
+					},
+					"branch": schema.StringAttribute{
+						MarkdownDescription: "Branch built.",
+						Optional:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *BuildInfoResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *BuildInfoResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BuildInfoResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	name := data.Name.ValueString()
+	number := data.Number.ValueString()
+
+	bi, err := r.client.PutBuildInfo(ws.Id, name, number, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to publish build %s/%s on workspaceId %s, got error: %s", name, number, ws.Id, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{ws.Id, name, number}, "/"))
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, bi)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow build info resource", map[string]interface{}{
+		"id":      data.Id.ValueString(),
+		"modules": len(bi.Modules),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildInfoResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BuildInfoResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected build info identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	bi, err := r.client.GetBuildInfo(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get build info %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, bi)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildInfoResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BuildInfoResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected build info identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bi, err := r.client.PutBuildInfo(idParts[0], idParts[1], idParts[2], opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update build info %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, bi)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildInfoResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data BuildInfoResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected build info identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeleteBuildInfo(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete build info, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow build info resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *BuildInfoResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data BuildInfoResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/name/number. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	bi, err := r.client.GetBuildInfo(ws.Id, idParts[1], idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import build info %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{ws.Id, idParts[1], idParts[2]}, "/"))
+	data.WorkspaceId = types.StringValue(workspace)
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, bi)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BuildInfoResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var vcs *BuildVcsModel
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("vcs"), &vcs)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if vcs != nil && vcs.Revision.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("vcs").AtName("revision"),
+			"Missing parameter",
+			"`vcs.revision` is required in the vcs block.",
+		)
+	}
+}
+
+func (r *BuildInfoResource) buildOptions(ctx context.Context, data *BuildInfoResourceModel) (client.BuildInfoOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []BuildModuleModel
+
+	diags.Append(data.Modules.ElementsAs(ctx, &models, false)...)
+
+	opts := client.BuildInfoOptions{
+		StartedAt: data.StartedAt.ValueStringPointer(),
+		Url:       data.Url.ValueStringPointer(),
+		Modules:   make([]client.BuildModule, 0, len(models)),
+	}
+
+	if data.Vcs != nil {
+		opts.Vcs = &client.BuildVcs{
+			Url:      data.Vcs.Url.ValueStringPointer(),
+			Revision: data.Vcs.Revision.ValueString(),
+			Branch:   data.Vcs.Branch.ValueStringPointer(),
+		}
+	}
+
+	for _, m := range models {
+		module := client.BuildModule{
+			Id:           m.Id.ValueString(),
+			RepositoryId: m.RepositoryId.ValueString(),
+		}
+		diags.Append(m.ArtifactPaths.ElementsAs(ctx, &module.ArtifactPaths, false)...)
+		opts.Modules = append(opts.Modules, module)
+	}
+
+	return opts, diags
+}
+
+func (r *BuildInfoResource) mapResponseToModel(ctx context.Context, data *BuildInfoResourceModel, bi *client.BuildInfo) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The workspace is kept as configured (name or Id)
+	data.Name = types.StringValue(bi.Name)
+	data.Number = types.StringValue(bi.Number)
+	data.Url = types.StringPointerValue(bi.Url)
+	// The API may normalize the timestamp, keep the configured value when it is the same instant
+	if bi.StartedAt == nil || !sameInstant(data.StartedAt.ValueString(), *bi.StartedAt) {
+		data.StartedAt = types.StringPointerValue(bi.StartedAt)
+	}
+
+	data.Vcs = nil
+	if bi.Vcs != nil {
+		data.Vcs = &BuildVcsModel{
+			Url:      types.StringPointerValue(bi.Vcs.Url),
+			Revision: types.StringValue(bi.Vcs.Revision),
+			Branch:   types.StringPointerValue(bi.Vcs.Branch),
+		}
+	}
+
+	// Keep the modules null when empty to avoid a diff with the configuration
+	if len(bi.Modules) == 0 {
+		data.Modules = types.ListNull(buildModuleType)
+		return diags
+	}
+
+	models := make([]BuildModuleModel, 0, len(bi.Modules))
+	for _, module := range bi.Modules {
+		paths, d := types.SetValueFrom(ctx, types.StringType, module.ArtifactPaths)
+		diags.Append(d...)
+
+		models = append(models, BuildModuleModel{
+			Id:            types.StringValue(module.Id),
+			RepositoryId:  types.StringValue(module.RepositoryId),
+			ArtifactPaths: paths,
+		})
+	}
+
+	modules, d := types.ListValueFrom(ctx, buildModuleType, models)
+	diags.Append(d...)
+	data.Modules = modules
+
+	return diags
+}
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+// A cron expression with the five minute, hour, day of month, month and day of week fields
+var cronRegexp = regexp.MustCompile(`^\S+( \S+){4}$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CleanupPolicyResource{}
+var _ resource.ResourceWithImportState = &CleanupPolicyResource{}
+
+func NewCleanupPolicyResource() resource.Resource {
+	return &CleanupPolicyResource{}
+}
+
+// CleanupPolicyResource defines the resource implementation.
+type CleanupPolicyResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.PoliciesAPI
+	}
+}
+
+// CleanupPolicyResourceModel describes the resource data model.
+type CleanupPolicyResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	WorkspaceId   types.String `tfsdk:"workspace"`
+	Name          types.String `tfsdk:"name"`
+	RepositoryIds types.Set    `tfsdk:"repository_ids"`
+	Patterns      types.Set    `tfsdk:"patterns"`
+	KeepLast      types.Int64  `tfsdk:"keep_last"`
+	MaxAgeDays    types.Int64  `tfsdk:"max_age_days"`
+	Schedule      types.String `tfsdk:"schedule"`
+	DryRun        types.Bool   `tfsdk:"dry_run"`
+	PolicyId      types.String `tfsdk:"policy_id"`
+}
+
+func (r *CleanupPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cleanup_policy"
+}
+
+func (r *CleanupPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Cleanup policy resource. Delete on a schedule the old artifacts uploaded to local repositories of a workspace. An artifact is deleted when it matches a pattern, is not one of the `keep_last` newest versions of its package and is older than `max_age_days`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the policy (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the policy.",
+				Required:            true,
+			},
+			"repository_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the local repositories to clean up.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"patterns": schema.SetAttribute{
+				MarkdownDescription: "Glob patterns of the package paths to clean up, e.g. `com/example/**` or `**/*-SNAPSHOT*`. Use `**` for every package.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"keep_last": schema.Int64Attribute{
+				MarkdownDescription: "Number of newest versions of each package always kept.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+					int64validator.AtLeastOneOf(path.MatchRoot("max_age_days")),
+				},
+			},
+			"max_age_days": schema.Int64Attribute{
+				MarkdownDescription: "Only delete the artifacts uploaded more than this number of days ago.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression of the runs (UTC), default to `0 3 * * *` (every day at 3am).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0 3 * * *"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronRegexp, "must be a cron expression with five fields"),
+				},
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "Only report the artifacts that would be deleted in the run logs, to review a new policy before enabling it.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "Cleanup policy Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Cleanup policy state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CleanupPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CleanupPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CleanupPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cp, err := r.client.CreateCleanupPolicy(ws.Id, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create cleanup policy on workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp, ws.Id)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow cleanup policy resource", map[string]interface{}{
+		"id":      data.Id.ValueString(),
+		"dry_run": cp.DryRun,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CleanupPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CleanupPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	policyId := data.PolicyId.ValueString()
+
+	cp, err := r.client.GetCleanupPolicy(workspaceId, policyId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to get cleanup policy %s on workspaceId %s, got error: %s", policyId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp, workspaceId)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CleanupPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CleanupPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	policyId := data.PolicyId.ValueString()
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cp, err := r.client.UpdateCleanupPolicy(workspaceId, policyId, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to update cleanup policy %s on workspaceId %s, got error: %s", policyId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp, workspaceId)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "updated a repoflow cleanup policy resource", map[string]interface{}{
+		"id":      data.Id.ValueString(),
+		"dry_run": cp.DryRun,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CleanupPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CleanupPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	policyId := data.PolicyId.ValueString()
+
+	if err := r.client.DeleteCleanupPolicy(workspaceId, policyId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete cleanup policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow cleanup policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *CleanupPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data CleanupPolicyResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/policyId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	policyId := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	cp, err := r.client.GetCleanupPolicy(ws.Id, policyId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import cleanup policy %s on workspaceId %s, got error: %s", policyId, ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp, ws.Id)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CleanupPolicyResource) buildOptions(ctx context.Context, data *CleanupPolicyResourceModel) (client.CleanupPolicyOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := client.CleanupPolicyOptions{
+		Name:       data.Name.ValueString(),
+		KeepLast:   factory.Int64ToPtr(data.KeepLast),
+		MaxAgeDays: factory.Int64ToPtr(data.MaxAgeDays),
+		Schedule:   data.Schedule.ValueString(),
+		DryRun:     data.DryRun.ValueBool(),
+	}
+	diags.Append(data.RepositoryIds.ElementsAs(ctx, &opts.RepositoryIds, false)...)
+	diags.Append(data.Patterns.ElementsAs(ctx, &opts.Patterns, false)...)
+
+	return opts, diags
+}
+
+func (r *CleanupPolicyResource) mapResponseToModel(ctx context.Context, data *CleanupPolicyResourceModel, cp *client.CleanupPolicy, workspaceId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// We save the state id with workspaceId/policyId
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, cp.Id}, "/"))
+	data.PolicyId = types.StringValue(cp.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.Name = types.StringValue(cp.Name)
+	data.KeepLast = types.Int64PointerValue(factory.IntPtrToInt64Ptr(cp.KeepLast))
+	data.MaxAgeDays = types.Int64PointerValue(factory.IntPtrToInt64Ptr(cp.MaxAgeDays))
+	data.Schedule = types.StringValue(cp.Schedule)
+	data.DryRun = types.BoolValue(cp.DryRun)
+
+	repositoryIds, setDiags := types.SetValueFrom(ctx, types.StringType, cp.RepositoryIds)
+	diags.Append(setDiags...)
+	data.RepositoryIds = repositoryIds
+
+	patterns, setDiags := types.SetValueFrom(ctx, types.StringType, cp.Patterns)
+	diags.Append(setDiags...)
+	data.Patterns = patterns
+
+	return diags
+}
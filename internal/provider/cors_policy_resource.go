@@ -0,0 +1,361 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// An origin is a scheme and a host, with an optional port and without path
+var corsOriginRegexp = regexp.MustCompile(`^(\*|https?://[^/]+)$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CorsPolicyResource{}
+var _ resource.ResourceWithImportState = &CorsPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &CorsPolicyResource{}
+
+func NewCorsPolicyResource() resource.Resource {
+	return &CorsPolicyResource{}
+}
+
+// CorsPolicyResource defines the resource implementation.
+type CorsPolicyResource struct {
+	client client.PoliciesAPI
+}
+
+// CorsPolicyResourceModel describes the resource data model.
+type CorsPolicyResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Target           types.String `tfsdk:"target"`
+	AllowedOrigins   types.Set    `tfsdk:"allowed_origins"`
+	AllowedMethods   types.Set    `tfsdk:"allowed_methods"`
+	AllowedHeaders   types.Set    `tfsdk:"allowed_headers"`
+	ExposedHeaders   types.Set    `tfsdk:"exposed_headers"`
+	AllowCredentials types.Bool   `tfsdk:"allow_credentials"`
+	MaxAgeSeconds    types.Int64  `tfsdk:"max_age_seconds"`
+}
+
+func (r *CorsPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cors_policy"
+}
+
+func (r *CorsPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "CORS policy resource. Define the cross-origin requests accepted by the API or the registry endpoints of the instance, so browser applications can call them directly. There is one policy per target, destroying the resource refuses the cross-origin requests again.",
+
+		Attributes: map[string]schema.Attribute{
+			"target": schema.StringAttribute{
+				MarkdownDescription: "Endpoints covered by the policy: `api` for the REST API, `registry` for the package registry endpoints.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.CorsTargetApi, client.CorsTargetRegistry),
+				},
+			},
+			"allowed_origins": schema.SetAttribute{
+				MarkdownDescription: "Origins of the accepted requests, e.g. `https://portal.example.com`, or `*` for any origin.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(corsOriginRegexp, "must be * or an http:// or https:// origin without path")),
+				},
+			},
+			"allowed_methods": schema.SetAttribute{
+				MarkdownDescription: "HTTP methods of the accepted requests, default to `GET` and `HEAD`.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("GET"),
+					types.StringValue("HEAD"),
+				})),
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("GET", "HEAD", "POST", "PUT", "PATCH", "DELETE")),
+				},
+			},
+			"allowed_headers": schema.SetAttribute{
+				MarkdownDescription: "Request headers accepted on top of the CORS-safelisted ones, e.g. `Authorization`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"exposed_headers": schema.SetAttribute{
+				MarkdownDescription: "Response headers readable by the browser applications on top of the CORS-safelisted ones, e.g. `ETag`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"allow_credentials": schema.BoolAttribute{
+				MarkdownDescription: "Whether the requests can send the cookies and the `Authorization` header of the user (default `false`). Not supported with the `*` origin.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"max_age_seconds": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds the browsers cache the preflight responses, up to a day (default `600`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(600),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 86400),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "CORS policy identifier, the target",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CorsPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CorsPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CorsPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := data.Target.ValueString()
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cp, err := r.client.PutCorsPolicy(target, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the CORS policy of the %s endpoints, got error: %s", target, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow CORS policy resource", map[string]interface{}{
+		"target": cp.Target,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CorsPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CorsPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := data.Id.ValueString()
+
+	cp, err := r.client.GetCorsPolicy(target)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get the CORS policy of the %s endpoints, got error: %s", target, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CorsPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CorsPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := data.Id.ValueString()
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cp, err := r.client.PutCorsPolicy(target, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the CORS policy of the %s endpoints, got error: %s", target, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, cp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CorsPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CorsPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	target := data.Id.ValueString()
+
+	if err := r.client.DeleteCorsPolicy(target); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete the CORS policy of the %s endpoints, got error: %s", target, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow CORS policy resource", map[string]interface{}{
+		"target": target,
+	})
+}
+
+func (r *CorsPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target"), req.ID)...)
+}
+
+func (r *CorsPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data CorsPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.AllowedOrigins.IsUnknown() || !data.AllowCredentials.ValueBool() {
+		return
+	}
+
+	// The browsers refuse the credentials with any origin
+	var origins []types.String
+	resp.Diagnostics.Append(data.AllowedOrigins.ElementsAs(ctx, &origins, false)...)
+
+	for _, origin := range origins {
+		if origin.ValueString() == "*" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("allow_credentials"),
+				"Invalid parameter",
+				"`allow_credentials` is not supported with the `*` origin, list the origins instead.",
+			)
+			return
+		}
+	}
+}
+
+func (r *CorsPolicyResource) buildOptions(ctx context.Context, data *CorsPolicyResourceModel) (client.CorsPolicyOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list adds no header
+	opts := client.CorsPolicyOptions{
+		AllowedHeaders:   []string{},
+		ExposedHeaders:   []string{},
+		AllowCredentials: data.AllowCredentials.ValueBool(),
+		MaxAgeSeconds:    int(data.MaxAgeSeconds.ValueInt64()),
+	}
+	diags.Append(data.AllowedOrigins.ElementsAs(ctx, &opts.AllowedOrigins, false)...)
+	diags.Append(data.AllowedMethods.ElementsAs(ctx, &opts.AllowedMethods, false)...)
+	diags.Append(data.AllowedHeaders.ElementsAs(ctx, &opts.AllowedHeaders, false)...)
+	diags.Append(data.ExposedHeaders.ElementsAs(ctx, &opts.ExposedHeaders, false)...)
+
+	return opts, diags
+}
+
+func (r *CorsPolicyResource) mapResponseToModel(ctx context.Context, data *CorsPolicyResourceModel, cp *client.CorsPolicy) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(cp.Target)
+	data.Target = types.StringValue(cp.Target)
+	data.AllowCredentials = types.BoolValue(cp.AllowCredentials)
+	data.MaxAgeSeconds = types.Int64Value(int64(cp.MaxAgeSeconds))
+
+	data.AllowedOrigins, d = types.SetValueFrom(ctx, types.StringType, cp.AllowedOrigins)
+	diags.Append(d...)
+	data.AllowedMethods, d = types.SetValueFrom(ctx, types.StringType, cp.AllowedMethods)
+	diags.Append(d...)
+
+	// Keep the lists null when empty to avoid a diff with the configuration
+	data.AllowedHeaders, d = stringSetOrNull(ctx, cp.AllowedHeaders)
+	diags.Append(d...)
+	data.ExposedHeaders, d = stringSetOrNull(ctx, cp.ExposedHeaders)
+	diags.Append(d...)
+
+	return diags
+}
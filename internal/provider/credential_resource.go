@@ -0,0 +1,388 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CredentialResource{}
+var _ resource.ResourceWithImportState = &CredentialResource{}
+
+func NewCredentialResource() resource.Resource {
+	return &CredentialResource{}
+}
+
+// CredentialResource defines the resource implementation.
+type CredentialResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.CredentialsAPI
+	}
+}
+
+// CredentialResourceModel describes the resource data model.
+type CredentialResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	WorkspaceId     types.String `tfsdk:"workspace"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	Username        types.String `tfsdk:"username"`
+	Password        types.String `tfsdk:"password"`
+	PasswordWo      types.String `tfsdk:"password_wo"`
+	TokenWo         types.String `tfsdk:"token_wo"`
+	RotationVersion types.Int64  `tfsdk:"rotation_version"`
+	RotatedAt       types.String `tfsdk:"rotated_at"`
+	CredentialId    types.String `tfsdk:"credential_id"`
+}
+
+func (r *CredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_credential"
+}
+
+func (r *CredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Credential resource. Store a named credential set in a workspace, used by its remote repositories through `credential_id`, so rotating one credential updates every proxy using it. The secrets are write-only, change `rotation_version` to send new ones.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the credential (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the credential, like the owner of the account.",
+				Optional:            true,
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username sent to the remote repositories.",
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password sent to the remote repositories.",
+				DeprecationMessage:  "Use `password_wo` instead to keep the password out of the state.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("password_wo")),
+				},
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password sent to the remote repositories, never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"token_wo": schema.StringAttribute{
+				MarkdownDescription: "Token sent to the remote repositories, like an API key, never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"rotation_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the secrets. Change it to send a new `password_wo` or `token_wo`, every remote repository using the credential gets them.",
+				Optional:            true,
+			},
+			"rotated_at": schema.StringAttribute{
+				MarkdownDescription: "Date of the last change of the secrets (RFC 3339).",
+				Computed:            true,
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "Credential Id, to set in the `credential_id` of the remote repositories.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Credential state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	password, token, diags := r.readSecrets(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cr, err := r.client.CreateCredential(ws.Id, client.CredentialOptions{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		Username:    data.Username.ValueStringPointer(),
+		Password:    password,
+		Token:       token,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create credential on workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cr, ws.Id)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow credential resource", map[string]interface{}{
+		"id":        cr.Id,
+		"workspace": ws.Id,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	credentialId := data.CredentialId.ValueString()
+
+	cr, err := r.client.GetCredential(workspaceId, credentialId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to get credential %s on workspaceId %s, got error: %s", credentialId, workspaceId, err,
+		))
+		return
+	}
+
+	r.mapResponseToModel(&data, cr, workspaceId)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	credentialId := data.CredentialId.ValueString()
+
+	password, token, diags := r.readSecrets(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cr, err := r.client.UpdateCredential(workspaceId, credentialId, client.CredentialUpdateOptions{
+		Description: data.Description.ValueStringPointer(),
+		Username:    data.Username.ValueStringPointer(),
+		Password:    password,
+		Token:       token,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to update credential %s on workspaceId %s, got error: %s", credentialId, workspaceId, err,
+		))
+		return
+	}
+
+	r.mapResponseToModel(&data, cr, workspaceId)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "updated a repoflow credential resource", map[string]interface{}{
+		"id": cr.Id,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CredentialResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	credentialId := data.CredentialId.ValueString()
+
+	if err := r.client.DeleteCredential(workspaceId, credentialId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete credential, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow credential resource", map[string]interface{}{
+		"id": credentialId,
+	})
+}
+
+func (r *CredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data CredentialResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/credential, with the credential name or Id. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	credential := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	crs, err := r.client.ListCredentials(ws.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list credentials of workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	// The credential can be given by name or Id
+	var cr *client.Credential
+	for i := range *crs {
+		if (*crs)[i].Id == credential || (*crs)[i].Name == credential {
+			cr = &(*crs)[i]
+			break
+		}
+	}
+
+	if cr == nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import credential %s, not found on workspaceId %s", credential, ws.Id))
+		return
+	}
+
+	// The secrets are never returned by the API
+	data.Password = types.StringNull()
+	data.RotationVersion = types.Int64Null()
+	r.mapResponseToModel(&data, cr, ws.Id)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CredentialResource) mapResponseToModel(data *CredentialResourceModel, cr *client.Credential, workspaceId string) {
+	// We save the state id with workspaceId/credentialId
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, cr.Id}, "/"))
+	data.CredentialId = types.StringValue(cr.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.Name = types.StringValue(cr.Name)
+	data.Description = types.StringPointerValue(cr.Description)
+	data.Username = types.StringPointerValue(cr.Username)
+	data.RotatedAt = types.StringPointerValue(cr.RotatedAt)
+	// Write-only values are never stored
+	data.PasswordWo = types.StringNull()
+	data.TokenWo = types.StringNull()
+}
+
+// readSecrets returns the password and the token to send, the write-only values
+// being only available in the configuration.
+func (r *CredentialResource) readSecrets(ctx context.Context, data *CredentialResourceModel, config tfsdk.Config) (*string, *string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var passwordWo, tokenWo types.String
+
+	diags.Append(config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("token_wo"), &tokenWo)...)
+
+	password := data.Password.ValueStringPointer()
+	if !passwordWo.IsNull() {
+		password = passwordWo.ValueStringPointer()
+	}
+
+	return password, tokenWo.ValueStringPointer(), diags
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &CustomDomainResource{}
+var _ resource.ResourceWithImportState = &CustomDomainResource{}
+
+func NewCustomDomainResource() resource.Resource {
+	return &CustomDomainResource{}
+}
+
+// CustomDomainResource defines the resource implementation.
+type CustomDomainResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.InstanceAPI
+	}
+}
+
+// CustomDomainResourceModel describes the resource data model.
+type CustomDomainResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Domain           types.String `tfsdk:"domain"`
+	WorkspaceId      types.String `tfsdk:"workspace"`
+	CertificateId    types.String `tfsdk:"certificate_id"`
+	ValidationStatus types.String `tfsdk:"validation_status"`
+
+	VerificationRecordName  types.String `tfsdk:"verification_record_name"`
+	VerificationRecordType  types.String `tfsdk:"verification_record_type"`
+	VerificationRecordValue types.String `tfsdk:"verification_record_value"`
+}
+
+func (r *CustomDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_domain"
+}
+
+func (r *CustomDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Custom domain resource. Attach a hostname to the instance or to a specific workspace, e.g. `docker.example.com` for the registry URLs. Create the DNS record given by the `verification_record_*` attributes to validate the domain.",
+
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "Domain name to attach (e.g. `npm.example.com`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace served by the domain (name or Id). The domain is attached to the whole instance when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the TLS certificate served for the domain.",
+				Optional:            true,
+			},
+			"validation_status": schema.StringAttribute{
+				MarkdownDescription: "DNS validation status of the domain.",
+				Computed:            true,
+			},
+			"verification_record_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the DNS record validating the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_record_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the DNS record validating the domain, e.g. `TXT`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"verification_record_value": schema.StringAttribute{
+				MarkdownDescription: "Value of the DNS record validating the domain.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Custom domain identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CustomDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.CustomDomainOptions{
+		Domain:        data.Domain.ValueString(),
+		CertificateId: data.CertificateId.ValueStringPointer(),
+	}
+
+	// The workspace can be given by name, the API only accepts the Id
+	if !data.WorkspaceId.IsNull() {
+		workspace := data.WorkspaceId.ValueString()
+		ws, err := r.client.GetWorkspace(workspace)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+			return
+		}
+		opts.WorkspaceId = &ws.Id
+	}
+
+	cd, err := r.client.CreateCustomDomain(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom domain, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cd)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow custom domain resource", map[string]interface{}{
+		"id":     cd.Id,
+		"domain": cd.Domain,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customDomainId := data.Id.ValueString()
+
+	cd, err := r.client.GetCustomDomain(customDomainId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get custom domain %s, got error: %s", customDomainId, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cd)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "get a repoflow custom domain resource", map[string]interface{}{
+		"id": cd.Id,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomDomainResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the certificate can change in place
+	opts := client.CustomDomainUpdateOptions{
+		CertificateId: data.CertificateId.ValueStringPointer(),
+	}
+
+	cd, err := r.client.UpdateCustomDomain(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom domain, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cd)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	customDomainId := data.Id.ValueString()
+
+	if err := r.client.DeleteCustomDomain(customDomainId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom domain, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow custom domain resource", map[string]interface{}{
+		"id": customDomainId,
+	})
+}
+
+func (r *CustomDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *CustomDomainResource) mapResponseToModel(data *CustomDomainResourceModel, cd *client.CustomDomain) {
+	data.Id = types.StringValue(cd.Id)
+	data.Domain = types.StringValue(cd.Domain)
+	// Keep the workspace as configured (name or Id), only fill it on import
+	if data.WorkspaceId.IsNull() || data.WorkspaceId.IsUnknown() {
+		data.WorkspaceId = types.StringPointerValue(cd.WorkspaceId)
+	}
+	data.CertificateId = types.StringPointerValue(cd.CertificateId)
+	data.ValidationStatus = types.StringValue(cd.ValidationStatus)
+
+	// Unset once the domain is validated
+	data.VerificationRecordName = types.StringNull()
+	data.VerificationRecordType = types.StringNull()
+	data.VerificationRecordValue = types.StringNull()
+	if cd.VerificationRecord != nil {
+		data.VerificationRecordName = types.StringValue(cd.VerificationRecord.Name)
+		data.VerificationRecordType = types.StringValue(cd.VerificationRecord.Type)
+		data.VerificationRecordValue = types.StringValue(cd.VerificationRecord.Value)
+	}
+}
@@ -0,0 +1,267 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &DefaultPermissionTemplateResource{}
+var _ resource.ResourceWithImportState = &DefaultPermissionTemplateResource{}
+
+func NewDefaultPermissionTemplateResource() resource.Resource {
+	return &DefaultPermissionTemplateResource{}
+}
+
+// DefaultPermissionTemplateResource defines the resource implementation.
+type DefaultPermissionTemplateResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.PermissionsAPI
+	}
+}
+
+// DefaultPermissionTemplateResourceModel describes the resource data model.
+type DefaultPermissionTemplateResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Grants      types.Map    `tfsdk:"grants"`
+}
+
+func (r *DefaultPermissionTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_default_permission_template"
+}
+
+func (r *DefaultPermissionTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Default permission template resource. Grant roles to users and groups on every repository created in a workspace, so a new repository is usable without a follow-up grant. There is one template per workspace, it only applies at creation: the repositories existing when it changes keep their grants, manage them with `repoflow_repository_permission`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the template (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"grants": schema.MapAttribute{
+				MarkdownDescription: "Roles granted on the new repositories, keyed by principal (`user:<name>` or `group:<name>`), one of `read`, `write`, `delete` or `manage`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(principalRegexp, "must be in the form user:<name> or group:<name>")),
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(repositoryRoles...)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Default permission template identifier, the workspace Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DefaultPermissionTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DefaultPermissionTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data DefaultPermissionTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	dp, err := r.client.PutDefaultPermissionTemplate(workspace, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create default permission template of workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, dp)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow default permission template resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultPermissionTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data DefaultPermissionTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dp, err := r.client.GetDefaultPermissionTemplate(data.Id.ValueString())
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get default permission template %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, dp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultPermissionTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data DefaultPermissionTemplateResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dp, err := r.client.PutDefaultPermissionTemplate(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update default permission template, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, dp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefaultPermissionTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data DefaultPermissionTemplateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The grants already given to the repositories are kept
+	if err := r.client.DeleteDefaultPermissionTemplate(data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete default permission template, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow default permission template resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *DefaultPermissionTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ws, err := r.client.GetWorkspace(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ws.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), req.ID)...)
+}
+
+func (r *DefaultPermissionTemplateResource) buildOptions(ctx context.Context, data *DefaultPermissionTemplateResourceModel) (client.DefaultPermissionTemplateOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	grants := map[string]string{}
+	diags.Append(data.Grants.ElementsAs(ctx, &grants, false)...)
+
+	opts := client.DefaultPermissionTemplateOptions{
+		Grants: []client.RepositoryPermission{},
+	}
+	for _, principal := range slices.Sorted(maps.Keys(grants)) {
+		opts.Grants = append(opts.Grants, client.RepositoryPermission{
+			Principal: principal,
+			Role:      grants[principal],
+		})
+	}
+
+	return opts, diags
+}
+
+func (r *DefaultPermissionTemplateResource) mapResponseToModel(ctx context.Context, data *DefaultPermissionTemplateResourceModel, dp *client.DefaultPermissionTemplate) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(dp.WorkspaceId)
+	// The workspace is kept as configured (name or Id)
+
+	grants := map[string]string{}
+	for _, grant := range dp.Grants {
+		grants[grant.Principal] = grant.Role
+	}
+	data.Grants, diags = types.MapValueFrom(ctx, types.StringType, grants)
+
+	return diags
+}
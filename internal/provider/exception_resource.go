@@ -0,0 +1,357 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ExceptionResource{}
+var _ resource.ResourceWithImportState = &ExceptionResource{}
+var _ resource.ResourceWithModifyPlan = &ExceptionResource{}
+
+func NewExceptionResource() resource.Resource {
+	return &ExceptionResource{}
+}
+
+// ExceptionResource defines the resource implementation.
+type ExceptionResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.SecurityAPI
+	}
+}
+
+// ExceptionResourceModel describes the resource data model.
+type ExceptionResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	PackageType types.String `tfsdk:"package_type"`
+	PackageName types.String `tfsdk:"package"`
+	Version     types.String `tfsdk:"version"`
+	Reason      types.String `tfsdk:"reason"`
+	Approver    types.String `tfsdk:"approver"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (r *ExceptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_exception"
+}
+
+func (r *ExceptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Exception resource. Time-boxed waiver allowing a package blocked by a policy or a failed scan. The exception lapses at `expires_at` and is then removed from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace covered by the exception (name or Id). The exception applies to the whole instance when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: "Package type of the allowed package.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(packageTypes...),
+				},
+			},
+			"package": schema.StringAttribute{
+				MarkdownDescription: "Name of the allowed package.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the allowed package. Every version is allowed when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"reason": schema.StringAttribute{
+				MarkdownDescription: "Justification of the exception.",
+				Required:            true,
+			},
+			"approver": schema.StringAttribute{
+				MarkdownDescription: "Person or team who approved the exception.",
+				Required:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry date of the exception (RFC 3339, e.g. `2026-12-31T00:00:00Z`).",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Exception identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ExceptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ExceptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.ExceptionOptions{
+		PackageType: data.PackageType.ValueString(),
+		PackageName: data.PackageName.ValueString(),
+		Version:     data.Version.ValueStringPointer(),
+		Reason:      data.Reason.ValueString(),
+		Approver:    data.Approver.ValueString(),
+		ExpiresAt:   data.ExpiresAt.ValueString(),
+	}
+
+	// The workspace can be given by name, the API only accepts the Id
+	if !data.WorkspaceId.IsNull() {
+		workspace := data.WorkspaceId.ValueString()
+		ws, err := r.client.GetWorkspace(workspace)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+			return
+		}
+		opts.WorkspaceId = &ws.Id
+	}
+
+	ex, err := r.client.CreateException(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create exception, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, ex)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow exception resource", map[string]interface{}{
+		"id":         ex.Id,
+		"package":    ex.PackageName,
+		"expires_at": ex.ExpiresAt,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExceptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exceptionId := data.Id.ValueString()
+
+	ex, err := r.client.GetException(exceptionId)
+
+	// The exception lapsed or was revoked outside of Terraform
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get exception %s, got error: %s", exceptionId, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, ex)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExceptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ExceptionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The package requires a replacement, the justification and the expiry change in place
+	opts := client.ExceptionUpdateOptions{
+		Reason:    data.Reason.ValueString(),
+		Approver:  data.Approver.ValueString(),
+		ExpiresAt: data.ExpiresAt.ValueString(),
+	}
+
+	ex, err := r.client.UpdateException(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update exception, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, ex)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ExceptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ExceptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exceptionId := data.Id.ValueString()
+
+	err := r.client.DeleteException(exceptionId)
+
+	// The exception may have lapsed since the last refresh
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete exception, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow exception resource", map[string]interface{}{
+		"id": exceptionId,
+	})
+}
+
+func (r *ExceptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ExceptionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	checkExpiresAt(ctx, req, resp, "Expired Exception", "exception", "no longer allows the package")
+}
+
+// checkExpiresAt checks the expiry date of a time-boxed waiver, which the API
+// no longer returns once it lapsed.
+func checkExpiresAt(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, summary string, name string, effect string) {
+	var expiresAt types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("expires_at"), &expiresAt)...)
+
+	if resp.Diagnostics.HasError() || expiresAt.IsNull() || expiresAt.IsUnknown() {
+		return
+	}
+
+	expiry, err := time.Parse(time.RFC3339, expiresAt.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			"Invalid Expiry Date",
+			fmt.Sprintf("The expiry date must be a RFC 3339 timestamp, got error: %s", err),
+		)
+		return
+	}
+
+	if expiry.After(time.Now()) {
+		return
+	}
+
+	// A lapsed waiver is removed from the state on refresh, creating it again would fail
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expires_at"),
+			summary,
+			fmt.Sprintf("The %s expired on %s, extend expires_at or remove the %s from the configuration.", name, expiresAt.ValueString(), name),
+		)
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root("expires_at"),
+		summary,
+		fmt.Sprintf("The %s expired on %s and %s.", name, expiresAt.ValueString(), effect),
+	)
+}
+
+func (r *ExceptionResource) mapResponseToModel(data *ExceptionResourceModel, ex *client.Exception) {
+	data.Id = types.StringValue(ex.Id)
+	// Keep the workspace as configured (name or Id), only fill it on import
+	if data.WorkspaceId.IsNull() || data.WorkspaceId.IsUnknown() {
+		data.WorkspaceId = types.StringPointerValue(ex.WorkspaceId)
+	}
+	data.PackageType = types.StringValue(ex.PackageType)
+	data.PackageName = types.StringValue(ex.PackageName)
+	data.Version = types.StringPointerValue(ex.Version)
+	data.Reason = types.StringValue(ex.Reason)
+	data.Approver = types.StringValue(ex.Approver)
+	// The API may normalize the timestamp, keep the configured value when it is the same instant
+	if !sameInstant(data.ExpiresAt.ValueString(), ex.ExpiresAt) {
+		data.ExpiresAt = types.StringValue(ex.ExpiresAt)
+	}
+}
+
+// sameInstant reports whether two RFC 3339 timestamps are the same instant.
+func sameInstant(a, b string) bool {
+	ta, err := time.Parse(time.RFC3339, a)
+	if err != nil {
+		return false
+	}
+	tb, err := time.Parse(time.RFC3339, b)
+	if err != nil {
+		return false
+	}
+	return ta.Equal(tb)
+}
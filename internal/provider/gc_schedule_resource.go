@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+const maxGcConcurrency = 16
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &GcScheduleResource{}
+var _ resource.ResourceWithImportState = &GcScheduleResource{}
+
+func NewGcScheduleResource() resource.Resource {
+	return &GcScheduleResource{}
+}
+
+// GcScheduleResource defines the resource implementation.
+type GcScheduleResource struct {
+	client client.InstanceAPI
+}
+
+// GcScheduleResourceModel describes the resource data model.
+type GcScheduleResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Task               types.String `tfsdk:"task"`
+	Schedule           types.String `tfsdk:"schedule"`
+	Concurrency        types.Int64  `tfsdk:"concurrency"`
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	MaxDurationMinutes types.Int64  `tfsdk:"max_duration_minutes"`
+}
+
+func (r *GcScheduleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_gc_schedule"
+}
+
+func (r *GcScheduleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Garbage collection schedule resource. Define when a garbage collection task of the instance runs and how many workers it uses. There is one schedule per task, destroying the resource resets the task to the instance defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"task": schema.StringAttribute{
+				MarkdownDescription: "Garbage collection task: `blobs` deletes the stored files no artifact references anymore, `orphaned_metadata` deletes the metadata of the deleted packages.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.GcTaskBlobs, client.GcTaskOrphanedMetadata),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression of the runs (UTC), e.g. `0 2 * * 0` (every sunday at 2am).",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronRegexp, "must be a cron expression with five fields"),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of workers of a run, between 1 and %d (default `1`). More workers end sooner but load the storage more.", maxGcConcurrency),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxGcConcurrency),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the task runs on its schedule (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"max_duration_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Stop a run after this number of minutes, e.g. to keep it within a maintenance window. The next run resumes it. A run is never stopped when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Garbage collection schedule identifier, the task",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *GcScheduleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *GcScheduleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data GcScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := data.Task.ValueString()
+
+	gs, err := r.client.PutGcSchedule(task, r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the schedule of the %s garbage collection, got error: %s", task, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, gs)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow gc schedule resource", map[string]interface{}{
+		"task":     gs.Task,
+		"schedule": gs.Schedule,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcScheduleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data GcScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := data.Id.ValueString()
+
+	gs, err := r.client.GetGcSchedule(task)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get the schedule of the %s garbage collection, got error: %s", task, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, gs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcScheduleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data GcScheduleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := data.Id.ValueString()
+
+	gs, err := r.client.PutGcSchedule(task, r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update the schedule of the %s garbage collection, got error: %s", task, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, gs)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *GcScheduleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data GcScheduleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	task := data.Id.ValueString()
+
+	if err := r.client.DeleteGcSchedule(task); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset the schedule of the %s garbage collection, got error: %s", task, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow gc schedule resource", map[string]interface{}{
+		"task": task,
+	})
+}
+
+func (r *GcScheduleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("task"), req.ID)...)
+}
+
+func (r *GcScheduleResource) buildOptions(data *GcScheduleResourceModel) client.GcScheduleOptions {
+	return client.GcScheduleOptions{
+		Schedule:           data.Schedule.ValueString(),
+		Concurrency:        int(data.Concurrency.ValueInt64()),
+		IsEnabled:          data.Enabled.ValueBool(),
+		MaxDurationMinutes: factory.Int64ToPtr(data.MaxDurationMinutes),
+	}
+}
+
+func (r *GcScheduleResource) mapResponseToModel(data *GcScheduleResourceModel, gs *client.GcSchedule) {
+	data.Id = types.StringValue(gs.Task)
+	data.Task = types.StringValue(gs.Task)
+	data.Schedule = types.StringValue(gs.Schedule)
+	data.Concurrency = types.Int64Value(int64(gs.Concurrency))
+	data.Enabled = types.BoolValue(gs.IsEnabled)
+	data.MaxDurationMinutes = types.Int64PointerValue(factory.IntPtrToInt64Ptr(gs.MaxDurationMinutes))
+}
@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ImmutableTagRuleResource{}
+var _ resource.ResourceWithImportState = &ImmutableTagRuleResource{}
+var _ resource.ResourceWithModifyPlan = &ImmutableTagRuleResource{}
+
+func NewImmutableTagRuleResource() resource.Resource {
+	return &ImmutableTagRuleResource{}
+}
+
+// ImmutableTagRuleResource defines the resource implementation.
+type ImmutableTagRuleResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PoliciesAPI
+	}
+}
+
+// ImmutableTagRuleResourceModel describes the resource data model.
+type ImmutableTagRuleResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	TagPattern  types.String `tfsdk:"tag_pattern"`
+	Description types.String `tfsdk:"description"`
+	Enabled     types.Bool   `tfsdk:"enabled"`
+	RuleId      types.String `tfsdk:"rule_id"`
+}
+
+func (r *ImmutableTagRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_immutable_tag_rule"
+}
+
+func (r *ImmutableTagRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Immutable tag rule resource. Make the tags of a local docker or oci repository matching a pattern immutable, e.g. the release tags: once pushed, they can not be overwritten nor deleted. Destroying the resource makes the matching tags mutable again.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Local docker or oci repository name or Id.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag_pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression (RE2 syntax) matched against the whole tag, e.g. `^v\\d+\\.\\d+\\.\\d+$` for the semantic version tags.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the rule.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the matching tags are immutable (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "Immutable tag rule Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Immutable tag rule state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ImmutableTagRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ImmutableTagRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ImmutableTagRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	it, err := r.client.CreateImmutableTagRule(workspaceId, repositoryId, r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create immutable tag rule on repository %s, got error: %s", repositoryId, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, it.Id}, "/"))
+	r.mapResponseToModel(&data, it)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow immutable tag rule resource", map[string]interface{}{
+		"id":          data.Id.ValueString(),
+		"tag_pattern": it.TagPattern,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImmutableTagRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ImmutableTagRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected immutable tag rule identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	it, err := r.client.GetImmutableTagRule(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get immutable tag rule %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	r.mapResponseToModel(&data, it)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImmutableTagRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ImmutableTagRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected immutable tag rule identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	it, err := r.client.UpdateImmutableTagRule(idParts[0], idParts[1], idParts[2], r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update immutable tag rule %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	r.mapResponseToModel(&data, it)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImmutableTagRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ImmutableTagRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected immutable tag rule identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeleteImmutableTagRule(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete immutable tag rule, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow immutable tag rule resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *ImmutableTagRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data ImmutableTagRuleResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/ruleId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	it, err := r.client.GetImmutableTagRule(workspaceId, repositoryId, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import immutable tag rule %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, it.Id}, "/"))
+	data.WorkspaceId = types.StringValue(idParts[0])
+	data.Repository = types.StringValue(idParts[1])
+	r.mapResponseToModel(&data, it)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ImmutableTagRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tagPattern types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tag_pattern"), &tagPattern)...)
+
+	if resp.Diagnostics.HasError() || tagPattern.IsUnknown() || tagPattern.IsNull() {
+		return
+	}
+
+	// The API matches the tags with the same regular expression syntax
+	if _, err := regexp.Compile(tagPattern.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("tag_pattern"),
+			"Invalid parameter",
+			fmt.Sprintf("%q is not a valid regular expression: %s.", tagPattern.ValueString(), err),
+		)
+	}
+}
+
+func (r *ImmutableTagRuleResource) buildOptions(data *ImmutableTagRuleResourceModel) client.ImmutableTagRuleOptions {
+	return client.ImmutableTagRuleOptions{
+		TagPattern:  data.TagPattern.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+		IsEnabled:   data.Enabled.ValueBool(),
+	}
+}
+
+func (r *ImmutableTagRuleResource) mapResponseToModel(data *ImmutableTagRuleResourceModel, it *client.ImmutableTagRule) {
+	data.RuleId = types.StringValue(it.Id)
+	data.TagPattern = types.StringValue(it.TagPattern)
+	data.Description = types.StringPointerValue(it.Description)
+	data.Enabled = types.BoolValue(it.IsEnabled)
+}
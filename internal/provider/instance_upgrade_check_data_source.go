@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// API areas covered by the resources of the provider
+var managedAreas = map[string]bool{
+	"workspaces":       true,
+	"repositories":     true,
+	"security":         true,
+	"settings":         true,
+	"label-taxonomy":   true,
+	"custom-domains":   true,
+	"tls-certificates": true,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &InstanceUpgradeCheckDataSource{}
+
+func NewInstanceUpgradeCheckDataSource() datasource.DataSource {
+	return &InstanceUpgradeCheckDataSource{}
+}
+
+// InstanceUpgradeCheckDataSource defines the data source implementation.
+type InstanceUpgradeCheckDataSource struct {
+	client client.InstanceAPI
+}
+
+type InstanceUpgradeCheckDataSourceModel struct {
+	AllBreakingChanges types.Bool            `tfsdk:"all_breaking_changes"`
+	CurrentVersion     types.String          `tfsdk:"current_version"`
+	LatestVersion      types.String          `tfsdk:"latest_version"`
+	UpgradeAvailable   types.Bool            `tfsdk:"upgrade_available"`
+	BreakingChanges    []BreakingChangeModel `tfsdk:"breaking_changes"`
+}
+
+type BreakingChangeModel struct {
+	Version     types.String `tfsdk:"version"`
+	Area        types.String `tfsdk:"area"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (d *InstanceUpgradeCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_instance_upgrade_check"
+}
+
+func (d *InstanceUpgradeCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Instance upgrade check data source. Whether a newer RepoFlow version is available, and the breaking changes it brings to the settings managed by this provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"all_breaking_changes": schema.BoolAttribute{
+				MarkdownDescription: "List every breaking change, not only the ones affecting the settings managed by this provider (default `false`).",
+				Optional:            true,
+			},
+			"current_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the running instance.",
+				Computed:            true,
+			},
+			"latest_version": schema.StringAttribute{
+				MarkdownDescription: "Latest released version.",
+				Computed:            true,
+			},
+			"upgrade_available": schema.BoolAttribute{
+				MarkdownDescription: "Whether a newer version is available.",
+				Computed:            true,
+			},
+			"breaking_changes": schema.ListNestedAttribute{
+				MarkdownDescription: "Breaking changes between the running and the latest version.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version introducing the change.",
+							Computed:            true,
+						},
+						"area": schema.StringAttribute{
+							MarkdownDescription: "API area affected by the change (e.g. `repositories`).",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the change.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *InstanceUpgradeCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *InstanceUpgradeCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data InstanceUpgradeCheckDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uc, err := d.client.GetUpgradeCheck()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check for instance upgrades, got error: %s", err))
+		return
+	}
+
+	data.CurrentVersion = types.StringValue(uc.CurrentVersion)
+	data.LatestVersion = types.StringValue(uc.LatestVersion)
+	data.UpgradeAvailable = types.BoolValue(uc.UpgradeAvailable)
+
+	data.BreakingChanges = []BreakingChangeModel{}
+	for _, bc := range uc.BreakingChanges {
+		if !data.AllBreakingChanges.ValueBool() && !managedAreas[bc.Area] {
+			continue
+		}
+		data.BreakingChanges = append(data.BreakingChanges, BreakingChangeModel{
+			Version:     types.StringValue(bc.Version),
+			Area:        types.StringValue(bc.Area),
+			Description: types.StringValue(bc.Description),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read instance upgrade check data", map[string]interface{}{
+		"current_version":  uc.CurrentVersion,
+		"latest_version":   uc.LatestVersion,
+		"breaking_changes": len(data.BreakingChanges),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,348 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The allowlist of the instance is a singleton, so its state always uses the same identifier.
+const ipAllowlistInstanceId = "instance"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &IpAllowlistResource{}
+var _ resource.ResourceWithImportState = &IpAllowlistResource{}
+var _ resource.ResourceWithModifyPlan = &IpAllowlistResource{}
+
+func NewIpAllowlistResource() resource.Resource {
+	return &IpAllowlistResource{}
+}
+
+// IpAllowlistResource defines the resource implementation.
+type IpAllowlistResource struct {
+	client client.PermissionsAPI
+}
+
+// IpAllowlistResourceModel describes the resource data model.
+type IpAllowlistResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Allow       types.Set    `tfsdk:"allow"`
+	Deny        types.Set    `tfsdk:"deny"`
+}
+
+func (r *IpAllowlistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_allowlist"
+}
+
+func (r *IpAllowlistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "IP allowlist resource. Restrict the addresses reaching the instance or a workspace, the denied addresses winning over the allowed ones. There is one allowlist per scope. Make sure the addresses running Terraform stay allowed on the instance, or the provider locks itself out.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace restricted by the allowlist (name or Id). The allowlist applies to the whole instance when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allow": schema.SetAttribute{
+				MarkdownDescription: "Addresses allowed, as IPs or CIDR ranges (e.g. `10.0.0.0/8`, `2001:db8::/32`). Every address is allowed when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AtLeastOneOf(path.MatchRoot("deny")),
+				},
+			},
+			"deny": schema.SetAttribute{
+				MarkdownDescription: "Addresses denied, as IPs or CIDR ranges, even when part of an allowed range.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "IP allowlist identifier, `instance` or the workspace Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IpAllowlistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IpAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IpAllowlistResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	al, err := r.client.PutIpAllowlist(workspace, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create IP allowlist, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, al)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow IP allowlist resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IpAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IpAllowlistResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	al, err := r.client.GetIpAllowlist(workspace)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get IP allowlist %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, al)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IpAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IpAllowlistResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	al, err := r.client.PutIpAllowlist(data.WorkspaceId.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update IP allowlist, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, al)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IpAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IpAllowlistResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteIpAllowlist(data.WorkspaceId.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete IP allowlist, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow IP allowlist resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *IpAllowlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+
+	// Any other identifier is the workspace (name or Id)
+	if req.ID != ipAllowlistInstanceId {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), req.ID)...)
+	}
+}
+
+func (r *IpAllowlistResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var allow, deny types.Set
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow"), &allow)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("deny"), &deny)...)
+
+	if resp.Diagnostics.HasError() || allow.IsUnknown() || deny.IsUnknown() {
+		return
+	}
+
+	allowed := map[netip.Prefix]bool{}
+	for _, attr := range []struct {
+		name string
+		set  types.Set
+	}{{"allow", allow}, {"deny", deny}} {
+		var addresses []types.String
+		resp.Diagnostics.Append(attr.set.ElementsAs(ctx, &addresses, false)...)
+
+		for _, address := range addresses {
+			if address.IsUnknown() {
+				continue
+			}
+
+			prefix, err := parseIpRange(address.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attr.name),
+					"Invalid parameter",
+					fmt.Sprintf("%q is not an IP or a CIDR range: %s.", address.ValueString(), err),
+				)
+				continue
+			}
+
+			if attr.name == "allow" {
+				allowed[prefix] = true
+			} else if allowed[prefix] {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("deny"),
+					"Invalid parameter",
+					fmt.Sprintf("%q is both allowed and denied.", address.ValueString()),
+				)
+			}
+		}
+	}
+}
+
+// parseIpRange parses an IP or a CIDR range, an IP being a range of a single address.
+func parseIpRange(s string) (netip.Prefix, error) {
+	if !strings.Contains(s, "/") {
+		addr, err := netip.ParseAddr(s)
+		if err != nil {
+			return netip.Prefix{}, err
+		}
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+
+	prefix, err := netip.ParsePrefix(s)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return prefix.Masked(), nil
+}
+
+func (r *IpAllowlistResource) buildOptions(ctx context.Context, data *IpAllowlistResourceModel) (client.IpAllowlistOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list allows or denies nothing
+	opts := client.IpAllowlistOptions{
+		Allow: []string{},
+		Deny:  []string{},
+	}
+	diags.Append(data.Allow.ElementsAs(ctx, &opts.Allow, false)...)
+	diags.Append(data.Deny.ElementsAs(ctx, &opts.Deny, false)...)
+
+	return opts, diags
+}
+
+func (r *IpAllowlistResource) mapResponseToModel(ctx context.Context, data *IpAllowlistResourceModel, al *client.IpAllowlist) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(ipAllowlistInstanceId)
+	if al.WorkspaceId != nil {
+		data.Id = types.StringValue(*al.WorkspaceId)
+	}
+	// The workspace is kept as configured (name or Id)
+
+	// Keep the lists null when empty to avoid a diff with the configuration
+	data.Allow = types.SetNull(types.StringType)
+	if len(al.Allow) > 0 {
+		var d diag.Diagnostics
+		data.Allow, d = types.SetValueFrom(ctx, types.StringType, al.Allow)
+		diags.Append(d...)
+	}
+	data.Deny = types.SetNull(types.StringType)
+	if len(al.Deny) > 0 {
+		var d diag.Diagnostics
+		data.Deny, d = types.SetValueFrom(ctx, types.StringType, al.Deny)
+		diags.Append(d...)
+	}
+
+	return diags
+}
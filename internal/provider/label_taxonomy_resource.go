@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LabelTaxonomyResource{}
+var _ resource.ResourceWithImportState = &LabelTaxonomyResource{}
+
+func NewLabelTaxonomyResource() resource.Resource {
+	return &LabelTaxonomyResource{}
+}
+
+// LabelTaxonomyResource defines the resource implementation.
+type LabelTaxonomyResource struct {
+	client client.LabelsAPI
+}
+
+// LabelTaxonomyResourceModel describes the resource data model.
+type LabelTaxonomyResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Key           types.String `tfsdk:"key"`
+	Description   types.String `tfsdk:"description"`
+	AllowedValues types.Set    `tfsdk:"allowed_values"`
+}
+
+func (r *LabelTaxonomyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_label_taxonomy"
+}
+
+func (r *LabelTaxonomyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Label taxonomy resource. Declare a label key allowed on workspaces and repositories, with its allowed values.",
+
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				MarkdownDescription: "Label key.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the label key.",
+				Optional:            true,
+			},
+			"allowed_values": schema.SetAttribute{
+				MarkdownDescription: "Values allowed for the key. Any value is allowed when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Label key identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LabelTaxonomyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LabelTaxonomyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LabelTaxonomyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lk, err := r.client.PutLabelKey(data.Key.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create label key, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lk)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow label key resource", map[string]interface{}{
+		"key": lk.Key,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LabelTaxonomyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LabelTaxonomyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Id.ValueString()
+
+	lk, err := r.client.GetLabelKey(key)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get label key %s, got error: %s", key, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lk)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LabelTaxonomyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LabelTaxonomyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lk, err := r.client.PutLabelKey(data.Key.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update label key, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lk)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LabelTaxonomyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LabelTaxonomyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.ValueString()
+
+	if err := r.client.DeleteLabelKey(key); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete label key, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow label key resource", map[string]interface{}{
+		"key": key,
+	})
+}
+
+func (r *LabelTaxonomyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *LabelTaxonomyResource) buildOptions(ctx context.Context, data *LabelTaxonomyResourceModel) (client.LabelKeyOptions, diag.Diagnostics) {
+	opts := client.LabelKeyOptions{
+		Description:   data.Description.ValueString(),
+		AllowedValues: []string{},
+	}
+
+	var diags diag.Diagnostics
+	if !data.AllowedValues.IsNull() {
+		diags = data.AllowedValues.ElementsAs(ctx, &opts.AllowedValues, false)
+	}
+
+	return opts, diags
+}
+
+func (r *LabelTaxonomyResource) mapResponseToModel(ctx context.Context, data *LabelTaxonomyResourceModel, lk *client.LabelKey) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(lk.Key)
+	data.Key = types.StringValue(lk.Key)
+
+	if lk.Description != "" {
+		data.Description = types.StringValue(lk.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	// An empty list means any value is allowed
+	if len(lk.AllowedValues) == 0 {
+		data.AllowedValues = types.SetNull(types.StringType)
+	} else {
+		setValue, setDiags := types.SetValueFrom(ctx, types.StringType, lk.AllowedValues)
+		diags.Append(setDiags...)
+		data.AllowedValues = setValue
+	}
+
+	return diags
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// validateLabels checks labels against the label taxonomy of the instance.
+// Keys missing from the taxonomy only raise a warning, they may be declared
+// by a repoflow_label_taxonomy resource of the same plan.
+func validateLabels(ctx context.Context, c client.LabelsAPI, attrPath path.Path, labels types.Map) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	values := map[string]types.String{}
+	diags.Append(labels.ElementsAs(ctx, &values, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	keys, err := c.ListLabelKeys()
+
+	// Instances without a taxonomy accept any label
+	if client.IsNotFound(err) || (err == nil && len(*keys) == 0) {
+		return diags
+	}
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list label keys, got error: %s", err))
+		return diags
+	}
+
+	taxonomy := make(map[string]client.LabelKey, len(*keys))
+	for _, lk := range *keys {
+		taxonomy[lk.Key] = lk
+	}
+
+	for key, value := range values {
+		lk, ok := taxonomy[key]
+		if !ok {
+			diags.AddAttributeWarning(
+				attrPath.AtMapKey(key),
+				"Undeclared Label Key",
+				fmt.Sprintf("The label key %q is not declared in the label taxonomy.", key),
+			)
+			continue
+		}
+
+		// Values are only known at apply time, or any value is allowed
+		if value.IsUnknown() || len(lk.AllowedValues) == 0 {
+			continue
+		}
+
+		if !slices.Contains(lk.AllowedValues, value.ValueString()) {
+			diags.AddAttributeError(
+				attrPath.AtMapKey(key),
+				"Invalid Label Value",
+				fmt.Sprintf("The value %q is not allowed for the label key %q, expected one of: %s.",
+					value.ValueString(), key, strings.Join(lk.AllowedValues, ", ")),
+			)
+		}
+	}
+
+	return diags
+}
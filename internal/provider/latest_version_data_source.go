@@ -0,0 +1,159 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &LatestVersionDataSource{}
+
+func NewLatestVersionDataSource() datasource.DataSource {
+	return &LatestVersionDataSource{}
+}
+
+// LatestVersionDataSource defines the data source implementation.
+type LatestVersionDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+type LatestVersionDataSourceModel struct {
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	PackageName types.String `tfsdk:"package_name"`
+	StableOnly  types.Bool   `tfsdk:"stable_only"`
+	Version     types.String `tfsdk:"version"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (d *LatestVersionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_latest_version"
+}
+
+func (d *LatestVersionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Latest version data source. The latest version of a package published in a repository, e.g. to template an image tag or a dependency pin. " +
+			"The newest semantic version wins, the other versions, like the `latest` tag of an image, are only used when the package has no semantic version.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id)",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id",
+				Required:            true,
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "Package name, e.g. `@acme/ui` or `library/nginx`.",
+				Required:            true,
+			},
+			"stable_only": schema.BoolAttribute{
+				MarkdownDescription: "Only consider the stable releases, the semantic versions without pre-release like `2.0.0-rc.1`. Defaults to `false`.",
+				Optional:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Latest version of the package.",
+				Computed:            true,
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Publication date of the version.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *LatestVersionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LatestVersionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data LatestVersionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+
+	workspaceId, repositoryId, err := resolveRepository(d.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	pvs, err := listPackageVersions(d.client, workspaceId, repositoryId, packageName)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list versions of package %s, got error: %s", packageName, err))
+		return
+	}
+
+	var latest *client.PackageVersion
+
+	// The versions are sorted the newest semantic version first
+	for _, pv := range sortPackageVersions(pvs) {
+		if data.StableOnly.ValueBool() {
+			if v, err := parseSemver(pv.Version); err != nil || v.prerelease != "" {
+				continue
+			}
+		}
+
+		latest = &pv
+		break
+	}
+
+	if latest == nil {
+		kind := "version"
+		if data.StableOnly.ValueBool() {
+			kind = "stable version"
+		}
+		resp.Diagnostics.AddError("No Version Found", fmt.Sprintf("Package %s has no %s in repository %s.", packageName, kind, data.Repository.ValueString()))
+		return
+	}
+
+	data.Version = types.StringValue(latest.Version)
+	data.CreatedAt = types.StringValue(latest.CreatedAt)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read latest version data", map[string]interface{}{
+		"repository": repositoryId,
+		"workspace":  workspaceId,
+		"package":    packageName,
+		"version":    latest.Version,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// newTestPackage creates a local repository publishing the given versions of a package.
+func newTestPackage(t *testing.T, p *testProvider, workspaceId string, name string, versions ...string) {
+	t.Helper()
+
+	if _, err := p.client.GetRepository(workspaceId, "npm-local"); client.IsNotFound(err) {
+		_, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+			RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+		})
+		if err != nil {
+			t.Fatalf("CreateLocalRepository: %s", err)
+		}
+	}
+
+	for _, v := range versions {
+		if err := p.client.AddPackageVersion(workspaceId, "npm-local", name, v, 1024); err != nil {
+			t.Fatalf("AddPackageVersion: %s", err)
+		}
+	}
+}
+
+func TestLatestVersionDataSource(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+	newTestPackage(t, p, workspaceId, "lodash", "1.0.0", "1.10.0", "1.2.0", "2.0.0-rc.1", "nightly")
+	newTestPackage(t, p, workspaceId, "snapshots", "nightly", "3.0.0-beta")
+
+	config := func(packageName string, stableOnly bool) tftypes.Value {
+		return p.dataConfig("repoflow_latest_version", map[string]tftypes.Value{
+			"workspace":    str("platform"),
+			"repository":   str("npm-local"),
+			"package_name": str(packageName),
+			"stable_only":  tftypes.NewValue(tftypes.Bool, stableOnly),
+		})
+	}
+
+	// The newest semantic version wins over the publication order and the other versions
+	if got := stringAttr(t, p.readData("repoflow_latest_version", config("lodash", false)), "version"); got != "2.0.0-rc.1" {
+		t.Errorf("version = %q, want 2.0.0-rc.1", got)
+	}
+	if got := stringAttr(t, p.readData("repoflow_latest_version", config("lodash", true)), "version"); got != "1.10.0" {
+		t.Errorf("stable version = %q, want 1.10.0", got)
+	}
+
+	if _, diags := p.tryReadData("repoflow_latest_version", config("snapshots", true)); !hasError(diags) {
+		t.Error("no error for a package without stable version")
+	}
+}
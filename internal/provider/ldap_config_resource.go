@@ -0,0 +1,358 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The LDAP integration is a singleton, so the state always uses the same identifier.
+const ldapConfigId = "ldap_config"
+
+var ldapUrlRegexp = regexp.MustCompile(`^ldaps?://[^/]+`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LdapConfigResource{}
+var _ resource.ResourceWithImportState = &LdapConfigResource{}
+
+func NewLdapConfigResource() resource.Resource {
+	return &LdapConfigResource{}
+}
+
+// LdapConfigResource defines the resource implementation.
+type LdapConfigResource struct {
+	client client.IdentityAPI
+}
+
+// LdapConfigResourceModel describes the resource data model.
+type LdapConfigResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	ServerUrl             types.String `tfsdk:"server_url"`
+	StartTls              types.Bool   `tfsdk:"start_tls"`
+	BindDn                types.String `tfsdk:"bind_dn"`
+	BindPasswordWo        types.String `tfsdk:"bind_password_wo"`
+	BindPasswordWoVersion types.Int64  `tfsdk:"bind_password_wo_version"`
+	UserSearchBase        types.String `tfsdk:"user_search_base"`
+	UserSearchFilter      types.String `tfsdk:"user_search_filter"`
+	GroupSearchBase       types.String `tfsdk:"group_search_base"`
+	GroupSearchFilter     types.String `tfsdk:"group_search_filter"`
+	GroupMappings         types.Map    `tfsdk:"group_mappings"`
+}
+
+func (r *LdapConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ldap_config"
+}
+
+func (r *LdapConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "LDAP config resource. Manage the LDAP or Active Directory integration of the instance (only one per instance): the directory users log in to RepoFlow and the members of the mapped directory groups join the RepoFlow groups, to use as `group:<name>` principal of the role assignments.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the directory users can log in.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"server_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the directory server, e.g. `ldaps://ldap.example.com:636`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(ldapUrlRegexp, "must be an ldap:// or ldaps:// URL"),
+				},
+			},
+			"start_tls": schema.BoolAttribute{
+				MarkdownDescription: "Whether to upgrade an `ldap://` connection with StartTLS.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"bind_dn": schema.StringAttribute{
+				MarkdownDescription: "DN of the service account searching the directory, e.g. `cn=repoflow,ou=services,dc=example,dc=com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"bind_password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password of the service account, never stored in the state. Only sent on creation and when `bind_password_wo_version` changes.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"bind_password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the password. Change it to send a new `bind_password_wo`.",
+				Optional:            true,
+			},
+			"user_search_base": schema.StringAttribute{
+				MarkdownDescription: "DN under which the users are searched, e.g. `ou=people,dc=example,dc=com`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"user_search_filter": schema.StringAttribute{
+				MarkdownDescription: "Filter finding the user logging in, `{username}` being replaced by the login. Use `(sAMAccountName={username})` for Active Directory.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("(uid={username})"),
+			},
+			"group_search_base": schema.StringAttribute{
+				MarkdownDescription: "DN under which the groups are searched, e.g. `ou=groups,dc=example,dc=com`. The groups are not synchronized when unset.",
+				Optional:            true,
+			},
+			"group_search_filter": schema.StringAttribute{
+				MarkdownDescription: "Filter finding the groups of a user, `{dn}` being replaced by the DN of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("(member={dn})"),
+			},
+			"group_mappings": schema.MapAttribute{
+				MarkdownDescription: "RepoFlow group joined by the members of each directory group, keyed by the DN of the directory group.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "LDAP config identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LdapConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LdapConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LdapConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	password, diags := r.readPassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts.BindPassword = password
+
+	lc, err := r.client.UpdateLdapConfig(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create LDAP config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lc)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow ldap config resource", map[string]interface{}{
+		"server_url": lc.ServerUrl,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LdapConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LdapConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lc, err := r.client.GetLdapConfig()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get LDAP config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lc)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "get a repoflow ldap config resource", map[string]interface{}{
+		"server_url": lc.ServerUrl,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LdapConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state LdapConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the password when its version changed
+	if !data.BindPasswordWoVersion.Equal(state.BindPasswordWoVersion) {
+		password, diags := r.readPassword(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.BindPassword = password
+	}
+
+	lc, err := r.client.UpdateLdapConfig(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update LDAP config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lc)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LdapConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LdapConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteLdapConfig()
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete LDAP config, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow ldap config resource")
+}
+
+func (r *LdapConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *LdapConfigResource) buildOptions(ctx context.Context, data *LdapConfigResourceModel) (client.LdapConfigOptions, diag.Diagnostics) {
+	mappings := map[string]string{}
+	diags := data.GroupMappings.ElementsAs(ctx, &mappings, false)
+
+	return client.LdapConfigOptions{
+		IsEnabled:         data.Enabled.ValueBool(),
+		ServerUrl:         data.ServerUrl.ValueString(),
+		StartTls:          data.StartTls.ValueBool(),
+		BindDn:            data.BindDn.ValueString(),
+		UserSearchBase:    data.UserSearchBase.ValueString(),
+		UserSearchFilter:  data.UserSearchFilter.ValueString(),
+		GroupSearchBase:   data.GroupSearchBase.ValueStringPointer(),
+		GroupSearchFilter: data.GroupSearchFilter.ValueString(),
+		GroupMappings:     mappings,
+	}, diags
+}
+
+// readPassword returns the bind password, the write-only value being only
+// available in the configuration.
+func (r *LdapConfigResource) readPassword(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var passwordWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("bind_password_wo"), &passwordWo)
+
+	return passwordWo.ValueStringPointer(), diags
+}
+
+func (r *LdapConfigResource) mapResponseToModel(ctx context.Context, data *LdapConfigResourceModel, lc *client.LdapConfig) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(ldapConfigId)
+	data.Enabled = types.BoolValue(lc.IsEnabled)
+	data.ServerUrl = types.StringValue(lc.ServerUrl)
+	data.StartTls = types.BoolValue(lc.StartTls)
+	data.BindDn = types.StringValue(lc.BindDn)
+	data.UserSearchBase = types.StringValue(lc.UserSearchBase)
+	data.UserSearchFilter = types.StringValue(lc.UserSearchFilter)
+	data.GroupSearchBase = types.StringPointerValue(lc.GroupSearchBase)
+	data.GroupSearchFilter = types.StringValue(lc.GroupSearchFilter)
+	// Write-only values are never stored
+	data.BindPasswordWo = types.StringNull()
+
+	// Keep the mappings null when none is set to avoid a diff with the configuration
+	if len(lc.GroupMappings) > 0 {
+		data.GroupMappings, diags = types.MapValueFrom(ctx, types.StringType, lc.GroupMappings)
+	} else {
+		data.GroupMappings = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
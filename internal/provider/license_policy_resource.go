@@ -0,0 +1,370 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LicensePolicyResource{}
+var _ resource.ResourceWithImportState = &LicensePolicyResource{}
+var _ resource.ResourceWithModifyPlan = &LicensePolicyResource{}
+
+func NewLicensePolicyResource() resource.Resource {
+	return &LicensePolicyResource{}
+}
+
+// LicensePolicyResource defines the resource implementation.
+type LicensePolicyResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.SecurityAPI
+	}
+}
+
+// LicensePolicyResourceModel describes the resource data model.
+type LicensePolicyResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	WorkspaceId     types.String `tfsdk:"workspace"`
+	Repository      types.String `tfsdk:"repository"`
+	AllowedLicenses types.Set    `tfsdk:"allowed_licenses"`
+	DeniedLicenses  types.Set    `tfsdk:"denied_licenses"`
+	Action          types.String `tfsdk:"action"`
+	EnforceOn       types.String `tfsdk:"enforce_on"`
+}
+
+func (r *LicensePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_license_policy"
+}
+
+func (r *LicensePolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "License policy resource. Define which package licenses are accepted in a workspace, or in a repository overriding the policy of its workspace, and what happens to the packages violating it. Destroying the resource removes the policy of the workspace, or makes the repository follow its workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository of the workspace (name or Id). The policy applies to the whole workspace when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_licenses": schema.SetAttribute{
+				MarkdownDescription: "SPDX identifiers of the accepted licenses (e.g. `MIT`, `Apache-2.0`), any other license violates the policy. Every license not denied is accepted when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.AtLeastOneOf(path.MatchRoot("denied_licenses")),
+				},
+			},
+			"denied_licenses": schema.SetAttribute{
+				MarkdownDescription: "SPDX identifiers of the licenses violating the policy (e.g. `GPL-3.0-only`, `AGPL-3.0-only`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "What happens to the packages violating the policy: `warn` only reports them, `block` refuses them (default `block`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("block"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("warn", "block"),
+				},
+			},
+			"enforce_on": schema.StringAttribute{
+				MarkdownDescription: "When the policy is checked: `download` also covers the packages already stored or cached from a remote, `upload` only the packages published afterwards (default `download`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("download"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("download", "upload"),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "License policy identifier, in the form `workspaceId` or `workspaceId/repositoryId`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LicensePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LicensePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LicensePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lp, err := r.client.PutLicensePolicy(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set license policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lp)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow license policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicensePolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LicensePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	lp, err := r.client.GetLicensePolicy(workspaceId, repositoryId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get license policy %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicensePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LicensePolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lp, err := r.client.PutLicensePolicy(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update license policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, lp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LicensePolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LicensePolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	if err := r.client.DeleteLicensePolicy(workspaceId, repositoryId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete license policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow license policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *LicensePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repository, _ := strings.Cut(req.ID, "/")
+
+	if workspace == "" || strings.Contains(repository, "/") {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace or workspace/repository. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, workspace, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scopeId(workspaceId, repositoryId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	if repository != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	}
+}
+
+func (r *LicensePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var allowed, denied types.Set
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allowed_licenses"), &allowed)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("denied_licenses"), &denied)...)
+
+	if resp.Diagnostics.HasError() || allowed.IsUnknown() || denied.IsUnknown() {
+		return
+	}
+
+	var allowedLicenses, deniedLicenses []types.String
+	resp.Diagnostics.Append(allowed.ElementsAs(ctx, &allowedLicenses, false)...)
+	resp.Diagnostics.Append(denied.ElementsAs(ctx, &deniedLicenses, false)...)
+
+	// The SPDX identifiers are case insensitive
+	accepted := map[string]bool{}
+	for _, license := range allowedLicenses {
+		if !license.IsUnknown() {
+			accepted[strings.ToLower(license.ValueString())] = true
+		}
+	}
+
+	for _, license := range deniedLicenses {
+		if !license.IsUnknown() && accepted[strings.ToLower(license.ValueString())] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("denied_licenses"),
+				"Invalid parameter",
+				fmt.Sprintf("%q is both allowed and denied.", license.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *LicensePolicyResource) buildOptions(ctx context.Context, data *LicensePolicyResourceModel) (client.LicensePolicyOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list allows or denies nothing
+	opts := client.LicensePolicyOptions{
+		AllowedLicenses: []string{},
+		DeniedLicenses:  []string{},
+		Action:          data.Action.ValueString(),
+		EnforceOn:       data.EnforceOn.ValueString(),
+	}
+	diags.Append(data.AllowedLicenses.ElementsAs(ctx, &opts.AllowedLicenses, false)...)
+	diags.Append(data.DeniedLicenses.ElementsAs(ctx, &opts.DeniedLicenses, false)...)
+
+	return opts, diags
+}
+
+func (r *LicensePolicyResource) mapResponseToModel(ctx context.Context, data *LicensePolicyResourceModel, lp *client.LicensePolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	repositoryId := ""
+	if lp.RepositoryId != nil {
+		repositoryId = *lp.RepositoryId
+	}
+
+	// The workspace and the repository are kept as configured (name or Id)
+	data.Id = types.StringValue(scopeId(lp.WorkspaceId, repositoryId))
+	data.Action = types.StringValue(lp.Action)
+	data.EnforceOn = types.StringValue(lp.EnforceOn)
+
+	// Keep the lists null when empty to avoid a diff with the configuration
+	var d diag.Diagnostics
+	data.AllowedLicenses, d = stringSetOrNull(ctx, lp.AllowedLicenses)
+	diags.Append(d...)
+	data.DeniedLicenses, d = stringSetOrNull(ctx, lp.DeniedLicenses)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The login message is a singleton, so the state always uses the same identifier.
+const loginMessageId = "login_message"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &LoginMessageResource{}
+var _ resource.ResourceWithImportState = &LoginMessageResource{}
+
+func NewLoginMessageResource() resource.Resource {
+	return &LoginMessageResource{}
+}
+
+// LoginMessageResource defines the resource implementation.
+type LoginMessageResource struct {
+	client client.InstanceAPI
+}
+
+// LoginMessageResourceModel describes the resource data model.
+type LoginMessageResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	Title                  types.String `tfsdk:"title"`
+	Message                types.String `tfsdk:"message"`
+	Enabled                types.Bool   `tfsdk:"enabled"`
+	RequireAcknowledgement types.Bool   `tfsdk:"require_acknowledgement"`
+}
+
+func (r *LoginMessageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_login_message"
+}
+
+func (r *LoginMessageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Login message resource. Manage the banner displayed on the instance login page (only one per instance).",
+
+		Attributes: map[string]schema.Attribute{
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Message displayed on the login page.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title displayed above the message.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the login message is displayed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"require_acknowledgement": schema.BoolAttribute{
+				MarkdownDescription: "Whether users must acknowledge the message before login.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Login message identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *LoginMessageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *LoginMessageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data LoginMessageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lm, err := r.client.UpdateLoginMessage(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create login message, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, lm)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow login message resource", map[string]interface{}{
+		"enabled": lm.IsEnabled,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LoginMessageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data LoginMessageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lm, err := r.client.GetLoginMessage()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get login message, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, lm)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "get a repoflow login message resource", map[string]interface{}{
+		"enabled": lm.IsEnabled,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LoginMessageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data LoginMessageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	lm, err := r.client.UpdateLoginMessage(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update login message, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, lm)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *LoginMessageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data LoginMessageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteLoginMessage(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete login message, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow login message resource")
+}
+
+func (r *LoginMessageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *LoginMessageResource) buildOptions(data *LoginMessageResourceModel) client.LoginMessageOptions {
+	return client.LoginMessageOptions{
+		Title:                  data.Title.ValueString(),
+		Message:                data.Message.ValueString(),
+		IsEnabled:              data.Enabled.ValueBool(),
+		RequireAcknowledgement: data.RequireAcknowledgement.ValueBool(),
+	}
+}
+
+func (r *LoginMessageResource) mapResponseToModel(data *LoginMessageResourceModel, lm *client.LoginMessage) {
+	data.Id = types.StringValue(loginMessageId)
+	data.Message = types.StringValue(lm.Message)
+	data.Enabled = types.BoolValue(lm.IsEnabled)
+	data.RequireAcknowledgement = types.BoolValue(lm.RequireAcknowledgement)
+
+	// Keep the title null when it is not set to avoid a diff with the configuration
+	if lm.Title != "" {
+		data.Title = types.StringValue(lm.Title)
+	} else {
+		data.Title = types.StringNull()
+	}
+}
@@ -0,0 +1,278 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MaintenanceWindowResource{}
+var _ resource.ResourceWithImportState = &MaintenanceWindowResource{}
+
+func NewMaintenanceWindowResource() resource.Resource {
+	return &MaintenanceWindowResource{}
+}
+
+// MaintenanceWindowResource defines the resource implementation.
+type MaintenanceWindowResource struct {
+	client client.InstanceAPI
+}
+
+// MaintenanceWindowResourceModel describes the resource data model.
+type MaintenanceWindowResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Schedule        types.String `tfsdk:"schedule"`
+	DurationMinutes types.Int64  `tfsdk:"duration_minutes"`
+	Jobs            types.Set    `tfsdk:"jobs"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *MaintenanceWindowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_maintenance_window"
+}
+
+func (r *MaintenanceWindowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Maintenance window resource. Define when the heavy background jobs of the instance are allowed to run. Once a window allows a job, the job only runs during the enabled windows allowing it: e.g. a `repoflow_gc_schedule` run due outside of them waits for the next window. The jobs allowed by no window run whenever they are due.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the maintenance window.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression of the start of the window (UTC), e.g. `0 22 * * 6` every Saturday at 22:00.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronRegexp, "must be a cron expression with five fields"),
+				},
+			},
+			"duration_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Length of the window in minutes. The jobs still running at its end are paused until the next window.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"jobs": schema.SetAttribute{
+				MarkdownDescription: "Background jobs allowed during the window: `gc` (garbage collection), `reindex` (package metadata rebuild), `replication` (push mirrors and failover synchronization) or `cleanup` (cleanup and retention policies).",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						client.MaintenanceJobGc,
+						client.MaintenanceJobReindex,
+						client.MaintenanceJobReplication,
+						client.MaintenanceJobCleanup,
+					)),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the window is open on its schedule (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Maintenance window identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MaintenanceWindowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MaintenanceWindowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MaintenanceWindowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mw, err := r.client.CreateMaintenanceWindow(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create maintenance window, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, mw)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow maintenance window resource", map[string]interface{}{
+		"id":       mw.Id,
+		"schedule": mw.Schedule,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MaintenanceWindowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowId := data.Id.ValueString()
+
+	mw, err := r.client.GetMaintenanceWindow(windowId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get maintenance window %s, got error: %s", windowId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, mw)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MaintenanceWindowResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mw, err := r.client.UpdateMaintenanceWindow(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update maintenance window, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, mw)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MaintenanceWindowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MaintenanceWindowResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	windowId := data.Id.ValueString()
+
+	if err := r.client.DeleteMaintenanceWindow(windowId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete maintenance window, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow maintenance window resource", map[string]interface{}{
+		"id": windowId,
+	})
+}
+
+func (r *MaintenanceWindowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MaintenanceWindowResource) buildOptions(ctx context.Context, data *MaintenanceWindowResourceModel) (client.MaintenanceWindowOptions, diag.Diagnostics) {
+	opts := client.MaintenanceWindowOptions{
+		Name:            data.Name.ValueString(),
+		Schedule:        data.Schedule.ValueString(),
+		DurationMinutes: int(data.DurationMinutes.ValueInt64()),
+		IsEnabled:       data.Enabled.ValueBool(),
+	}
+	diags := data.Jobs.ElementsAs(ctx, &opts.Jobs, false)
+
+	return opts, diags
+}
+
+func (r *MaintenanceWindowResource) mapResponseToModel(ctx context.Context, data *MaintenanceWindowResourceModel, mw *client.MaintenanceWindow) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(mw.Id)
+	data.Name = types.StringValue(mw.Name)
+	data.Schedule = types.StringValue(mw.Schedule)
+	data.DurationMinutes = types.Int64Value(int64(mw.DurationMinutes))
+	data.Enabled = types.BoolValue(mw.IsEnabled)
+
+	data.Jobs, diags = types.SetValueFrom(ctx, types.StringType, mw.Jobs)
+
+	return diags
+}
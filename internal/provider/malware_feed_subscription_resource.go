@@ -0,0 +1,262 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MalwareFeedSubscriptionResource{}
+var _ resource.ResourceWithImportState = &MalwareFeedSubscriptionResource{}
+
+func NewMalwareFeedSubscriptionResource() resource.Resource {
+	return &MalwareFeedSubscriptionResource{}
+}
+
+// MalwareFeedSubscriptionResource defines the resource implementation.
+type MalwareFeedSubscriptionResource struct {
+	client client.SecurityAPI
+}
+
+// MalwareFeedSubscriptionResourceModel describes the resource data model.
+type MalwareFeedSubscriptionResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Feed          types.String `tfsdk:"feed"`
+	ApiKey        types.String `tfsdk:"api_key_wo"`
+	ApiKeyVersion types.Int64  `tfsdk:"api_key_wo_version"`
+	Enabled       types.Bool   `tfsdk:"enabled"`
+	Action        types.String `tfsdk:"action"`
+	LastSyncedAt  types.String `tfsdk:"last_synced_at"`
+}
+
+func (r *MalwareFeedSubscriptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_malware_feed_subscription"
+}
+
+func (r *MalwareFeedSubscriptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Malware feed subscription resource. Subscribe the instance to a malicious package intelligence feed used by the dependency firewall. The feed API key is write-only and never stored in the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"feed": schema.StringAttribute{
+				MarkdownDescription: "Name of the intelligence feed.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"api_key_wo": schema.StringAttribute{
+				MarkdownDescription: "API key used to pull the feed, for feeds requiring one.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"api_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the API key. Change it to send a new `api_key_wo`.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the feed is pulled (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"action": schema.StringAttribute{
+				MarkdownDescription: "Action taken on packages reported by the feed, `block` or `warn` (default `block`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("block"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("block", "warn"),
+				},
+			},
+			"last_synced_at": schema.StringAttribute{
+				MarkdownDescription: "Date of the last successful feed synchronization (RFC 3339).",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Feed subscription identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MalwareFeedSubscriptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MalwareFeedSubscriptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MalwareFeedSubscriptionResourceModel
+	var apiKey types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.MalwareFeedSubscriptionOptions{
+		Feed:    data.Feed.ValueString(),
+		ApiKey:  apiKey.ValueString(),
+		Enabled: data.Enabled.ValueBool(),
+		Action:  data.Action.ValueString(),
+	}
+
+	sub, err := r.client.CreateMalwareFeedSubscription(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create malware feed subscription, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sub)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow malware feed subscription resource", map[string]interface{}{
+		"id":   sub.Id,
+		"feed": sub.Feed,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MalwareFeedSubscriptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MalwareFeedSubscriptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptionId := data.Id.ValueString()
+
+	sub, err := r.client.GetMalwareFeedSubscription(subscriptionId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get malware feed subscription %s, got error: %s", subscriptionId, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sub)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MalwareFeedSubscriptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MalwareFeedSubscriptionResourceModel
+	var apiKey types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("api_key_wo"), &apiKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The API keeps the current key when none is sent
+	opts := client.MalwareFeedSubscriptionOptions{
+		ApiKey:  apiKey.ValueString(),
+		Enabled: data.Enabled.ValueBool(),
+		Action:  data.Action.ValueString(),
+	}
+
+	sub, err := r.client.UpdateMalwareFeedSubscription(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update malware feed subscription, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sub)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MalwareFeedSubscriptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MalwareFeedSubscriptionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	subscriptionId := data.Id.ValueString()
+
+	if err := r.client.DeleteMalwareFeedSubscription(subscriptionId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete malware feed subscription, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow malware feed subscription resource", map[string]interface{}{
+		"id": subscriptionId,
+	})
+}
+
+func (r *MalwareFeedSubscriptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MalwareFeedSubscriptionResource) mapResponseToModel(data *MalwareFeedSubscriptionResourceModel, sub *client.MalwareFeedSubscription) {
+	data.Id = types.StringValue(sub.Id)
+	data.Feed = types.StringValue(sub.Feed)
+	data.Enabled = types.BoolValue(sub.Enabled)
+	data.Action = types.StringValue(sub.Action)
+	data.LastSyncedAt = types.StringPointerValue(sub.LastSyncedAt)
+	// Never store the API key
+	data.ApiKey = types.StringNull()
+}
@@ -0,0 +1,35 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// managedByMarker is written on the workspaces and repositories created by the provider,
+// to tell them apart from the hand-made ones.
+const managedByMarker = "terraform"
+
+// checkManagedBy warns when an object does not carry the marker, like one created by hand
+// and imported, or one claimed by another tool.
+func checkManagedBy(kind string, name string, managedBy *string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch {
+	case managedBy == nil || *managedBy == "":
+		diags.AddAttributeWarning(
+			path.Root("managed_by"),
+			"Missing Managed-By Marker",
+			fmt.Sprintf("The %s %s has no managed_by marker, it was probably created outside of Terraform. The next apply writes the marker.", kind, name),
+		)
+	case *managedBy != managedByMarker:
+		diags.AddAttributeWarning(
+			path.Root("managed_by"),
+			"Different Managed-By Marker",
+			fmt.Sprintf("The %s %s is marked as managed by %q, another tool may manage it too. The next apply replaces the marker with %q.", kind, name, *managedBy, managedByMarker),
+		)
+	}
+
+	return diags
+}
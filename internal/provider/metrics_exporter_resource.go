@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The metrics exporter is a singleton, so the state always uses the same identifier.
+const metricsExporterId = "metrics_exporter"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MetricsExporterResource{}
+var _ resource.ResourceWithImportState = &MetricsExporterResource{}
+
+func NewMetricsExporterResource() resource.Resource {
+	return &MetricsExporterResource{}
+}
+
+// MetricsExporterResource defines the resource implementation.
+type MetricsExporterResource struct {
+	client client.InstanceAPI
+}
+
+// MetricsExporterResourceModel describes the resource data model.
+type MetricsExporterResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	MetricGroups         types.Set    `tfsdk:"metric_groups"`
+	ScrapeTokenWo        types.String `tfsdk:"scrape_token_wo"`
+	ScrapeTokenWoVersion types.Int64  `tfsdk:"scrape_token_wo_version"`
+	EndpointUrl          types.String `tfsdk:"endpoint_url"`
+}
+
+func (r *MetricsExporterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_metrics_exporter"
+}
+
+func (r *MetricsExporterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Metrics exporter resource. Expose the metrics of the instance on a Prometheus endpoint (only one per instance), optionally protected by a scrape token sent as a bearer token. Destroying the resource disables the endpoint and removes its token.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the metrics are exposed (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"metric_groups": schema.SetAttribute{
+				MarkdownDescription: "Groups of metrics exposed: `http` (requests and latencies), `storage` (usage of the storage backends), `repositories` (packages and downloads per repository), `jobs` (background jobs) or `runtime` (process and memory). Every group is exposed when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						client.MetricGroupHttp,
+						client.MetricGroupStorage,
+						client.MetricGroupRepositories,
+						client.MetricGroupJobs,
+						client.MetricGroupRuntime,
+					)),
+				},
+			},
+			"scrape_token_wo": schema.StringAttribute{
+				MarkdownDescription: "Bearer token Prometheus must send to scrape the endpoint, never stored in the state. The endpoint is public until a token is set. Only sent on creation and when `scrape_token_wo_version` changes.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(16),
+				},
+			},
+			"scrape_token_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the scrape token. Change it to send a new `scrape_token_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("scrape_token_wo")),
+				},
+			},
+			"endpoint_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the endpoint scraped by Prometheus.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Metrics exporter identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MetricsExporterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MetricsExporterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MetricsExporterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	scrapeToken, diags := r.readScrapeToken(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts.ScrapeToken = scrapeToken
+
+	me, err := r.client.UpdateMetricsExporter(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create metrics exporter, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, me)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow metrics exporter resource", map[string]interface{}{
+		"enabled":      me.IsEnabled,
+		"endpoint_url": me.EndpointUrl,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetricsExporterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MetricsExporterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	me, err := r.client.GetMetricsExporter()
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get metrics exporter, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, me)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetricsExporterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state MetricsExporterResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the scrape token when its version changed
+	if !data.ScrapeTokenWoVersion.Equal(state.ScrapeTokenWoVersion) {
+		scrapeToken, diags := r.readScrapeToken(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.ScrapeToken = scrapeToken
+	}
+
+	me, err := r.client.UpdateMetricsExporter(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update metrics exporter, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, me)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MetricsExporterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MetricsExporterResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteMetricsExporter(); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete metrics exporter, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow metrics exporter resource")
+}
+
+func (r *MetricsExporterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MetricsExporterResource) buildOptions(ctx context.Context, data *MetricsExporterResourceModel) (client.MetricsExporterOptions, diag.Diagnostics) {
+	// An empty list exposes every group
+	opts := client.MetricsExporterOptions{
+		IsEnabled:    data.Enabled.ValueBool(),
+		MetricGroups: []string{},
+	}
+	diags := data.MetricGroups.ElementsAs(ctx, &opts.MetricGroups, false)
+
+	return opts, diags
+}
+
+// readScrapeToken returns the scrape token, the write-only value being only
+// available in the configuration.
+func (r *MetricsExporterResource) readScrapeToken(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var scrapeTokenWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("scrape_token_wo"), &scrapeTokenWo)
+
+	return scrapeTokenWo.ValueStringPointer(), diags
+}
+
+func (r *MetricsExporterResource) mapResponseToModel(ctx context.Context, data *MetricsExporterResourceModel, me *client.MetricsExporter) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(metricsExporterId)
+	data.Enabled = types.BoolValue(me.IsEnabled)
+	data.EndpointUrl = types.StringValue(me.EndpointUrl)
+	// Write-only values are never stored
+	data.ScrapeTokenWo = types.StringNull()
+
+	// Keep the list null when empty to avoid a diff with the configuration
+	data.MetricGroups, diags = stringSetOrNull(ctx, me.MetricGroups)
+
+	return diags
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The MFA enforcement is a singleton, so the state always uses the same identifier.
+const mfaEnforcementId = "mfa_enforcement"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &MfaEnforcementResource{}
+var _ resource.ResourceWithImportState = &MfaEnforcementResource{}
+var _ resource.ResourceWithModifyPlan = &MfaEnforcementResource{}
+
+func NewMfaEnforcementResource() resource.Resource {
+	return &MfaEnforcementResource{}
+}
+
+// MfaEnforcementResource defines the resource implementation.
+type MfaEnforcementResource struct {
+	client client.IdentityAPI
+}
+
+// MfaEnforcementResourceModel describes the resource data model.
+type MfaEnforcementResourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	Scope            types.String `tfsdk:"scope"`
+	Groups           types.Set    `tfsdk:"groups"`
+	ExemptPrincipals types.Set    `tfsdk:"exempt_principals"`
+	GracePeriodDays  types.Int64  `tfsdk:"grace_period_days"`
+}
+
+func (r *MfaEnforcementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mfa_enforcement"
+}
+
+func (r *MfaEnforcementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "MFA enforcement resource. Require a second factor at login for every user of the instance, or for the members of some groups (only one per instance). Destroying the resource makes the second factor optional again.",
+
+		Attributes: map[string]schema.Attribute{
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "Users requiring a second factor: `instance` for every user, `groups` for the members of the `groups`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(client.MfaScopeInstance, client.MfaScopeGroups),
+				},
+			},
+			"groups": schema.SetAttribute{
+				MarkdownDescription: "Groups whose members require a second factor, only with the `groups` scope.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"exempt_principals": schema.SetAttribute{
+				MarkdownDescription: "Users and groups never asked for a second factor, e.g. a break-glass account, in the form `user:<name>` or `group:<name>`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(principalRegexp, "must be in the form user:<name> or group:<name>")),
+				},
+			},
+			"grace_period_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the users without a second factor can still log in to enroll one (default `0`, the enrollment is required at the next login).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "MFA enforcement identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *MfaEnforcementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MfaEnforcementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MfaEnforcementResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	me, err := r.client.UpdateMfaEnforcement(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MFA enforcement, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, me)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow MFA enforcement resource", map[string]interface{}{
+		"scope": me.Scope,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MfaEnforcementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MfaEnforcementResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	me, err := r.client.GetMfaEnforcement()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get MFA enforcement, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, me)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MfaEnforcementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MfaEnforcementResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	me, err := r.client.UpdateMfaEnforcement(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MFA enforcement, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, me)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MfaEnforcementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MfaEnforcementResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteMfaEnforcement()
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete MFA enforcement, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow MFA enforcement resource")
+}
+
+func (r *MfaEnforcementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *MfaEnforcementResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data MfaEnforcementResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Scope.IsUnknown() || data.Groups.IsUnknown() {
+		return
+	}
+
+	// The groups are required by the groups scope, and meaningless otherwise
+	switch {
+	case data.Scope.ValueString() == client.MfaScopeGroups && data.Groups.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("groups"),
+			"Missing parameter",
+			fmt.Sprintf("`groups` is required with the `%s` scope.", client.MfaScopeGroups),
+		)
+	case data.Scope.ValueString() != client.MfaScopeGroups && !data.Groups.IsNull():
+		resp.Diagnostics.AddAttributeError(
+			path.Root("groups"),
+			"Invalid parameter",
+			fmt.Sprintf("`groups` is only supported with the `%s` scope.", client.MfaScopeGroups),
+		)
+	}
+}
+
+func (r *MfaEnforcementResource) buildOptions(ctx context.Context, data *MfaEnforcementResourceModel) (client.MfaEnforcementOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list requires no group or exempts nobody
+	opts := client.MfaEnforcementOptions{
+		Scope:            data.Scope.ValueString(),
+		Groups:           []string{},
+		ExemptPrincipals: []string{},
+		GracePeriodDays:  int(data.GracePeriodDays.ValueInt64()),
+	}
+	diags.Append(data.Groups.ElementsAs(ctx, &opts.Groups, false)...)
+	diags.Append(data.ExemptPrincipals.ElementsAs(ctx, &opts.ExemptPrincipals, false)...)
+
+	return opts, diags
+}
+
+func (r *MfaEnforcementResource) mapResponseToModel(ctx context.Context, data *MfaEnforcementResourceModel, me *client.MfaEnforcement) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(mfaEnforcementId)
+	data.Scope = types.StringValue(me.Scope)
+	data.GracePeriodDays = types.Int64Value(int64(me.GracePeriodDays))
+
+	// Keep the lists null when empty to avoid a diff with the configuration
+	var d diag.Diagnostics
+	data.Groups, d = stringSetOrNull(ctx, me.Groups)
+	diags.Append(d...)
+	data.ExemptPrincipals, d = stringSetOrNull(ctx, me.ExemptPrincipals)
+	diags.Append(d...)
+
+	return diags
+}
@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Actions of a migration plan on the target instance
+const (
+	migrationCreate = "create"
+	migrationUpdate = "update"
+	migrationDelete = "delete"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &MigrationPlanDataSource{}
+
+func NewMigrationPlanDataSource() datasource.DataSource {
+	return &MigrationPlanDataSource{}
+}
+
+// MigrationPlanDataSource defines the data source implementation.
+type MigrationPlanDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.InstanceAPI
+	}
+}
+
+type MigrationPlanDataSourceModel struct {
+	TargetBaseURL types.String         `tfsdk:"target_base_url"`
+	TargetApiKey  types.String         `tfsdk:"target_api_key"`
+	Workspaces    []string             `tfsdk:"workspaces"`
+	InSync        types.Bool           `tfsdk:"in_sync"`
+	Differences   []MigrationStepModel `tfsdk:"differences"`
+}
+
+type MigrationStepModel struct {
+	Kind       types.String `tfsdk:"kind"`
+	Workspace  types.String `tfsdk:"workspace"`
+	Name       types.String `tfsdk:"name"`
+	Action     types.String `tfsdk:"action"`
+	Attributes []string     `tfsdk:"attributes"`
+}
+
+func (d *MigrationPlanDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_migration_plan"
+}
+
+func (d *MigrationPlanDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Migration plan data source. Compare the workspaces and repositories of the configured instance with a second instance, e.g. the green instance of a blue/green upgrade, and list what the target misses or has differently. The objects are matched by name, their Ids differ between instances.",
+
+		Attributes: map[string]schema.Attribute{
+			"target_base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the instance to compare with.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(baseURLRegexp, "must be an http or https URL like https://repoflow.example.com/api"),
+				},
+			},
+			"target_api_key": schema.StringAttribute{
+				MarkdownDescription: "API key of the instance to compare with.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"workspaces": schema.ListAttribute{
+				MarkdownDescription: "Names of the workspaces to compare. Every workspace of both instances when unset, the workspaces only on the target being reported for deletion.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"in_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether the target matches the configured instance.",
+				Computed:            true,
+			},
+			"differences": schema.ListNestedAttribute{
+				MarkdownDescription: "Changes to apply on the target to match the configured instance, the workspaces before their repositories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of object, `workspace` or `repository`.",
+							Computed:            true,
+						},
+						"workspace": schema.StringAttribute{
+							MarkdownDescription: "Workspace name",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Object name",
+							Computed:            true,
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Change on the target: `create` (missing), `update` (different settings) or `delete` (only on the target).",
+							Computed:            true,
+						},
+						"attributes": schema.ListAttribute{
+							MarkdownDescription: "Settings to update, empty for the other actions.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *MigrationPlanDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *MigrationPlanDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MigrationPlanDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The target gets the transports and headers of the configured client
+	target := d.client.Instance([]string{data.TargetBaseURL.ValueString()}, data.TargetApiKey.ValueString())
+
+	// Every workspace of both instances by default
+	names := data.Workspaces
+	compareAll := names == nil
+	if compareAll {
+		var err error
+		if names, err = workspaceNames(d.client); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workspaces, got error: %s", err))
+			return
+		}
+	}
+
+	data.Differences = []MigrationStepModel{}
+
+	for _, name := range names {
+		steps, err := migrationSteps(d.client, target, name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to compare workspace %s, got error: %s", name, err))
+			return
+		}
+		data.Differences = append(data.Differences, steps...)
+	}
+
+	if compareAll {
+		targetNames, err := workspaceNames(target)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workspaces of %s, got error: %s", data.TargetBaseURL.ValueString(), err))
+			return
+		}
+
+		for _, name := range targetNames {
+			if !slices.Contains(names, name) {
+				data.Differences = append(data.Differences, migrationStep("workspace", name, name, migrationDelete, nil))
+			}
+		}
+	}
+
+	data.InSync = types.BoolValue(len(data.Differences) == 0)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read migration plan data", map[string]interface{}{
+		"target":      data.TargetBaseURL.ValueString(),
+		"workspaces":  len(names),
+		"differences": len(data.Differences),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// workspaceNames returns the names of the workspaces of an instance.
+func workspaceNames(c client.WorkspacesAPI) ([]string, error) {
+	wss, err := c.ListWorkspaces()
+	if err != nil {
+		return nil, err
+	}
+
+	names := []string{}
+	for _, ws := range *wss {
+		names = append(names, ws.Name)
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// migrationSteps compares a workspace and its repositories between the source and the target.
+func migrationSteps(source repositoryResolver, target repositoryResolver, name string) ([]MigrationStepModel, error) {
+	steps := []MigrationStepModel{}
+
+	ws, err := source.GetWorkspace(name)
+	if err != nil {
+		return nil, err
+	}
+
+	sourceRepositories, err := repositoriesByName(source, ws.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	targetWs, err := target.GetWorkspace(name)
+	if client.IsNotFound(err) {
+		// The whole workspace is missing
+		steps = append(steps, migrationStep("workspace", name, name, migrationCreate, nil))
+		for _, rpName := range slices.Sorted(maps.Keys(sourceRepositories)) {
+			steps = append(steps, migrationStep("repository", name, rpName, migrationCreate, nil))
+		}
+		return steps, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	if attributes := settingsDiff(workspaceSettings(ws), workspaceSettings(targetWs)); len(attributes) > 0 {
+		steps = append(steps, migrationStep("workspace", name, name, migrationUpdate, attributes))
+	}
+
+	targetRepositories, err := repositoriesByName(target, targetWs.Id)
+	if err != nil {
+		return nil, fmt.Errorf("target: %w", err)
+	}
+
+	for _, rpName := range slices.Sorted(maps.Keys(sourceRepositories)) {
+		targetRp, ok := targetRepositories[rpName]
+		if !ok {
+			steps = append(steps, migrationStep("repository", name, rpName, migrationCreate, nil))
+			continue
+		}
+
+		if attributes := settingsDiff(repositorySettings(sourceRepositories[rpName]), repositorySettings(targetRp)); len(attributes) > 0 {
+			steps = append(steps, migrationStep("repository", name, rpName, migrationUpdate, attributes))
+		}
+	}
+
+	for _, rpName := range slices.Sorted(maps.Keys(targetRepositories)) {
+		if _, ok := sourceRepositories[rpName]; !ok {
+			steps = append(steps, migrationStep("repository", name, rpName, migrationDelete, nil))
+		}
+	}
+
+	return steps, nil
+}
+
+// repositoriesByName reads every repository of a workspace.
+func repositoriesByName(c client.RepositoriesAPI, workspaceId string) (map[string]*client.Repository, error) {
+	rps, err := c.ListRepositories(workspaceId)
+	if err != nil {
+		return nil, err
+	}
+
+	repositories := map[string]*client.Repository{}
+	for _, item := range *rps {
+		rp, err := c.GetRepository(workspaceId, item.Id)
+		if err != nil {
+			return nil, err
+		}
+		repositories[rp.Name] = rp
+	}
+
+	return repositories, nil
+}
+
+func migrationStep(kind string, workspace string, name string, action string, attributes []string) MigrationStepModel {
+	if attributes == nil {
+		attributes = []string{}
+	}
+
+	return MigrationStepModel{
+		Kind:       types.StringValue(kind),
+		Workspace:  types.StringValue(workspace),
+		Name:       types.StringValue(name),
+		Action:     types.StringValue(action),
+		Attributes: attributes,
+	}
+}
+
+// settingsDiff returns the sorted names of the settings with different values.
+func settingsDiff(source map[string]string, target map[string]string) []string {
+	attributes := []string{}
+	for _, k := range slices.Sorted(maps.Keys(source)) {
+		if source[k] != target[k] {
+			attributes = append(attributes, k)
+		}
+	}
+
+	return attributes
+}
+
+// workspaceSettings returns the settings of a workspace compared between instances,
+// named like the attributes of repoflow_workspace.
+func workspaceSettings(ws *client.Workspace) map[string]string {
+	labels := []string{}
+	for _, k := range slices.Sorted(maps.Keys(ws.Labels)) {
+		labels = append(labels, k+"="+ws.Labels[k])
+	}
+
+	return map[string]string{
+		"description":                  settingValue(ws.Description),
+		"labels":                       strings.Join(labels, ","),
+		"storage_quota_bytes":          settingValue(ws.StorageLimitInByte),
+		"default_remote_cache_enabled": settingValue(ws.DefaultRemoteCacheEnabled),
+		"default_file_cache_ttl":       settingValue(ws.DefaultFileCacheTtl),
+		"default_metadata_cache_ttl":   settingValue(ws.DefaultMetadataCacheTtl),
+		"default_member_role":          settingValue(ws.DefaultMemberRole),
+		"audit_retention_days":         settingValue(ws.AuditRetentionDays),
+	}
+}
+
+// repositorySettings returns the settings of a repository compared between instances,
+// the child repositories by name as their Ids differ.
+func repositorySettings(rp *client.Repository) map[string]string {
+	children := []string{}
+	for _, child := range rp.ChildRepositories {
+		children = append(children, child.Name)
+	}
+	sort.Strings(children)
+
+	return map[string]string{
+		"repository_type":      rp.RepositoryType,
+		"package_type":         rp.PackageType,
+		"remote.url":           settingValue(rp.RemoteRepositoryUrl),
+		"remote.cache_enabled": fmt.Sprint(rp.IsRemoteCacheEnabled),
+		"remote.file_cache_time_till_revalidation":     settingValue(rp.FileCacheTimeTillRevalidation),
+		"remote.metadata_cache_time_till_revalidation": settingValue(rp.MetadataCacheTimeTillRevalidation),
+		"virtual.child_repository_ids":                 strings.Join(children, ","),
+		"virtual.upload_local_repository_id":           rp.UploadTargetLocalRepository.Name,
+		"virtual.deployment_policy":                    settingValue(rp.DeploymentPolicy),
+	}
+}
+
+// settingValue formats an optional setting, unset ones being empty.
+func settingValue[T any](v *T) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*v)
+}
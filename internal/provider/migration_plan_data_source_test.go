@@ -0,0 +1,70 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/client/fake"
+)
+
+func TestMigrationPlanDataSource(t *testing.T) {
+	p := newTestProvider(t)
+	target := p.client.Instance([]string{"https://green.example.com/api"}, "green-key").(*fake.Client)
+
+	// The source holds a workspace with two repositories
+	workspaceId := newTestWorkspace(t, p, "platform")
+	for _, name := range []string{"npm-local", "pypi-local"} {
+		if _, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+			RepositoryOptions: repoflow.RepositoryOptions{Name: name, PackageType: "npm"},
+		}); err != nil {
+			t.Fatalf("CreateLocalRepository: %s", err)
+		}
+	}
+
+	// The target misses a repository, and has a workspace of its own
+	for _, name := range []string{"platform", "legacy"} {
+		if _, err := target.CreateWorkspace(client.WorkspaceOptions{WorkspaceOptions: repoflow.WorkspaceOptions{Name: name}}); err != nil {
+			t.Fatalf("CreateWorkspace: %s", err)
+		}
+	}
+	if _, err := target.CreateLocalRepository("platform", client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+	}); err != nil {
+		t.Fatalf("CreateLocalRepository: %s", err)
+	}
+
+	config := func(baseURL string) tftypes.Value {
+		return p.dataConfig("repoflow_migration_plan", map[string]tftypes.Value{
+			"target_base_url": str(baseURL),
+			"target_api_key":  str("green-key"),
+		})
+	}
+
+	state := p.readData("repoflow_migration_plan", config("https://green.example.com/api"))
+
+	var differences []tftypes.Value
+	if err := valueAt(t, state, "differences").As(&differences); err != nil {
+		t.Fatalf("differences: %s", err)
+	}
+	got := []string{}
+	for _, d := range differences {
+		got = append(got, stringAttr(t, d, "action")+" "+stringAttr(t, d, "kind")+" "+stringAttr(t, d, "workspace")+"/"+stringAttr(t, d, "name"))
+	}
+	if want := []string{"create repository platform/pypi-local", "delete workspace legacy/legacy"}; !slices.Equal(got, want) {
+		t.Errorf("differences = %v, want %v", got, want)
+	}
+
+	var inSync bool
+	if err := valueAt(t, state, "in_sync").As(&inSync); err != nil || inSync {
+		t.Errorf("in_sync = %v (%v), want false", inSync, err)
+	}
+
+	// The target base URL is checked like the ones of the provider
+	if _, diags := p.tryReadData("repoflow_migration_plan", config("green.example.com")); !hasError(diags) {
+		t.Error("target base URL without scheme accepted")
+	}
+}
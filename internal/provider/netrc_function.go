@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &NetrcFunction{}
+
+func NewNetrcFunction() function.Function {
+	return &NetrcFunction{}
+}
+
+// NetrcFunction defines the function implementation.
+type NetrcFunction struct{}
+
+func (f *NetrcFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "netrc"
+}
+
+func (f *NetrcFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Render a netrc entry",
+		MarkdownDescription: "Render the `machine`/`login`/`password` entry of a `.netrc` file authenticating to a RepoFlow host, e.g. to bootstrap the build agents from a template. The host can be given as a URL like the provider `base_url`, only its hostname is kept.",
+
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "host",
+				MarkdownDescription: "Hostname of the RepoFlow instance, or a URL of it.",
+			},
+			function.StringParameter{
+				Name:                "username",
+				MarkdownDescription: "Login of the entry.",
+			},
+			function.StringParameter{
+				Name:                "token",
+				MarkdownDescription: "Password of the entry, like an access token.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *NetrcFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var host, username, token string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &host, &username, &token))
+
+	if resp.Error != nil {
+		return
+	}
+
+	// Keep the hostname of a URL
+	if strings.Contains(host, "://") {
+		u, err := url.Parse(host)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Invalid host URL: %s", err))
+			return
+		}
+		host = u.Hostname()
+	}
+
+	// The netrc tokens are separated by whitespaces
+	for i, value := range []string{host, username, token} {
+		if value == "" || strings.ContainsAny(value, " \t\r\n") {
+			resp.Error = function.ConcatFuncErrors(resp.Error, function.NewArgumentFuncError(int64(i), "The value must be non-empty and must not contain whitespaces."))
+		}
+	}
+
+	if resp.Error != nil {
+		return
+	}
+
+	entry := fmt.Sprintf("machine %s\nlogin %s\npassword %s\n", host, username, token)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, entry))
+}
@@ -0,0 +1,58 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// runNetrc calls the netrc function with the given arguments.
+func runNetrc(host string, username string, token string) (string, *function.FuncError) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(host),
+			types.StringValue(username),
+			types.StringValue(token),
+		}),
+	}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.StringUnknown()),
+	}
+
+	NewNetrcFunction().Run(context.Background(), req, &resp)
+
+	return resp.Result.Value().(types.String).ValueString(), resp.Error
+}
+
+func TestNetrcFunction(t *testing.T) {
+	for host, want := range map[string]string{
+		"repoflow.example.com":                  "machine repoflow.example.com\nlogin ci\npassword pat_xxx\n",
+		"https://repoflow.example.com/api":      "machine repoflow.example.com\nlogin ci\npassword pat_xxx\n",
+		"https://repoflow.example.com:8443/api": "machine repoflow.example.com\nlogin ci\npassword pat_xxx\n",
+	} {
+		got, err := runNetrc(host, "ci", "pat_xxx")
+		if err != nil {
+			t.Errorf("netrc(%q): %s", host, err)
+			continue
+		}
+		if got != want {
+			t.Errorf("netrc(%q) = %q, want %q", host, got, want)
+		}
+	}
+}
+
+func TestNetrcFunctionInvalid(t *testing.T) {
+	for name, args := range map[string][3]string{
+		"empty host":         {"", "ci", "pat_xxx"},
+		"invalid URL":        {"https://repo flow.example.com", "ci", "pat_xxx"},
+		"login with spaces":  {"repoflow.example.com", "ci bot", "pat_xxx"},
+		"token with newline": {"repoflow.example.com", "ci", "pat_xxx\nmachine evil.example.com"},
+	} {
+		if _, err := runNetrc(args[0], args[1], args[2]); err == nil {
+			t.Errorf("%s accepted", name)
+		}
+	}
+}
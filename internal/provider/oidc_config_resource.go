@@ -0,0 +1,360 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The OIDC single sign-on is a singleton, so the state always uses the same identifier.
+const oidcConfigId = "oidc_config"
+
+var oidcIssuerRegexp = regexp.MustCompile(`^https://`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OidcConfigResource{}
+var _ resource.ResourceWithImportState = &OidcConfigResource{}
+
+func NewOidcConfigResource() resource.Resource {
+	return &OidcConfigResource{}
+}
+
+// OidcConfigResource defines the resource implementation.
+type OidcConfigResource struct {
+	client client.IdentityAPI
+}
+
+// OidcConfigResourceModel describes the resource data model.
+type OidcConfigResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	Enabled               types.Bool   `tfsdk:"enabled"`
+	IssuerUrl             types.String `tfsdk:"issuer_url"`
+	ClientId              types.String `tfsdk:"client_id"`
+	ClientSecretWo        types.String `tfsdk:"client_secret_wo"`
+	ClientSecretWoVersion types.Int64  `tfsdk:"client_secret_wo_version"`
+	Scopes                types.Set    `tfsdk:"scopes"`
+	GroupsClaim           types.String `tfsdk:"groups_claim"`
+	GroupMappings         types.Map    `tfsdk:"group_mappings"`
+	RedirectUri           types.String `tfsdk:"redirect_uri"`
+}
+
+func (r *OidcConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_oidc_config"
+}
+
+func (r *OidcConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "OIDC config resource. Manage the OpenID Connect single sign-on of the instance (only one per instance): the users log in through the identity provider and join the RepoFlow groups mapped to their groups claim, to use as `group:<name>` principal of the role assignments. Register the `redirect_uri` on the identity provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the users can log in through the identity provider.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"issuer_url": schema.StringAttribute{
+				MarkdownDescription: "Issuer URL of the identity provider, its discovery document being served under `/.well-known/openid-configuration`, e.g. `https://login.example.com/realms/main`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(oidcIssuerRegexp, "must be an https:// URL"),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client Id of RepoFlow on the identity provider.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"client_secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Client secret of RepoFlow on the identity provider, never stored in the state. Only sent on creation and when `client_secret_wo_version` changes.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"client_secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the client secret. Change it to send a new `client_secret_wo`.",
+				Optional:            true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes requested on login, default to `openid`, `profile` and `email`. Add the scope releasing the groups claim when the identity provider requires one.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Default: setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{
+					types.StringValue("openid"),
+					types.StringValue("profile"),
+					types.StringValue("email"),
+				})),
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"groups_claim": schema.StringAttribute{
+				MarkdownDescription: "Claim of the ID token listing the groups of the user.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("groups"),
+			},
+			"group_mappings": schema.MapAttribute{
+				MarkdownDescription: "RepoFlow group joined by the users of each group of the claim, keyed by the value of the claim.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"redirect_uri": schema.StringAttribute{
+				MarkdownDescription: "Callback URL of RepoFlow, to register on the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "OIDC config identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OidcConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OidcConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OidcConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	secret, diags := r.readSecret(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts.ClientSecret = secret
+
+	oc, err := r.client.UpdateOidcConfig(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OIDC config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, oc)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow oidc config resource", map[string]interface{}{
+		"issuer_url": oc.IssuerUrl,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OidcConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OidcConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	oc, err := r.client.GetOidcConfig()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get OIDC config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, oc)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "get a repoflow oidc config resource", map[string]interface{}{
+		"issuer_url": oc.IssuerUrl,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OidcConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OidcConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the secret when its version changed
+	if !data.ClientSecretWoVersion.Equal(state.ClientSecretWoVersion) {
+		secret, diags := r.readSecret(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.ClientSecret = secret
+	}
+
+	oc, err := r.client.UpdateOidcConfig(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OIDC config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, oc)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OidcConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OidcConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOidcConfig()
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OIDC config, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow oidc config resource")
+}
+
+func (r *OidcConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *OidcConfigResource) buildOptions(ctx context.Context, data *OidcConfigResourceModel) (client.OidcConfigOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	scopes := []string{}
+	diags.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+	mappings := map[string]string{}
+	diags.Append(data.GroupMappings.ElementsAs(ctx, &mappings, false)...)
+
+	return client.OidcConfigOptions{
+		IsEnabled:     data.Enabled.ValueBool(),
+		IssuerUrl:     data.IssuerUrl.ValueString(),
+		ClientId:      data.ClientId.ValueString(),
+		Scopes:        scopes,
+		GroupsClaim:   data.GroupsClaim.ValueString(),
+		GroupMappings: mappings,
+	}, diags
+}
+
+// readSecret returns the client secret, the write-only value being only
+// available in the configuration.
+func (r *OidcConfigResource) readSecret(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var secretWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("client_secret_wo"), &secretWo)
+
+	return secretWo.ValueStringPointer(), diags
+}
+
+func (r *OidcConfigResource) mapResponseToModel(ctx context.Context, data *OidcConfigResourceModel, oc *client.OidcConfig) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.Id = types.StringValue(oidcConfigId)
+	data.Enabled = types.BoolValue(oc.IsEnabled)
+	data.IssuerUrl = types.StringValue(oc.IssuerUrl)
+	data.ClientId = types.StringValue(oc.ClientId)
+	data.GroupsClaim = types.StringValue(oc.GroupsClaim)
+	data.RedirectUri = types.StringValue(oc.RedirectUri)
+	// Write-only values are never stored
+	data.ClientSecretWo = types.StringNull()
+
+	data.Scopes, d = types.SetValueFrom(ctx, types.StringType, oc.Scopes)
+	diags.Append(d...)
+
+	// Keep the mappings null when none is set to avoid a diff with the configuration
+	if len(oc.GroupMappings) > 0 {
+		data.GroupMappings, d = types.MapValueFrom(ctx, types.StringType, oc.GroupMappings)
+		diags.Append(d...)
+	} else {
+		data.GroupMappings = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,325 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The outbound proxy is a singleton, so the state always uses the same identifier.
+const outboundProxyId = "outbound_proxy"
+
+var proxyUrlRegexp = regexp.MustCompile(`^https?://[^/]+`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &OutboundProxyResource{}
+var _ resource.ResourceWithImportState = &OutboundProxyResource{}
+
+func NewOutboundProxyResource() resource.Resource {
+	return &OutboundProxyResource{}
+}
+
+// OutboundProxyResource defines the resource implementation.
+type OutboundProxyResource struct {
+	client client.InstanceAPI
+}
+
+// OutboundProxyResourceModel describes the resource data model.
+type OutboundProxyResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	HttpProxy         types.String `tfsdk:"http_proxy"`
+	HttpsProxy        types.String `tfsdk:"https_proxy"`
+	NoProxy           types.Set    `tfsdk:"no_proxy"`
+	Username          types.String `tfsdk:"username"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+}
+
+func (r *OutboundProxyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outbound_proxy"
+}
+
+func (r *OutboundProxyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Outbound proxy resource. Manage the proxy of the instance (only one per instance) used by every remote repository to reach its upstream, e.g. when the instance has no direct internet access. Destroying the resource makes the remote repositories reach their upstreams directly.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the remote repositories go through the proxy (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "Proxy of the `http://` upstreams, e.g. `http://proxy.example.com:3128`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(proxyUrlRegexp, "must be an http:// or https:// URL"),
+					stringvalidator.AtLeastOneOf(path.MatchRoot("https_proxy")),
+				},
+			},
+			"https_proxy": schema.StringAttribute{
+				MarkdownDescription: "Proxy of the `https://` upstreams, e.g. `http://proxy.example.com:3128`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(proxyUrlRegexp, "must be an http:// or https:// URL"),
+				},
+			},
+			"no_proxy": schema.SetAttribute{
+				MarkdownDescription: "Upstreams reached directly, as hosts (`nexus.internal`), domains (`.example.com`) or CIDR ranges (`10.0.0.0/8`).",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username authenticating to the proxy.",
+				Optional:            true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password authenticating to the proxy, never stored in the state. Only sent on creation and when `password_wo_version` changes.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("username")),
+				},
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the password. Change it to send a new `password_wo`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("password_wo")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Outbound proxy identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *OutboundProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *OutboundProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data OutboundProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	password, diags := r.readPassword(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts.Password = password
+
+	op, err := r.client.UpdateOutboundProxy(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create outbound proxy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, op)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow outbound proxy resource", map[string]interface{}{
+		"http_proxy":  data.HttpProxy.ValueString(),
+		"https_proxy": data.HttpsProxy.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OutboundProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data OutboundProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	op, err := r.client.GetOutboundProxy()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get outbound proxy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, op)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OutboundProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state OutboundProxyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only send the password when its version changed
+	if !data.PasswordWoVersion.Equal(state.PasswordWoVersion) {
+		password, diags := r.readPassword(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.Password = password
+	}
+
+	op, err := r.client.UpdateOutboundProxy(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update outbound proxy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, op)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *OutboundProxyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data OutboundProxyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteOutboundProxy()
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete outbound proxy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow outbound proxy resource")
+}
+
+func (r *OutboundProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *OutboundProxyResource) buildOptions(ctx context.Context, data *OutboundProxyResourceModel) (client.OutboundProxyOptions, diag.Diagnostics) {
+	// An empty list sends every request through the proxy
+	noProxy := []string{}
+	diags := data.NoProxy.ElementsAs(ctx, &noProxy, false)
+
+	return client.OutboundProxyOptions{
+		IsEnabled:  data.Enabled.ValueBool(),
+		HttpProxy:  data.HttpProxy.ValueStringPointer(),
+		HttpsProxy: data.HttpsProxy.ValueStringPointer(),
+		NoProxy:    noProxy,
+		Username:   data.Username.ValueStringPointer(),
+	}, diags
+}
+
+// readPassword returns the proxy password, the write-only value being only
+// available in the configuration.
+func (r *OutboundProxyResource) readPassword(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var passwordWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)
+
+	return passwordWo.ValueStringPointer(), diags
+}
+
+func (r *OutboundProxyResource) mapResponseToModel(ctx context.Context, data *OutboundProxyResourceModel, op *client.OutboundProxy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(outboundProxyId)
+	data.Enabled = types.BoolValue(op.IsEnabled)
+	data.HttpProxy = types.StringPointerValue(op.HttpProxy)
+	data.HttpsProxy = types.StringPointerValue(op.HttpsProxy)
+	data.Username = types.StringPointerValue(op.Username)
+	// Write-only values are never stored
+	data.PasswordWo = types.StringNull()
+
+	// Keep the list null when empty to avoid a diff with the configuration
+	data.NoProxy, diags = stringSetOrNull(ctx, op.NoProxy)
+
+	return diags
+}
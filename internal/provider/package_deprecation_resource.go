@@ -0,0 +1,319 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PackageDeprecationResource{}
+var _ resource.ResourceWithImportState = &PackageDeprecationResource{}
+
+func NewPackageDeprecationResource() resource.Resource {
+	return &PackageDeprecationResource{}
+}
+
+// PackageDeprecationResource defines the resource implementation.
+type PackageDeprecationResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+// PackageDeprecationResourceModel describes the resource data model.
+type PackageDeprecationResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	PackageName types.String `tfsdk:"package"`
+	Version     types.String `tfsdk:"version"`
+	Message     types.String `tfsdk:"message"`
+}
+
+func (r *PackageDeprecationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_package_deprecation"
+}
+
+func (r *PackageDeprecationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Package deprecation resource. Deprecate package versions with a message surfaced to the clients, like `npm deprecate`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository holding the package (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"package": schema.StringAttribute{
+				MarkdownDescription: "Name of the deprecated package.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version or version range of the deprecated versions (e.g. `<2.0.0`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Deprecation message shown to the clients installing a deprecated version.",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Deprecation identifier, in the form `workspaceId/repositoryId/deprecationId`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PackageDeprecationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PackageDeprecationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PackageDeprecationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+	repository := data.Repository.ValueString()
+
+	// The workspace and the repository can be given by name, resolve their Id
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rp, err := r.client.GetRepository(ws.Id, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository %s on workspaceId %s, got error: %s", repository, ws.Id, err,
+		))
+		return
+	}
+
+	opts := client.PackageDeprecationOptions{
+		PackageName: data.PackageName.ValueString(),
+		Version:     data.Version.ValueString(),
+		Message:     data.Message.ValueString(),
+	}
+
+	pd, err := r.client.CreatePackageDeprecation(ws.Id, rp.Id, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to deprecate package, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{ws.Id, rp.Id, pd.Id}, "/"))
+	r.mapResponseToModel(&data, pd)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow package deprecation resource", map[string]interface{}{
+		"id":      data.Id.ValueString(),
+		"package": pd.PackageName,
+		"version": pd.Version,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageDeprecationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PackageDeprecationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected package deprecation identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	pd, err := r.client.GetPackageDeprecation(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get package deprecation %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pd)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageDeprecationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PackageDeprecationResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the message can change in place
+	opts := client.PackageDeprecationOptions{
+		Message: data.Message.ValueString(),
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected package deprecation identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	pd, err := r.client.UpdatePackageDeprecation(idParts[0], idParts[1], idParts[2], opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update package deprecation, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pd)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageDeprecationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PackageDeprecationResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected package deprecation identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeletePackageDeprecation(idParts[0], idParts[1], idParts[2]); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete package deprecation, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow package deprecation resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *PackageDeprecationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data PackageDeprecationResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/deprecationId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	repository := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rp, err := r.client.GetRepository(ws.Id, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository %s on workspaceId %s, got error: %s", repository, ws.Id, err,
+		))
+		return
+	}
+
+	pd, err := r.client.GetPackageDeprecation(ws.Id, rp.Id, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import package deprecation %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{ws.Id, rp.Id, pd.Id}, "/"))
+	data.WorkspaceId = types.StringValue(workspace)
+	data.Repository = types.StringValue(repository)
+	r.mapResponseToModel(&data, pd)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageDeprecationResource) mapResponseToModel(data *PackageDeprecationResourceModel, pd *client.PackageDeprecation) {
+	data.PackageName = types.StringValue(pd.PackageName)
+	data.Version = types.StringValue(pd.Version)
+	data.Message = types.StringValue(pd.Message)
+}
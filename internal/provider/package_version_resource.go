@@ -0,0 +1,389 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PackageVersionResource{}
+var _ resource.ResourceWithImportState = &PackageVersionResource{}
+
+func NewPackageVersionResource() resource.Resource {
+	return &PackageVersionResource{}
+}
+
+// PackageVersionResource defines the resource implementation.
+type PackageVersionResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+// PackageVersionResourceModel describes the resource data model.
+type PackageVersionResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	PackageName types.String `tfsdk:"package"`
+	Version     types.String `tfsdk:"version"`
+	Properties  types.Map    `tfsdk:"properties"`
+	SizeBytes   types.Int64  `tfsdk:"size_bytes"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+func (r *PackageVersionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_package_version"
+}
+
+func (r *PackageVersionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Package version resource. Assert that a version of a package is published in a repository, manage its properties, and delete it on destroy, e.g. to curate a golden set of packages. The version is not published by the resource, the creation fails when it is missing.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository holding the package (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"package": schema.StringAttribute{
+				MarkdownDescription: "Name of the package, e.g. `@acme/ui` or `requests`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the package, e.g. `1.4.2`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"properties": schema.MapAttribute{
+				MarkdownDescription: "Properties of the version, replacing the existing ones. The properties are left untouched when unset.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the files of the version in bytes.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Publication date of the version (RFC 3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Package version identifier, in the form `workspaceId/repositoryId/package/version`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PackageVersionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PackageVersionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PackageVersionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	packageName := data.PackageName.ValueString()
+	version := data.Version.ValueString()
+
+	pv, err := r.client.GetPackageVersion(workspaceId, repositoryId, packageName, version)
+
+	if client.IsNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Missing Package Version",
+			fmt.Sprintf("Version %s of package %s is not published in repository %s. Publish it before applying, the resource does not upload packages.", version, packageName, repositoryId),
+		)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get version %s of package %s, got error: %s", version, packageName, err))
+		return
+	}
+
+	// Keep the existing properties when they are not managed
+	if !data.Properties.IsUnknown() {
+		opts, diags := r.buildOptions(ctx, &data)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		pv, err = r.client.UpdatePackageVersion(workspaceId, repositoryId, packageName, version, opts)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update version %s of package %s, got error: %s", version, packageName, err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, packageName, version}, "/"))
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pv)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow package version resource", map[string]interface{}{
+		"id":      data.Id.ValueString(),
+		"package": pv.PackageName,
+		"version": pv.Version,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageVersionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PackageVersionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, packageName, version, ok := splitPackageVersionId(data.Id.ValueString())
+	if !ok {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected package version identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	pv, err := r.client.GetPackageVersion(workspaceId, repositoryId, packageName, version)
+
+	// Deleted outside of Terraform, the next apply fails until it is published again
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get package version %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pv)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageVersionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PackageVersionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, packageName, version, ok := splitPackageVersionId(data.Id.ValueString())
+	if !ok {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected package version identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	// Only the properties can change in place
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pv, err := r.client.UpdatePackageVersion(workspaceId, repositoryId, packageName, version, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update package version %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pv)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageVersionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PackageVersionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, packageName, version, ok := splitPackageVersionId(data.Id.ValueString())
+	if !ok {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected package version identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeletePackageVersion(workspaceId, repositoryId, packageName, version); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete package version, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow package version resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *PackageVersionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data PackageVersionResourceModel
+
+	workspace, repository, packageName, version, ok := splitPackageVersionId(req.ID)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/package/version. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, workspace, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	pv, err := r.client.GetPackageVersion(workspaceId, repositoryId, packageName, version)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import package version %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, packageName, version}, "/"))
+	data.WorkspaceId = types.StringValue(workspace)
+	data.Repository = types.StringValue(repository)
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pv)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PackageVersionResource) buildOptions(ctx context.Context, data *PackageVersionResourceModel) (client.PackageVersionOptions, diag.Diagnostics) {
+	opts := client.PackageVersionOptions{
+		Properties: map[string]string{},
+	}
+
+	if data.Properties.IsNull() || data.Properties.IsUnknown() {
+		return opts, nil
+	}
+
+	diags := data.Properties.ElementsAs(ctx, &opts.Properties, false)
+
+	return opts, diags
+}
+
+func (r *PackageVersionResource) mapResponseToModel(ctx context.Context, data *PackageVersionResourceModel, pv *client.PackageVersion) diag.Diagnostics {
+	data.PackageName = types.StringValue(pv.PackageName)
+	data.Version = types.StringValue(pv.Version)
+	data.SizeBytes = types.Int64Value(pv.SizeBytes)
+	data.CreatedAt = types.StringValue(pv.CreatedAt)
+
+	properties := pv.Properties
+	if properties == nil {
+		properties = map[string]string{}
+	}
+
+	mapValue, diags := types.MapValueFrom(ctx, types.StringType, properties)
+	data.Properties = mapValue
+
+	return diags
+}
+
+// splitPackageVersionId splits a workspace/repository/package/version
+// identifier, the package name can hold a slash like the npm scoped packages.
+func splitPackageVersionId(id string) (string, string, string, string, bool) {
+	idParts := strings.Split(id, "/")
+	if len(idParts) < 4 {
+		return "", "", "", "", false
+	}
+
+	packageName := strings.Join(idParts[2:len(idParts)-1], "/")
+	version := idParts[len(idParts)-1]
+
+	if idParts[0] == "" || idParts[1] == "" || packageName == "" || version == "" {
+		return "", "", "", "", false
+	}
+
+	return idParts[0], idParts[1], packageName, version, true
+}
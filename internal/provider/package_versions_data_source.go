@@ -0,0 +1,231 @@
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PackageVersionsDataSource{}
+
+func NewPackageVersionsDataSource() datasource.DataSource {
+	return &PackageVersionsDataSource{}
+}
+
+// PackageVersionsDataSource defines the data source implementation.
+type PackageVersionsDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+type PackageVersionsDataSourceModel struct {
+	WorkspaceId       types.String               `tfsdk:"workspace"`
+	Repository        types.String               `tfsdk:"repository"`
+	PackageName       types.String               `tfsdk:"package_name"`
+	VersionConstraint types.String               `tfsdk:"version_constraint"`
+	Versions          []PackageVersionEntryModel `tfsdk:"versions"`
+}
+
+type PackageVersionEntryModel struct {
+	Version   types.String `tfsdk:"version"`
+	SizeBytes types.Int64  `tfsdk:"size_bytes"`
+	CreatedAt types.String `tfsdk:"created_at"`
+}
+
+func (d *PackageVersionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_package_versions"
+}
+
+func (d *PackageVersionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Package versions data source. The versions of a package published in a repository, optionally filtered by a version constraint, e.g. to pin the newest version matching `~> 1.4`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id)",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id",
+				Required:            true,
+			},
+			"package_name": schema.StringAttribute{
+				MarkdownDescription: "Package name, e.g. `@acme/ui` or `library/nginx`.",
+				Required:            true,
+			},
+			"version_constraint": schema.StringAttribute{
+				MarkdownDescription: "Only return the versions meeting this constraint, with the syntax of the Terraform version constraints, e.g. `~> 1.4` or `>= 2.0, != 2.3.1`. " +
+					"The versions which are not semantic versions, like the `latest` tag of an image, are left out, and a pre-release only meets a constraint on a pre-release of the same release. Every version is returned when unset.",
+				Optional: true,
+			},
+			"versions": schema.ListNestedAttribute{
+				MarkdownDescription: "Versions found, the newest semantic version first. The other versions come last, the most recently published first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"version": schema.StringAttribute{
+							MarkdownDescription: "Version",
+							Computed:            true,
+						},
+						"size_bytes": schema.Int64Attribute{
+							MarkdownDescription: "Size of the files of the version",
+							Computed:            true,
+						},
+						"created_at": schema.StringAttribute{
+							MarkdownDescription: "Publication date of the version",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PackageVersionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PackageVersionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PackageVersionsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var constraints []versionConstraint
+	if !data.VersionConstraint.IsNull() {
+		cs, err := parseVersionConstraints(data.VersionConstraint.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("version_constraint"), "Invalid parameter", err.Error())
+			return
+		}
+		constraints = cs
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(d.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	pvs, err := listPackageVersions(d.client, workspaceId, repositoryId, data.PackageName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list versions of package %s, got error: %s", data.PackageName.ValueString(), err))
+		return
+	}
+
+	data.Versions = []PackageVersionEntryModel{}
+
+	for _, pv := range sortPackageVersions(pvs) {
+		if constraints != nil {
+			v, err := parseSemver(pv.Version)
+			if err != nil || !matchVersionConstraints(v, constraints) {
+				continue
+			}
+		}
+
+		data.Versions = append(data.Versions, PackageVersionEntryModel{
+			Version:   types.StringValue(pv.Version),
+			SizeBytes: types.Int64Value(pv.SizeBytes),
+			CreatedAt: types.StringValue(pv.CreatedAt),
+		})
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read package versions data", map[string]interface{}{
+		"repository": repositoryId,
+		"workspace":  workspaceId,
+		"package":    data.PackageName.ValueString(),
+		"listed":     len(pvs),
+		"found":      len(data.Versions),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listPackageVersions returns every version of a package, following the pages.
+func listPackageVersions(c client.PackagesAPI, workspaceId string, repositoryId string, packageName string) ([]client.PackageVersion, error) {
+	pvs := []client.PackageVersion{}
+
+	for offset := 0; ; offset += packagesPageSize {
+		page, err := c.ListPackageVersions(workspaceId, repositoryId, packageName, offset, packagesPageSize)
+		if err != nil {
+			return nil, err
+		}
+
+		pvs = append(pvs, page.Versions...)
+
+		if len(page.Versions) == 0 || offset+len(page.Versions) >= page.Total {
+			return pvs, nil
+		}
+	}
+}
+
+// sortPackageVersions orders the versions the newest semantic version first, then the
+// other versions the most recently published first.
+func sortPackageVersions(pvs []client.PackageVersion) []client.PackageVersion {
+	type parsed struct {
+		pv client.PackageVersion
+		sv *semver
+	}
+
+	items := make([]parsed, 0, len(pvs))
+	for _, pv := range pvs {
+		sv, _ := parseSemver(pv.Version)
+		items = append(items, parsed{pv: pv, sv: sv})
+	}
+
+	slices.SortStableFunc(items, func(a, b parsed) int {
+		switch {
+		case a.sv != nil && b.sv != nil:
+			return b.sv.compare(a.sv)
+		case a.sv != nil:
+			return -1
+		case b.sv != nil:
+			return 1
+		}
+		return cmp.Or(strings.Compare(b.pv.CreatedAt, a.pv.CreatedAt), strings.Compare(a.pv.Version, b.pv.Version))
+	})
+
+	sorted := make([]client.PackageVersion, 0, len(items))
+	for _, item := range items {
+		sorted = append(sorted, item.pv)
+	}
+	return sorted
+}
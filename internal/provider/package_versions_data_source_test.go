@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPackageVersionsDataSource(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+	newTestPackage(t, p, workspaceId, "lodash", "1.0.0", "2.0.0", "1.2.0", "1.3.0-beta", "nightly")
+
+	versions := func(constraint *string) []string {
+		attrs := map[string]tftypes.Value{
+			"workspace":    str("platform"),
+			"repository":   str("npm-local"),
+			"package_name": str("lodash"),
+		}
+		if constraint != nil {
+			attrs["version_constraint"] = str(*constraint)
+		}
+
+		var entries []tftypes.Value
+		if err := valueAt(t, p.readData("repoflow_package_versions", p.dataConfig("repoflow_package_versions", attrs)), "versions").As(&entries); err != nil {
+			t.Fatalf("versions: %s", err)
+		}
+
+		got := []string{}
+		for _, entry := range entries {
+			got = append(got, stringAttr(t, entry, "version"))
+		}
+		return got
+	}
+
+	// Every version, the newest semantic version first
+	if got, want := versions(nil), []string{"2.0.0", "1.3.0-beta", "1.2.0", "1.0.0", "nightly"}; !slices.Equal(got, want) {
+		t.Errorf("versions = %v, want %v", got, want)
+	}
+
+	// The constraint skips the pre-releases and the other versions
+	constraint := ">= 1.0, < 2.0"
+	if got, want := versions(&constraint), []string{"1.2.0", "1.0.0"}; !slices.Equal(got, want) {
+		t.Errorf("versions matching %q = %v, want %v", constraint, got, want)
+	}
+
+	invalid := ">= latest"
+	if _, diags := p.tryReadData("repoflow_package_versions", p.dataConfig("repoflow_package_versions", map[string]tftypes.Value{
+		"workspace":          str("platform"),
+		"repository":         str("npm-local"),
+		"package_name":       str("lodash"),
+		"version_constraint": str(invalid),
+	})); !hasError(diags) {
+		t.Errorf("invalid constraint %q accepted", invalid)
+	}
+}
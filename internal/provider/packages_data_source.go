@@ -0,0 +1,190 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Number of packages read per request
+const packagesPageSize = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PackagesDataSource{}
+
+func NewPackagesDataSource() datasource.DataSource {
+	return &PackagesDataSource{}
+}
+
+// PackagesDataSource defines the data source implementation.
+type PackagesDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+type PackagesDataSourceModel struct {
+	WorkspaceId      types.String        `tfsdk:"workspace"`
+	Repository       types.String        `tfsdk:"repository"`
+	NamePrefix       types.String        `tfsdk:"name_prefix"`
+	MinDownloadCount types.Int64         `tfsdk:"min_download_count"`
+	Packages         []PackageEntryModel `tfsdk:"packages"`
+}
+
+type PackageEntryModel struct {
+	Name          types.String `tfsdk:"name"`
+	LatestVersion types.String `tfsdk:"latest_version"`
+	DownloadCount types.Int64  `tfsdk:"download_count"`
+	UpdatedAt     types.String `tfsdk:"updated_at"`
+}
+
+func (d *PackagesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_packages"
+}
+
+func (d *PackagesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Packages data source. The packages published in a repository with their latest version and download count, optionally filtered by name prefix or popularity.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id)",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id",
+				Required:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return the packages whose name starts with this prefix, e.g. an npm scope like `@acme/`.",
+				Optional:            true,
+			},
+			"min_download_count": schema.Int64Attribute{
+				MarkdownDescription: "Only return the packages downloaded at least this number of times.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"packages": schema.ListNestedAttribute{
+				MarkdownDescription: "Packages found, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Package name",
+							Computed:            true,
+						},
+						"latest_version": schema.StringAttribute{
+							MarkdownDescription: "Latest version of the package",
+							Computed:            true,
+						},
+						"download_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of downloads of the package, all versions included",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Date of the last version published",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PackagesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PackagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PackagesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(d.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	data.Packages = []PackageEntryModel{}
+	listed := 0
+
+	// The packages are returned by pages, ordered by name
+	for offset := 0; ; offset += packagesPageSize {
+		page, err := d.client.ListPackages(workspaceId, repositoryId, offset, packagesPageSize)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to list packages of repository %s, got error: %s", data.Repository.ValueString(), err,
+			))
+			return
+		}
+
+		for _, pkg := range page.Packages {
+			if !strings.HasPrefix(pkg.Name, data.NamePrefix.ValueString()) {
+				continue
+			}
+			if pkg.DownloadCount < data.MinDownloadCount.ValueInt64() {
+				continue
+			}
+
+			data.Packages = append(data.Packages, PackageEntryModel{
+				Name:          types.StringValue(pkg.Name),
+				LatestVersion: types.StringValue(pkg.LatestVersion),
+				DownloadCount: types.Int64Value(pkg.DownloadCount),
+				UpdatedAt:     types.StringValue(pkg.UpdatedAt),
+			})
+		}
+		listed += len(page.Packages)
+
+		if len(page.Packages) == 0 || offset+len(page.Packages) >= page.Total {
+			break
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read packages data", map[string]interface{}{
+		"repository": repositoryId,
+		"workspace":  workspaceId,
+		"listed":     listed,
+		"found":      len(data.Packages),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
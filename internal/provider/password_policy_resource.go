@@ -0,0 +1,277 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+// The password policy is a singleton, so the state always uses the same identifier.
+const passwordPolicyId = "password_policy"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PasswordPolicyResource{}
+var _ resource.ResourceWithImportState = &PasswordPolicyResource{}
+
+func NewPasswordPolicyResource() resource.Resource {
+	return &PasswordPolicyResource{}
+}
+
+// PasswordPolicyResource defines the resource implementation.
+type PasswordPolicyResource struct {
+	client client.IdentityAPI
+}
+
+// PasswordPolicyResourceModel describes the resource data model.
+type PasswordPolicyResourceModel struct {
+	Id                     types.String `tfsdk:"id"`
+	MinLength              types.Int64  `tfsdk:"min_length"`
+	RequireUppercase       types.Bool   `tfsdk:"require_uppercase"`
+	RequireLowercase       types.Bool   `tfsdk:"require_lowercase"`
+	RequireDigit           types.Bool   `tfsdk:"require_digit"`
+	RequireSymbol          types.Bool   `tfsdk:"require_symbol"`
+	MaxAgeDays             types.Int64  `tfsdk:"max_age_days"`
+	LockoutThreshold       types.Int64  `tfsdk:"lockout_threshold"`
+	LockoutDurationMinutes types.Int64  `tfsdk:"lockout_duration_minutes"`
+}
+
+func (r *PasswordPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_password_policy"
+}
+
+func (r *PasswordPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Password policy resource. Manage the passwords accepted for the local users of the instance (only one per instance), the users of the LDAP and OIDC directories are not concerned. The policy applies to the next password changes. Destroying the resource resets the policy to its defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"min_length": schema.Int64Attribute{
+				MarkdownDescription: "Minimum number of characters of the passwords (default `8`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(8),
+				Validators: []validator.Int64{
+					int64validator.Between(8, 128),
+				},
+			},
+			"require_uppercase": schema.BoolAttribute{
+				MarkdownDescription: "Whether the passwords must hold an uppercase letter (default `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"require_lowercase": schema.BoolAttribute{
+				MarkdownDescription: "Whether the passwords must hold a lowercase letter (default `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"require_digit": schema.BoolAttribute{
+				MarkdownDescription: "Whether the passwords must hold a digit (default `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"require_symbol": schema.BoolAttribute{
+				MarkdownDescription: "Whether the passwords must hold a character which is neither a letter nor a digit (default `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"max_age_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days before the users must change their password. The passwords never expire when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"lockout_threshold": schema.Int64Attribute{
+				MarkdownDescription: "Number of failed logins in a row locking the account. The accounts are never locked when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"lockout_duration_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Number of minutes an account stays locked (default `15`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(15),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("lockout_threshold")),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Password policy identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PasswordPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PasswordPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp, err := r.client.UpdatePasswordPolicy(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create password policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pp)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow password policy resource", map[string]interface{}{
+		"min_length": pp.MinLength,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasswordPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp, err := r.client.GetPasswordPolicy()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get password policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pp)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasswordPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp, err := r.client.UpdatePasswordPolicy(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update password policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pp)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PasswordPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PasswordPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeletePasswordPolicy(); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete password policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow password policy resource")
+}
+
+func (r *PasswordPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *PasswordPolicyResource) buildOptions(data *PasswordPolicyResourceModel) client.PasswordPolicyOptions {
+	return client.PasswordPolicyOptions{
+		MinLength:              int(data.MinLength.ValueInt64()),
+		RequireUppercase:       data.RequireUppercase.ValueBool(),
+		RequireLowercase:       data.RequireLowercase.ValueBool(),
+		RequireDigit:           data.RequireDigit.ValueBool(),
+		RequireSymbol:          data.RequireSymbol.ValueBool(),
+		MaxAgeDays:             factory.Int64ToPtr(data.MaxAgeDays),
+		LockoutThreshold:       factory.Int64ToPtr(data.LockoutThreshold),
+		LockoutDurationMinutes: int(data.LockoutDurationMinutes.ValueInt64()),
+	}
+}
+
+func (r *PasswordPolicyResource) mapResponseToModel(data *PasswordPolicyResourceModel, pp *client.PasswordPolicy) {
+	data.Id = types.StringValue(passwordPolicyId)
+	data.MinLength = types.Int64Value(int64(pp.MinLength))
+	data.RequireUppercase = types.BoolValue(pp.RequireUppercase)
+	data.RequireLowercase = types.BoolValue(pp.RequireLowercase)
+	data.RequireDigit = types.BoolValue(pp.RequireDigit)
+	data.RequireSymbol = types.BoolValue(pp.RequireSymbol)
+	data.MaxAgeDays = types.Int64PointerValue(factory.IntPtrToInt64Ptr(pp.MaxAgeDays))
+	data.LockoutThreshold = types.Int64PointerValue(factory.IntPtrToInt64Ptr(pp.LockoutThreshold))
+	data.LockoutDurationMinutes = types.Int64Value(int64(pp.LockoutDurationMinutes))
+}
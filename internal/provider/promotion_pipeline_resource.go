@@ -0,0 +1,478 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PromotionPipelineResource{}
+var _ resource.ResourceWithImportState = &PromotionPipelineResource{}
+var _ resource.ResourceWithModifyPlan = &PromotionPipelineResource{}
+
+func NewPromotionPipelineResource() resource.Resource {
+	return &PromotionPipelineResource{}
+}
+
+// PromotionPipelineResource defines the resource implementation.
+type PromotionPipelineResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.PackagesAPI
+	}
+}
+
+// PromotionPipelineResourceModel describes the resource data model.
+type PromotionPipelineResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Name        types.String `tfsdk:"name"`
+	Stages      types.List   `tfsdk:"stages"`
+	PipelineId  types.String `tfsdk:"pipeline_id"`
+}
+
+// PromotionStageModel describes a stage of the stages list data model.
+type PromotionStageModel struct {
+	Name               types.String `tfsdk:"name"`
+	RepositoryId       types.String `tfsdk:"repository_id"`
+	RequiredProperties types.Map    `tfsdk:"required_properties"`
+	RequiredApprovals  types.Int64  `tfsdk:"required_approvals"`
+	Approvers          types.Set    `tfsdk:"approvers"`
+}
+
+var promotionStageType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":                types.StringType,
+		"repository_id":       types.StringType,
+		"required_properties": types.MapType{ElemType: types.StringType},
+		"required_approvals":  types.Int64Type,
+		"approvers":           types.SetType{ElemType: types.StringType},
+	},
+}
+
+func (r *PromotionPipelineResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_promotion_pipeline"
+}
+
+func (r *PromotionPipelineResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Promotion pipeline resource. Define the path of the artifacts between local repositories of a workspace, e.g. `dev`, `staging` then `release`. The CI promotes an artifact to the next stage with the `pipeline_id`, once it has the properties and approvals required by that stage.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the pipeline (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the pipeline.",
+				Required:            true,
+			},
+			"stages": schema.ListNestedAttribute{
+				MarkdownDescription: "Stages of the pipeline, in promotion order. The artifacts are uploaded to the first stage, which has no requirement.",
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(2),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the stage, e.g. `staging`.",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"repository_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the local repository of the stage.",
+							Required:            true,
+						},
+						"required_properties": schema.MapAttribute{
+							MarkdownDescription: "Properties an artifact must have, with their value, to be promoted to the stage, e.g. `tests = \"passed\"` (see `repoflow_artifact_properties`).",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Map{
+								mapvalidator.SizeAtLeast(1),
+							},
+						},
+						"required_approvals": schema.Int64Attribute{
+							MarkdownDescription: "Number of approvals needed to promote an artifact to the stage. No approval is needed when unset.",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"approvers": schema.SetAttribute{
+							MarkdownDescription: "Principals allowed to approve, in the form `user:<name>` or `group:<name>`. Anyone with write access to the repository of the stage can approve when unset.",
+							Optional:            true,
+							ElementType:         types.StringType,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+								setvalidator.ValueStringsAre(stringvalidator.RegexMatches(principalRegexp, "must be in the form user:<name> or group:<name>")),
+								setvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("required_approvals")),
+							},
+						},
+					},
+				},
+			},
+			"pipeline_id": schema.StringAttribute{
+				MarkdownDescription: "Promotion pipeline Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Promotion pipeline state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PromotionPipelineResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PromotionPipelineResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PromotionPipelineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp, err := r.client.CreatePromotionPipeline(ws.Id, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create promotion pipeline on workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pp, ws.Id)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow promotion pipeline resource", map[string]interface{}{
+		"id":     data.Id.ValueString(),
+		"stages": len(pp.Stages),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromotionPipelineResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PromotionPipelineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	pipelineId := data.PipelineId.ValueString()
+
+	pp, err := r.client.GetPromotionPipeline(workspaceId, pipelineId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to get promotion pipeline %s on workspaceId %s, got error: %s", pipelineId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pp, workspaceId)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromotionPipelineResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PromotionPipelineResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	pipelineId := data.PipelineId.ValueString()
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pp, err := r.client.UpdatePromotionPipeline(workspaceId, pipelineId, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to update promotion pipeline %s on workspaceId %s, got error: %s", pipelineId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pp, workspaceId)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "updated a repoflow promotion pipeline resource", map[string]interface{}{
+		"id":     data.Id.ValueString(),
+		"stages": len(pp.Stages),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromotionPipelineResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PromotionPipelineResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	pipelineId := data.PipelineId.ValueString()
+
+	if err := r.client.DeletePromotionPipeline(workspaceId, pipelineId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete promotion pipeline, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow promotion pipeline resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *PromotionPipelineResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data PromotionPipelineResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/pipelineId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	pipelineId := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	pp, err := r.client.GetPromotionPipeline(ws.Id, pipelineId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import promotion pipeline %s on workspaceId %s, got error: %s", pipelineId, ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, pp, ws.Id)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PromotionPipelineResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var stages types.List
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("stages"), &stages)...)
+
+	if resp.Diagnostics.HasError() || stages.IsUnknown() || stages.IsNull() {
+		return
+	}
+
+	var models []PromotionStageModel
+	resp.Diagnostics.Append(stages.ElementsAs(ctx, &models, false)...)
+
+	names := map[string]bool{}
+	repositories := map[string]bool{}
+
+	for i, stage := range models {
+		stagePath := path.Root("stages").AtListIndex(i)
+
+		// Nothing is promoted to the first stage, it only receives the uploads
+		if i == 0 && (!stage.RequiredProperties.IsNull() || !stage.RequiredApprovals.IsNull()) {
+			resp.Diagnostics.AddAttributeError(
+				stagePath,
+				"Invalid parameter",
+				"The first stage receives the uploads, it cannot require properties or approvals.",
+			)
+		}
+
+		if !stage.Name.IsUnknown() {
+			if names[stage.Name.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					stagePath.AtName("name"),
+					"Invalid parameter",
+					fmt.Sprintf("The stage %q is defined more than once.", stage.Name.ValueString()),
+				)
+			}
+			names[stage.Name.ValueString()] = true
+		}
+
+		if !stage.RepositoryId.IsUnknown() {
+			if repositories[stage.RepositoryId.ValueString()] {
+				resp.Diagnostics.AddAttributeError(
+					stagePath.AtName("repository_id"),
+					"Invalid parameter",
+					fmt.Sprintf("The repository %q is used by more than one stage.", stage.RepositoryId.ValueString()),
+				)
+			}
+			repositories[stage.RepositoryId.ValueString()] = true
+		}
+	}
+}
+
+func (r *PromotionPipelineResource) buildOptions(ctx context.Context, data *PromotionPipelineResourceModel) (client.PromotionPipelineOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []PromotionStageModel
+
+	diags.Append(data.Stages.ElementsAs(ctx, &models, false)...)
+
+	opts := client.PromotionPipelineOptions{
+		Name:   data.Name.ValueString(),
+		Stages: make([]client.PromotionStage, 0, len(models)),
+	}
+
+	for _, m := range models {
+		// An empty map or list requires nothing
+		stage := client.PromotionStage{
+			Name:               m.Name.ValueString(),
+			RepositoryId:       m.RepositoryId.ValueString(),
+			RequiredProperties: map[string]string{},
+			RequiredApprovals:  int(m.RequiredApprovals.ValueInt64()),
+			Approvers:          []string{},
+		}
+		diags.Append(m.RequiredProperties.ElementsAs(ctx, &stage.RequiredProperties, false)...)
+		diags.Append(m.Approvers.ElementsAs(ctx, &stage.Approvers, false)...)
+		opts.Stages = append(opts.Stages, stage)
+	}
+
+	return opts, diags
+}
+
+func (r *PromotionPipelineResource) mapResponseToModel(ctx context.Context, data *PromotionPipelineResourceModel, pp *client.PromotionPipeline, workspaceId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// We save the state id with workspaceId/pipelineId
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, pp.Id}, "/"))
+	data.PipelineId = types.StringValue(pp.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.Name = types.StringValue(pp.Name)
+
+	models := make([]PromotionStageModel, 0, len(pp.Stages))
+	for _, stage := range pp.Stages {
+		// Keep the requirements null when empty to avoid a diff with the configuration
+		properties := types.MapNull(types.StringType)
+		if len(stage.RequiredProperties) > 0 {
+			var d diag.Diagnostics
+			properties, d = types.MapValueFrom(ctx, types.StringType, stage.RequiredProperties)
+			diags.Append(d...)
+		}
+		approvals := types.Int64Null()
+		if stage.RequiredApprovals > 0 {
+			approvals = types.Int64Value(int64(stage.RequiredApprovals))
+		}
+		approvers, d := stringSetOrNull(ctx, stage.Approvers)
+		diags.Append(d...)
+
+		models = append(models, PromotionStageModel{
+			Name:               types.StringValue(stage.Name),
+			RepositoryId:       types.StringValue(stage.RepositoryId),
+			RequiredProperties: properties,
+			RequiredApprovals:  approvals,
+			Approvers:          approvers,
+		})
+	}
+
+	stages, d := types.ListValueFrom(ctx, promotionStageType, models)
+	diags.Append(d...)
+	data.Stages = stages
+
+	return diags
+}
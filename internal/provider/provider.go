@@ -2,20 +2,33 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"regexp"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
-	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
 )
 
+// Region names like eu-west-1
+var regionRegexp = regexp.MustCompile(`^[a-z]+(-[a-z0-9]+)+$`)
+
+// HTTP or HTTPS URLs like https://repoflow.example.com/api
+var baseURLRegexp = regexp.MustCompile(`^https?://[^/\s]+(/\S*)?$`)
+
 // Ensure RepoflowProvider satisfies various provider interfaces.
 var _ provider.Provider = &RepoflowProvider{}
 var _ provider.ProviderWithFunctions = &RepoflowProvider{}
@@ -28,12 +41,19 @@ type RepoflowProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// client replaces the client built from the configuration when set,
+	// see NewWithClient.
+	client client.API
 }
 
 // RepoflowProviderModel describes the provider data model.
 type RepoflowProviderModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	ApiKey  types.String `tfsdk:"api_key"`
+	BaseURL  types.String `tfsdk:"base_url"`
+	BaseURLs types.List   `tfsdk:"base_urls"`
+	ApiKey   types.String `tfsdk:"api_key"`
+	Region   types.String `tfsdk:"region"`
+	RunId    types.String `tfsdk:"run_id"`
 }
 
 func (p *RepoflowProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -49,11 +69,35 @@ func (p *RepoflowProvider) Schema(ctx context.Context, req provider.SchemaReques
 				MarkdownDescription: "Base URL of the Repoflow",
 				Optional:            true,
 			},
+			"base_urls": schema.ListAttribute{
+				MarkdownDescription: "Base URLs of a highly available Repoflow, in order of preference. The next one is used when the current one is unreachable.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ConflictsWith(path.MatchRoot("base_url")),
+					// Every base URL may become the active one
+					listvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(baseURLRegexp, "must be an http or https URL like https://repoflow.example.com/api"),
+					),
+				},
+			},
 			"api_key": schema.StringAttribute{
-				MarkdownDescription: "Personnal Repoflow API key",
+				MarkdownDescription: "Personnal Repoflow API key. Defaults to the `REPOFLOW_API_KEY` environment variable, or the content of the file given by `REPOFLOW_API_KEY_FILE`.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "RepoFlow cloud region, e.g. `eu-west-1`. Defaults to the `REPOFLOW_REGION` environment variable. The reads of the workspaces of another region, and of everything they hold, fail with an error. The base URL is still required.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regionRegexp, "must be a region name like eu-west-1"),
+				},
+			},
+			"run_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the Terraform run, sent in the `X-Terraform-Run-ID` header of every request to correlate the RepoFlow audit logs with the runs. Defaults to the `REPOFLOW_RUN_ID` environment variable, or `TFC_RUN_ID` on HCP Terraform.",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -61,59 +105,233 @@ func (p *RepoflowProvider) Schema(ctx context.Context, req provider.SchemaReques
 func (p *RepoflowProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
 	var data RepoflowProviderModel
 
+	// The configuration is ignored with an injected client
+	if p.client != nil {
+		resp.DataSourceData = p.client
+		resp.ResourceData = p.client
+		resp.EphemeralResourceData = p.client
+		resp.ActionData = p.client
+		return
+	}
+
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
+	// Values computed from other resources are not known during the plan
+	if data.BaseURL.IsUnknown() || data.BaseURLs.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Unknown RepoFlow Base URL",
+			"The provider cannot create the RepoFlow client as the base URL is unknown during the plan. "+
+				"Set it statically in the provider block or use the REPOFLOW_BASE_URL environment variable.",
+		)
+	}
+	if data.Region.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Unknown RepoFlow Region",
+			"The provider cannot create the RepoFlow client as the region is unknown during the plan. "+
+				"Set it statically in the provider block or use the REPOFLOW_REGION environment variable.",
+		)
+	}
+	if data.ApiKey.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Unknown RepoFlow API Key",
+			"The provider cannot create the RepoFlow client as the API key is unknown during the plan. "+
+				"Set it statically in the provider block or use the REPOFLOW_API_KEY or REPOFLOW_API_KEY_FILE environment variables.",
+		)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Attributes of the provider block take precedence over the environment
+	region := os.Getenv("REPOFLOW_REGION")
+	if !data.Region.IsNull() {
+		region = data.Region.ValueString()
+	}
+	if region != "" && !regionRegexp.MatchString(region) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("region"),
+			"Invalid RepoFlow Region",
+			fmt.Sprintf("The region must be a region name like eu-west-1, got %q from the REPOFLOW_REGION environment variable.", region),
+		)
+	}
 
-	// Example client configuration for data sources and resources
-	baseURL := os.Getenv("REPOFLOW_BASE_URL")
+	var baseURLs []string
+	if baseURL := os.Getenv("REPOFLOW_BASE_URL"); baseURL != "" {
+		baseURLs = []string{baseURL}
+	}
 	if !data.BaseURL.IsNull() {
-		baseURL = data.BaseURL.ValueString()
+		baseURLs = []string{data.BaseURL.ValueString()}
 	}
-	if baseURL == "" {
-		resp.Diagnostics.AddError("Configuration Error", "base_url must be set in provider block or REPOFLOW_BASE_URL env var")
+	if !data.BaseURLs.IsNull() {
+		resp.Diagnostics.Append(data.BaseURLs.ElementsAs(ctx, &baseURLs, false)...)
 	}
 
 	apiKey := os.Getenv("REPOFLOW_API_KEY")
+	// A file mounted from a secret store, only read when no inline key is set
+	var apiKeyFileErr error
+	if apiKeyFile := os.Getenv("REPOFLOW_API_KEY_FILE"); apiKey == "" && data.ApiKey.IsNull() && apiKeyFile != "" {
+		apiKey, apiKeyFileErr = readApiKeyFile(apiKeyFile)
+		if apiKeyFileErr != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_key"),
+				"Invalid RepoFlow API Key File",
+				fmt.Sprintf("Unable to read the API key from the REPOFLOW_API_KEY_FILE environment variable, got error: %s", apiKeyFileErr),
+			)
+		}
+	}
 	if !data.ApiKey.IsNull() {
 		apiKey = data.ApiKey.ValueString()
 	}
-	if apiKey == "" {
-		resp.Diagnostics.AddError("Configuration Error", "api_key must be set in provider block or REPOFLOW_API_KEY env var")
+
+	// Report every missing setting at once
+	if len(baseURLs) == 0 || baseURLs[0] == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("base_url"),
+			"Missing RepoFlow Base URL",
+			"The provider cannot create the RepoFlow client as no base URL is configured. "+
+				"Neither base_url nor base_urls is set in the provider block, and the REPOFLOW_BASE_URL environment variable fallback is unset or empty.",
+		)
+	}
+	if apiKey == "" && apiKeyFileErr == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing RepoFlow API Key",
+			"The provider cannot create the RepoFlow client as no API key is configured. "+
+				"api_key is not set in the provider block, and both the REPOFLOW_API_KEY and REPOFLOW_API_KEY_FILE environment variable fallbacks are unset or empty.",
+		)
 	}
 
-	client := repoflow.NewClient(baseURL, apiKey)
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// HCP Terraform exposes the run identifier to the provider as TFC_RUN_ID
+	runId := os.Getenv("REPOFLOW_RUN_ID")
+	if runId == "" {
+		runId = os.Getenv("TFC_RUN_ID")
+	}
+	if !data.RunId.IsNull() && !data.RunId.IsUnknown() {
+		runId = data.RunId.ValueString()
+	}
+
+	c := client.NewClient(baseURLs, apiKey)
+	c.SetRegion(region)
+	c.SetLogContext(ctx)
+	c.SetUserAgent(fmt.Sprintf("terraform-provider-repoflow/%s Terraform/%s", p.version, req.TerraformVersion))
+	if runId != "" {
+		c.SetRunId(runId)
+	}
+
+	// The grants of a repository applied together are sent in a single request
+	api := client.WithPermissionBatching(c)
+
+	resp.DataSourceData = api
+	resp.ResourceData = api
+	resp.EphemeralResourceData = api
+	resp.ActionData = api
 }
 
 func (p *RepoflowProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		NewWorkspaceResource, NewRepositoryResource,
+		NewWorkspaceResource,
+		NewRepositoryResource,
+		NewLoginMessageResource,
+		NewCustomDomainResource,
+		NewTlsCertificateResource,
+		NewLabelTaxonomyResource,
+		NewMalwareFeedSubscriptionResource,
+		NewExceptionResource,
+		NewPackageDeprecationResource,
+		NewRepositoryPermissionResource,
+		NewBannerResource,
+		NewRoleAssignmentResource,
+		NewAccessTokenResource,
+		NewServiceAccountResource,
+		NewCredentialResource,
+		NewRepositoryWebhookResource,
+		NewCleanupPolicyResource,
+		NewRetentionPolicyResource,
+		NewPushMirrorResource,
+		NewArtifactResource,
+		NewPackageVersionResource,
+		NewArtifactPropertiesResource,
+		NewSigningKeyResource,
+		NewLdapConfigResource,
+		NewOidcConfigResource,
+		NewScimConfigResource,
+		NewAuditLogStreamResource,
+		NewStorageBackendResource,
+		NewIpAllowlistResource,
+		NewRateLimitPolicyResource,
+		NewAnonymousAccessResource,
+		NewSecurityScanPolicyResource,
+		NewVulnerabilityAllowlistResource,
+		NewLicensePolicyResource,
+		NewQuarantineRuleResource,
+		NewPromotionPipelineResource,
+		NewBuildInfoResource,
+		NewGcScheduleResource,
+		NewOutboundProxyResource,
+		NewUploadPolicyResource,
+		NewImmutableTagRuleResource,
+		NewTrustedPublisherResource,
+		NewPasswordPolicyResource,
+		NewSessionPolicyResource,
+		NewMfaEnforcementResource,
+		NewMaintenanceWindowResource,
+		NewMetricsExporterResource,
+		NewCorsPolicyResource,
+		NewDefaultPermissionTemplateResource,
+		NewRepositorySetResource,
+		NewRegistryStackResource,
+		NewWorkspaceSettingsResource,
 	}
 }
 
 func (p *RepoflowProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
-	return []func() ephemeral.EphemeralResource{}
+	return []func() ephemeral.EphemeralResource{
+		NewRegistryTokenEphemeralResource,
+	}
 }
 
 func (p *RepoflowProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
-		NewWorkspaceDataSource, NewRepositoryDataSource,
+		NewWorkspaceDataSource,
+		NewRepositoryDataSource,
+		NewRepositoryStatsDataSource,
+		NewInstanceUpgradeCheckDataSource,
+		NewUnmanagedChangesDataSource,
+		NewRepositoryEndpointCheckDataSource,
+		NewMigrationPlanDataSource,
+		NewWorkspacesDataSource,
+		NewRepositoriesDataSource,
+		NewPackagesDataSource,
+		NewPackageVersionsDataSource,
+		NewLatestVersionDataSource,
 	}
 }
 
 func (p *RepoflowProvider) Functions(ctx context.Context) []func() function.Function {
-	return []func() function.Function{}
+	return []func() function.Function{
+		NewNetrcFunction,
+	}
 }
 
 func (p *RepoflowProvider) Actions(ctx context.Context) []func() action.Action {
-	return []func() action.Action{}
+	return []func() action.Action{
+		NewSystemTaskAction,
+		NewRepositoryCloneAction,
+		NewWorkspaceExportAction,
+		NewWorkspaceImportAction,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -123,3 +341,29 @@ func New(version string) func() provider.Provider {
 		}
 	}
 }
+
+// NewWithClient returns a provider using the given client whatever its
+// configuration, like the in-memory one of the fake package.
+func NewWithClient(version string, client client.API) func() provider.Provider {
+	return func() provider.Provider {
+		return &RepoflowProvider{
+			version: version,
+			client:  client,
+		}
+	}
+}
+
+// readApiKeyFile reads an API key from a file, ignoring surrounding whitespaces.
+func readApiKeyFile(name string) (string, error) {
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return "", err
+	}
+
+	apiKey := strings.TrimSpace(string(content))
+	if apiKey == "" {
+		return "", fmt.Errorf("file %s is empty", name)
+	}
+
+	return apiKey, nil
+}
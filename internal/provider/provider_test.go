@@ -0,0 +1,475 @@
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/client/fake"
+)
+
+// testProvider serves the provider with an in-memory RepoFlow, and drives its
+// resources through the plugin protocol like Terraform does.
+type testProvider struct {
+	t             *testing.T
+	server        tfprotov6.ProviderServer
+	client        *fake.Client
+	schemas       map[string]*tfprotov6.Schema
+	dataSchemas   map[string]*tfprotov6.Schema
+	actionSchemas map[string]*tfprotov6.ActionSchema
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	c := fake.New()
+	return newTestProviderWithClient(t, c, c)
+}
+
+// newTestProviderWithClient serves the provider with a client wrapping the in-memory
+// RepoFlow, like a client recording the requests.
+func newTestProviderWithClient(t *testing.T, c *fake.Client, api client.API) *testProvider {
+	t.Helper()
+
+	ctx := context.Background()
+	server := providerserver.NewProtocol6(NewWithClient("test", api)())()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema: %s", err)
+	}
+	checkDiagnostics(t, "GetProviderSchema", schemas.Diagnostics)
+
+	// The configuration is ignored with an injected client
+	config := dynamicValue(t, schemas.Provider.ValueType(), tftypes.NewValue(schemas.Provider.ValueType(), nil))
+	configured, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{Config: config})
+	if err != nil {
+		t.Fatalf("ConfigureProvider: %s", err)
+	}
+	checkDiagnostics(t, "ConfigureProvider", configured.Diagnostics)
+
+	return &testProvider{
+		t:             t,
+		server:        server,
+		client:        c,
+		schemas:       schemas.ResourceSchemas,
+		dataSchemas:   schemas.DataSourceSchemas,
+		actionSchemas: schemas.ActionSchemas,
+	}
+}
+
+// config returns the configuration of a resource, the attributes not given being null.
+func (p *testProvider) config(typeName string, attrs map[string]tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	schema, ok := p.schemas[typeName]
+	if !ok {
+		p.t.Fatalf("unknown resource type %s", typeName)
+	}
+	return objectValue(schema.ValueType().(tftypes.Object), attrs)
+}
+
+// block returns a nested block or object attribute of a resource, the attributes not
+// given being null.
+func (p *testProvider) block(typeName string, name string, attrs map[string]tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	typ, ok := p.schemas[typeName].ValueType().(tftypes.Object).AttributeTypes[name].(tftypes.Object)
+	if !ok {
+		p.t.Fatalf("%s has no block %s", typeName, name)
+	}
+	return objectValue(typ, attrs)
+}
+
+// apply plans and applies a configuration over the prior state, a null configuration
+// destroying the resource, and returns the new state.
+func (p *testProvider) apply(typeName string, prior tftypes.Value, config tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	state, _, diags := p.applyChange(typeName, prior, config)
+	checkDiagnostics(p.t, "apply "+typeName, diags)
+
+	return state
+}
+
+// applyInPlace is apply for a change expected to update the resource in place, failing
+// when the plan requires its replacement.
+//
+// The harness applies every change through Update, so a replacement is only visible
+// in the plan.
+func (p *testProvider) applyInPlace(typeName string, prior tftypes.Value, config tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	state, requiresReplace, diags := p.applyChange(typeName, prior, config)
+	checkDiagnostics(p.t, "apply "+typeName, diags)
+	if len(requiresReplace) > 0 {
+		p.t.Fatalf("apply %s: plan requires replacement: %v", typeName, requiresReplace)
+	}
+
+	return state
+}
+
+// tryApply is apply returning the diagnostics of the plan or the apply.
+func (p *testProvider) tryApply(typeName string, prior tftypes.Value, config tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	p.t.Helper()
+
+	state, _, diags := p.applyChange(typeName, prior, config)
+	return state, diags
+}
+
+// applyChange plans and applies a change, returning the new state, the attributes the
+// plan requires the replacement of, and the diagnostics of the plan or the apply.
+func (p *testProvider) applyChange(typeName string, prior tftypes.Value, config tftypes.Value) (tftypes.Value, []*tftypes.AttributePath, []*tfprotov6.Diagnostic) {
+	p.t.Helper()
+
+	ctx := context.Background()
+	schema := p.schemas[typeName]
+	typ := schema.ValueType()
+
+	if !config.IsNull() {
+		validated, err := p.server.ValidateResourceConfig(ctx, &tfprotov6.ValidateResourceConfigRequest{
+			TypeName: typeName,
+			Config:   dynamicValue(p.t, typ, config),
+			ClientCapabilities: &tfprotov6.ValidateResourceConfigClientCapabilities{
+				WriteOnlyAttributesAllowed: true,
+			},
+		})
+		if err != nil {
+			p.t.Fatalf("ValidateResourceConfig %s: %s", typeName, err)
+		}
+		if hasError(validated.Diagnostics) {
+			return prior, nil, validated.Diagnostics
+		}
+	}
+
+	planned, err := p.server.PlanResourceChange(ctx, &tfprotov6.PlanResourceChangeRequest{
+		TypeName:         typeName,
+		PriorState:       dynamicValue(p.t, typ, prior),
+		ProposedNewState: dynamicValue(p.t, typ, proposedNewState(schema.Block, prior, config)),
+		Config:           dynamicValue(p.t, typ, config),
+	})
+	if err != nil {
+		p.t.Fatalf("PlanResourceChange %s: %s", typeName, err)
+	}
+	if hasError(planned.Diagnostics) {
+		return prior, planned.RequiresReplace, planned.Diagnostics
+	}
+
+	applied, err := p.server.ApplyResourceChange(ctx, &tfprotov6.ApplyResourceChangeRequest{
+		TypeName:       typeName,
+		PriorState:     dynamicValue(p.t, typ, prior),
+		PlannedState:   planned.PlannedState,
+		Config:         dynamicValue(p.t, typ, config),
+		PlannedPrivate: planned.PlannedPrivate,
+	})
+	if err != nil {
+		p.t.Fatalf("ApplyResourceChange %s: %s", typeName, err)
+	}
+
+	state, err := applied.NewState.Unmarshal(typ)
+	if err != nil {
+		p.t.Fatalf("ApplyResourceChange %s: %s", typeName, err)
+	}
+
+	return state, planned.RequiresReplace, applied.Diagnostics
+}
+
+// dataConfig returns the configuration of a data source, the attributes not given being
+// null.
+func (p *testProvider) dataConfig(typeName string, attrs map[string]tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	schema, ok := p.dataSchemas[typeName]
+	if !ok {
+		p.t.Fatalf("unknown data source type %s", typeName)
+	}
+	return objectValue(schema.ValueType().(tftypes.Object), attrs)
+}
+
+// readData reads a data source and returns its state.
+func (p *testProvider) readData(typeName string, config tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	state, diags := p.tryReadData(typeName, config)
+	checkDiagnostics(p.t, "read data "+typeName, diags)
+
+	return state
+}
+
+// tryReadData is readData returning the diagnostics of the validation or the read.
+func (p *testProvider) tryReadData(typeName string, config tftypes.Value) (tftypes.Value, []*tfprotov6.Diagnostic) {
+	p.t.Helper()
+
+	ctx := context.Background()
+	typ := p.dataSchemas[typeName].ValueType()
+
+	validated, err := p.server.ValidateDataResourceConfig(ctx, &tfprotov6.ValidateDataResourceConfigRequest{
+		TypeName: typeName,
+		Config:   dynamicValue(p.t, typ, config),
+	})
+	if err != nil {
+		p.t.Fatalf("ValidateDataResourceConfig %s: %s", typeName, err)
+	}
+	if hasError(validated.Diagnostics) {
+		return tftypes.NewValue(typ, nil), validated.Diagnostics
+	}
+
+	read, err := p.server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: typeName,
+		Config:   dynamicValue(p.t, typ, config),
+	})
+	if err != nil {
+		p.t.Fatalf("ReadDataSource %s: %s", typeName, err)
+	}
+	if hasError(read.Diagnostics) {
+		return tftypes.NewValue(typ, nil), read.Diagnostics
+	}
+
+	state, err := read.State.Unmarshal(typ)
+	if err != nil {
+		p.t.Fatalf("ReadDataSource %s: %s", typeName, err)
+	}
+
+	return state, read.Diagnostics
+}
+
+// actionConfig returns the configuration of an action, the attributes not given being
+// null.
+func (p *testProvider) actionConfig(typeName string, attrs map[string]tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	schema, ok := p.actionSchemas[typeName]
+	if !ok {
+		p.t.Fatalf("unknown action type %s", typeName)
+	}
+	return objectValue(schema.Schema.ValueType().(tftypes.Object), attrs)
+}
+
+// invoke validates and invokes an action, returning its progress messages and the
+// diagnostics of the validation or of its completion.
+func (p *testProvider) invoke(typeName string, config tftypes.Value) ([]string, []*tfprotov6.Diagnostic) {
+	p.t.Helper()
+
+	ctx := context.Background()
+	typ := p.actionSchemas[typeName].Schema.ValueType()
+	server := p.server.(tfprotov6.ProviderServerWithActions)
+
+	validated, err := server.ValidateActionConfig(ctx, &tfprotov6.ValidateActionConfigRequest{
+		ActionType: typeName,
+		Config:     dynamicValue(p.t, typ, config),
+	})
+	if err != nil {
+		p.t.Fatalf("ValidateActionConfig %s: %s", typeName, err)
+	}
+	if hasError(validated.Diagnostics) {
+		return nil, validated.Diagnostics
+	}
+
+	stream, err := server.InvokeAction(ctx, &tfprotov6.InvokeActionRequest{
+		ActionType: typeName,
+		Config:     dynamicValue(p.t, typ, config),
+	})
+	if err != nil {
+		p.t.Fatalf("InvokeAction %s: %s", typeName, err)
+	}
+
+	var progress []string
+	var diags []*tfprotov6.Diagnostic
+	for event := range stream.Events {
+		switch e := event.Type.(type) {
+		case tfprotov6.ProgressInvokeActionEventType:
+			progress = append(progress, e.Message)
+		case tfprotov6.CompletedInvokeActionEventType:
+			diags = e.Diagnostics
+		}
+	}
+
+	return progress, diags
+}
+
+// nullState returns the state of a resource not created yet.
+func (p *testProvider) nullState(typeName string) tftypes.Value {
+	return tftypes.NewValue(p.schemas[typeName].ValueType(), nil)
+}
+
+// destroy destroys a resource.
+func (p *testProvider) destroy(typeName string, prior tftypes.Value) {
+	p.t.Helper()
+
+	p.apply(typeName, prior, p.nullState(typeName))
+}
+
+// read refreshes a state, a null state meaning the resource is gone.
+func (p *testProvider) read(typeName string, state tftypes.Value) tftypes.Value {
+	p.t.Helper()
+
+	typ := p.schemas[typeName].ValueType()
+
+	read, err := p.server.ReadResource(context.Background(), &tfprotov6.ReadResourceRequest{
+		TypeName:     typeName,
+		CurrentState: dynamicValue(p.t, typ, state),
+	})
+	if err != nil {
+		p.t.Fatalf("ReadResource %s: %s", typeName, err)
+	}
+	checkDiagnostics(p.t, "read "+typeName, read.Diagnostics)
+
+	newState, err := read.NewState.Unmarshal(typ)
+	if err != nil {
+		p.t.Fatalf("ReadResource %s: %s", typeName, err)
+	}
+
+	return newState
+}
+
+// importState imports a resource and reads it, like `terraform import`.
+func (p *testProvider) importState(typeName string, id string) tftypes.Value {
+	p.t.Helper()
+
+	typ := p.schemas[typeName].ValueType()
+
+	imported, err := p.server.ImportResourceState(context.Background(), &tfprotov6.ImportResourceStateRequest{
+		TypeName: typeName,
+		ID:       id,
+	})
+	if err != nil {
+		p.t.Fatalf("ImportResourceState %s: %s", typeName, err)
+	}
+	checkDiagnostics(p.t, "import "+typeName, imported.Diagnostics)
+
+	if len(imported.ImportedResources) != 1 {
+		p.t.Fatalf("import %s: got %d resources, want 1", typeName, len(imported.ImportedResources))
+	}
+
+	state, err := imported.ImportedResources[0].State.Unmarshal(typ)
+	if err != nil {
+		p.t.Fatalf("ImportResourceState %s: %s", typeName, err)
+	}
+
+	return p.read(typeName, state)
+}
+
+// proposedNewState merges the configuration and the prior state like Terraform does
+// before the plan: the computed attributes missing from the configuration keep their
+// prior value.
+func proposedNewState(block *tfprotov6.SchemaBlock, prior tftypes.Value, config tftypes.Value) tftypes.Value {
+	if prior.IsNull() || config.IsNull() || !config.IsKnown() {
+		return config
+	}
+
+	priorAttrs := map[string]tftypes.Value{}
+	configAttrs := map[string]tftypes.Value{}
+	_ = prior.As(&priorAttrs)
+	_ = config.As(&configAttrs)
+
+	proposed := map[string]tftypes.Value{}
+	for name, v := range configAttrs {
+		proposed[name] = v
+	}
+	for _, attr := range block.Attributes {
+		if attr.Computed && configAttrs[attr.Name].IsNull() {
+			proposed[attr.Name] = priorAttrs[attr.Name]
+		}
+	}
+	for _, nested := range block.BlockTypes {
+		if nested.Nesting == tfprotov6.SchemaNestedBlockNestingModeSingle {
+			proposed[nested.TypeName] = proposedNewState(nested.Block, priorAttrs[nested.TypeName], configAttrs[nested.TypeName])
+		}
+	}
+
+	return tftypes.NewValue(config.Type(), proposed)
+}
+
+// objectValue returns an object of the given type, the attributes not given being null.
+func objectValue(typ tftypes.Object, attrs map[string]tftypes.Value) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for name, attrType := range typ.AttributeTypes {
+		if v, ok := attrs[name]; ok {
+			values[name] = v
+			continue
+		}
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tftypes.NewValue(typ, values)
+}
+
+func dynamicValue(t *testing.T, typ tftypes.Type, v tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+
+	dv, err := tfprotov6.NewDynamicValue(typ, v)
+	if err != nil {
+		t.Fatalf("NewDynamicValue: %s", err)
+	}
+	return &dv
+}
+
+func hasError(diags []*tfprotov6.Diagnostic) bool {
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			return true
+		}
+	}
+	return false
+}
+
+func checkDiagnostics(t *testing.T, step string, diags []*tfprotov6.Diagnostic) {
+	t.Helper()
+
+	for _, d := range diags {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("%s: %s: %s", step, d.Summary, d.Detail)
+		}
+	}
+}
+
+// valueAt returns an attribute of an object, following the path of nested objects.
+func valueAt(t *testing.T, v tftypes.Value, names ...string) tftypes.Value {
+	t.Helper()
+
+	for _, name := range names {
+		attrs := map[string]tftypes.Value{}
+		if err := v.As(&attrs); err != nil {
+			t.Fatalf("attribute %s: %s", name, err)
+		}
+		v = attrs[name]
+	}
+	return v
+}
+
+// stringAttr returns a string attribute of an object, empty when null.
+func stringAttr(t *testing.T, v tftypes.Value, names ...string) string {
+	t.Helper()
+
+	var s *string
+	if err := valueAt(t, v, names...).As(&s); err != nil {
+		t.Fatalf("attribute %v: %s", names, err)
+	}
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func str(s string) tftypes.Value {
+	return tftypes.NewValue(tftypes.String, s)
+}
+
+func stringMap(m map[string]string) tftypes.Value {
+	values := map[string]tftypes.Value{}
+	for k, v := range m {
+		values[k] = str(v)
+	}
+	return tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, values)
+}
+
+func stringSet(elems ...string) tftypes.Value {
+	values := []tftypes.Value{}
+	for _, e := range elems {
+		values = append(values, str(e))
+	}
+	return tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, values)
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Registries are only reached over HTTP
+var pushMirrorUrlRegexp = regexp.MustCompile(`^https?://`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PushMirrorResource{}
+var _ resource.ResourceWithImportState = &PushMirrorResource{}
+
+func NewPushMirrorResource() resource.Resource {
+	return &PushMirrorResource{}
+}
+
+// PushMirrorResource defines the resource implementation.
+type PushMirrorResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+// PushMirrorResourceModel describes the resource data model.
+type PushMirrorResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	WorkspaceId       types.String `tfsdk:"workspace"`
+	Repository        types.String `tfsdk:"repository"`
+	Url               types.String `tfsdk:"url"`
+	CredentialId      types.String `tfsdk:"credential_id"`
+	Username          types.String `tfsdk:"username"`
+	PasswordWo        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion types.Int64  `tfsdk:"password_wo_version"`
+	Schedule          types.String `tfsdk:"schedule"`
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	LastSyncedAt      types.String `tfsdk:"last_synced_at"`
+	PushMirrorId      types.String `tfsdk:"push_mirror_id"`
+}
+
+func (r *PushMirrorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_push_mirror"
+}
+
+func (r *PushMirrorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Push mirror resource. Push the packages of a local repository to an external registry on a schedule, e.g. the docker images to ECR. Authenticate with a workspace `credential_id`, or a `username` and write-only `password_wo`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Local repository name or Id.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the external registry receiving the packages, e.g. `https://123456789012.dkr.ecr.eu-west-1.amazonaws.com/team` for an ECR registry.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pushMirrorUrlRegexp, "must be an http:// or https:// URL"),
+				},
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "Id of a `repoflow_credential` of the workspace authenticating to the registry.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(
+						path.MatchRoot("username"),
+						path.MatchRoot("password_wo"),
+					),
+				},
+			},
+			"username": schema.StringAttribute{
+				MarkdownDescription: "Username authenticating to the registry.",
+				Optional:            true,
+			},
+			"password_wo": schema.StringAttribute{
+				MarkdownDescription: "Password or token authenticating to the registry, never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the password. Change it to send a new `password_wo` to the push mirror.",
+				Optional:            true,
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression of the synchronizations (UTC), default to `0 * * * *` (every hour).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0 * * * *"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronRegexp, "must be a cron expression with five fields"),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the packages are pushed (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"last_synced_at": schema.StringAttribute{
+				MarkdownDescription: "Date of the last successful synchronization (RFC 3339).",
+				Computed:            true,
+			},
+			"push_mirror_id": schema.StringAttribute{
+				MarkdownDescription: "Push mirror Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Push mirror state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PushMirrorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PushMirrorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PushMirrorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pm, err := r.client.CreatePushMirror(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create push mirror on repository %s, got error: %s", repositoryId, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, pm.Id}, "/"))
+	r.mapResponseToModel(&data, pm)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow push mirror resource", map[string]interface{}{
+		"id":  data.Id.ValueString(),
+		"url": pm.Url,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushMirrorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PushMirrorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected push mirror identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	pm, err := r.client.GetPushMirror(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get push mirror %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pm)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushMirrorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PushMirrorResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected push mirror identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pm, err := r.client.UpdatePushMirror(idParts[0], idParts[1], idParts[2], opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update push mirror %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	r.mapResponseToModel(&data, pm)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushMirrorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PushMirrorResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected push mirror identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeletePushMirror(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete push mirror, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow push mirror resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *PushMirrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data PushMirrorResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/pushMirrorId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	pm, err := r.client.GetPushMirror(workspaceId, repositoryId, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import push mirror %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, pm.Id}, "/"))
+	data.WorkspaceId = types.StringValue(idParts[0])
+	data.Repository = types.StringValue(idParts[1])
+	data.PasswordWoVersion = types.Int64Null()
+	r.mapResponseToModel(&data, pm)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PushMirrorResource) buildOptions(ctx context.Context, data *PushMirrorResourceModel, config tfsdk.Config) (client.PushMirrorOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var passwordWo types.String
+
+	opts := client.PushMirrorOptions{
+		Url:          data.Url.ValueString(),
+		CredentialId: data.CredentialId.ValueStringPointer(),
+		Username:     data.Username.ValueStringPointer(),
+		Schedule:     data.Schedule.ValueString(),
+		Enabled:      data.Enabled.ValueBool(),
+	}
+
+	// Write-only values are only available in the configuration
+	diags.Append(config.GetAttribute(ctx, path.Root("password_wo"), &passwordWo)...)
+	opts.Password = passwordWo.ValueStringPointer()
+
+	return opts, diags
+}
+
+func (r *PushMirrorResource) mapResponseToModel(data *PushMirrorResourceModel, pm *client.PushMirror) {
+	data.PushMirrorId = types.StringValue(pm.Id)
+	data.Url = types.StringValue(pm.Url)
+	data.CredentialId = types.StringPointerValue(pm.CredentialId)
+	data.Username = types.StringPointerValue(pm.Username)
+	data.Schedule = types.StringValue(pm.Schedule)
+	data.Enabled = types.BoolValue(pm.Enabled)
+	data.LastSyncedAt = types.StringPointerValue(pm.LastSyncedAt)
+	// Write-only values are never stored
+	data.PasswordWo = types.StringNull()
+}
@@ -0,0 +1,352 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &QuarantineRuleResource{}
+var _ resource.ResourceWithImportState = &QuarantineRuleResource{}
+
+func NewQuarantineRuleResource() resource.Resource {
+	return &QuarantineRuleResource{}
+}
+
+// QuarantineRuleResource defines the resource implementation.
+type QuarantineRuleResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.SecurityAPI
+	}
+}
+
+// QuarantineRuleResourceModel describes the resource data model.
+type QuarantineRuleResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	WorkspaceId     types.String `tfsdk:"workspace"`
+	Name            types.String `tfsdk:"name"`
+	RepositoryIds   types.Set    `tfsdk:"repository_ids"`
+	PackagePatterns types.Set    `tfsdk:"package_patterns"`
+	QuarantineHours types.Int64  `tfsdk:"quarantine_hours"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	RuleId          types.String `tfsdk:"rule_id"`
+}
+
+func (r *QuarantineRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_quarantine_rule"
+}
+
+func (r *QuarantineRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Quarantine rule resource. Hold the upstream package versions newly cached by remote repositories of a workspace for `quarantine_hours` after their publication before they can be downloaded, as a protection against typosquatting and freshly poisoned releases. The versions published before are served as usual.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the rule (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the rule.",
+				Required:            true,
+			},
+			"repository_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the remote repositories to quarantine.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"package_patterns": schema.SetAttribute{
+				MarkdownDescription: "Glob patterns of the package names to quarantine (e.g. `@acme/*`). Every package is quarantined when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"quarantine_hours": schema.Int64Attribute{
+				MarkdownDescription: "Number of hours after their upstream publication during which the new versions cannot be downloaded, e.g. `72` for three days.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the rule is applied (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"rule_id": schema.StringAttribute{
+				MarkdownDescription: "Quarantine rule Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Quarantine rule state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *QuarantineRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *QuarantineRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data QuarantineRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	qr, err := r.client.CreateQuarantineRule(ws.Id, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create quarantine rule on workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, qr, ws.Id)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow quarantine rule resource", map[string]interface{}{
+		"id":               data.Id.ValueString(),
+		"quarantine_hours": qr.QuarantineHours,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuarantineRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data QuarantineRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	ruleId := data.RuleId.ValueString()
+
+	qr, err := r.client.GetQuarantineRule(workspaceId, ruleId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to get quarantine rule %s on workspaceId %s, got error: %s", ruleId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, qr, workspaceId)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuarantineRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data QuarantineRuleResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	ruleId := data.RuleId.ValueString()
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	qr, err := r.client.UpdateQuarantineRule(workspaceId, ruleId, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to update quarantine rule %s on workspaceId %s, got error: %s", ruleId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, qr, workspaceId)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "updated a repoflow quarantine rule resource", map[string]interface{}{
+		"id":               data.Id.ValueString(),
+		"quarantine_hours": qr.QuarantineHours,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuarantineRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data QuarantineRuleResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	ruleId := data.RuleId.ValueString()
+
+	if err := r.client.DeleteQuarantineRule(workspaceId, ruleId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete quarantine rule, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow quarantine rule resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *QuarantineRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data QuarantineRuleResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/ruleId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	ruleId := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	qr, err := r.client.GetQuarantineRule(ws.Id, ruleId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import quarantine rule %s on workspaceId %s, got error: %s", ruleId, ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, qr, ws.Id)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *QuarantineRuleResource) buildOptions(ctx context.Context, data *QuarantineRuleResourceModel) (client.QuarantineRuleOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := client.QuarantineRuleOptions{
+		Name:            data.Name.ValueString(),
+		PackagePatterns: []string{},
+		QuarantineHours: int(data.QuarantineHours.ValueInt64()),
+		IsEnabled:       data.Enabled.ValueBool(),
+	}
+	diags.Append(data.RepositoryIds.ElementsAs(ctx, &opts.RepositoryIds, false)...)
+	diags.Append(data.PackagePatterns.ElementsAs(ctx, &opts.PackagePatterns, false)...)
+
+	return opts, diags
+}
+
+func (r *QuarantineRuleResource) mapResponseToModel(ctx context.Context, data *QuarantineRuleResourceModel, qr *client.QuarantineRule, workspaceId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// We save the state id with workspaceId/ruleId
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, qr.Id}, "/"))
+	data.RuleId = types.StringValue(qr.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.Name = types.StringValue(qr.Name)
+	data.QuarantineHours = types.Int64Value(int64(qr.QuarantineHours))
+	data.Enabled = types.BoolValue(qr.IsEnabled)
+
+	repositoryIds, setDiags := types.SetValueFrom(ctx, types.StringType, qr.RepositoryIds)
+	diags.Append(setDiags...)
+	data.RepositoryIds = repositoryIds
+
+	// Keep the patterns null when empty to avoid a diff with the configuration
+	packagePatterns, setDiags := stringSetOrNull(ctx, qr.PackagePatterns)
+	diags.Append(setDiags...)
+	data.PackagePatterns = packagePatterns
+
+	return diags
+}
@@ -0,0 +1,337 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RateLimitPolicyResource{}
+var _ resource.ResourceWithImportState = &RateLimitPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &RateLimitPolicyResource{}
+
+func NewRateLimitPolicyResource() resource.Resource {
+	return &RateLimitPolicyResource{}
+}
+
+// RateLimitPolicyResource defines the resource implementation.
+type RateLimitPolicyResource struct {
+	client client.PoliciesAPI
+}
+
+// RateLimitPolicyResourceModel describes the resource data model.
+type RateLimitPolicyResourceModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	Scope                   types.String `tfsdk:"scope"`
+	Subjects                types.Set    `tfsdk:"subjects"`
+	RequestsPerMinute       types.Int64  `tfsdk:"requests_per_minute"`
+	Burst                   types.Int64  `tfsdk:"burst"`
+	BandwidthBytesPerSecond types.Int64  `tfsdk:"bandwidth_bytes_per_second"`
+	Enabled                 types.Bool   `tfsdk:"enabled"`
+}
+
+func (r *RateLimitPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rate_limit_policy"
+}
+
+func (r *RateLimitPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Rate limit policy resource. Limit the API requests and the download bandwidth of each token, user or repository, so a noisy CI job can't starve the registry. The requests over the limit get a `429 Too Many Requests`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the policy, unique on the instance.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"scope": schema.StringAttribute{
+				MarkdownDescription: "What is limited, one of `token`, `user` or `repository`. Each token, user or repository gets its own limit.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("token", "user", "repository"),
+				},
+			},
+			"subjects": schema.SetAttribute{
+				MarkdownDescription: "Tokens, users or repositories limited by the policy: access token or service account token identifiers for the `token` scope, `user:<name>` or `group:<name>` for the `user` scope (each member of a group being limited separately), repository identifiers for the `repository` scope. Every token, user or repository is limited when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"requests_per_minute": schema.Int64Attribute{
+				MarkdownDescription: "Requests allowed per minute.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtLeastOneOf(path.MatchRoot("bandwidth_bytes_per_second")),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "Requests allowed above `requests_per_minute` for a short time, e.g. when a build starts. No burst when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AlsoRequires(path.MatchRoot("requests_per_minute")),
+				},
+			},
+			"bandwidth_bytes_per_second": schema.Int64Attribute{
+				MarkdownDescription: "Download bandwidth cap, in bytes per second.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the limits are enforced (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Rate limit policy identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RateLimitPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RateLimitPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RateLimitPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rl, err := r.client.CreateRateLimitPolicy(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create rate limit policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rl)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow rate limit policy resource", map[string]interface{}{
+		"id":    rl.Id,
+		"scope": rl.Scope,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RateLimitPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RateLimitPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyId := data.Id.ValueString()
+
+	rl, err := r.client.GetRateLimitPolicy(policyId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get rate limit policy %s, got error: %s", policyId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rl)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RateLimitPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RateLimitPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rl, err := r.client.UpdateRateLimitPolicy(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update rate limit policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rl)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RateLimitPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RateLimitPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	policyId := data.Id.ValueString()
+
+	if err := r.client.DeleteRateLimitPolicy(policyId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete rate limit policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow rate limit policy resource", map[string]interface{}{
+		"id": policyId,
+	})
+}
+
+func (r *RateLimitPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *RateLimitPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var scope types.String
+	var subjects types.Set
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("scope"), &scope)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("subjects"), &subjects)...)
+
+	// Only the users are checked, the tokens and the repositories are identifiers
+	if resp.Diagnostics.HasError() || scope.ValueString() != "user" || subjects.IsUnknown() {
+		return
+	}
+
+	var values []types.String
+	resp.Diagnostics.Append(subjects.ElementsAs(ctx, &values, false)...)
+
+	for _, value := range values {
+		if !value.IsUnknown() && !principalRegexp.MatchString(value.ValueString()) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("subjects"),
+				"Invalid parameter",
+				fmt.Sprintf("The subjects of the user scope must be in the form user:<name> or group:<name>, got %q.", value.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *RateLimitPolicyResource) buildOptions(ctx context.Context, data *RateLimitPolicyResourceModel) (client.RateLimitPolicyOptions, diag.Diagnostics) {
+	// An empty list limits every token, user or repository
+	subjects := []string{}
+	diags := data.Subjects.ElementsAs(ctx, &subjects, false)
+
+	return client.RateLimitPolicyOptions{
+		Name:                    data.Name.ValueString(),
+		Scope:                   data.Scope.ValueString(),
+		Subjects:                subjects,
+		RequestsPerMinute:       factory.Int64ToPtr(data.RequestsPerMinute),
+		Burst:                   factory.Int64ToPtr(data.Burst),
+		BandwidthBytesPerSecond: data.BandwidthBytesPerSecond.ValueInt64Pointer(),
+		IsEnabled:               data.Enabled.ValueBool(),
+	}, diags
+}
+
+func (r *RateLimitPolicyResource) mapResponseToModel(ctx context.Context, data *RateLimitPolicyResourceModel, rl *client.RateLimitPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(rl.Id)
+	data.Name = types.StringValue(rl.Name)
+	data.Scope = types.StringValue(rl.Scope)
+	data.RequestsPerMinute = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rl.RequestsPerMinute))
+	data.Burst = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rl.Burst))
+	data.BandwidthBytesPerSecond = types.Int64PointerValue(rl.BandwidthBytesPerSecond)
+	data.Enabled = types.BoolValue(rl.IsEnabled)
+
+	// Keep the subjects null when every one is limited to avoid a diff with the configuration
+	if len(rl.Subjects) > 0 {
+		data.Subjects, diags = types.SetValueFrom(ctx, types.StringType, rl.Subjects)
+	} else {
+		data.Subjects = types.SetNull(types.StringType)
+	}
+
+	return diags
+}
@@ -0,0 +1,394 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RegistryStackResource{}
+var _ resource.ResourceWithImportState = &RegistryStackResource{}
+var _ resource.ResourceWithModifyPlan = &RegistryStackResource{}
+
+func NewRegistryStackResource() resource.Resource {
+	return &RegistryStackResource{}
+}
+
+// RegistryStackResource defines the resource implementation.
+type RegistryStackResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+// RegistryStackResourceModel describes the resource data model.
+type RegistryStackResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	WorkspaceId         types.String `tfsdk:"workspace"`
+	Name                types.String `tfsdk:"name"`
+	PackageType         types.String `tfsdk:"package_type"`
+	UpstreamUrl         types.String `tfsdk:"upstream_url"`
+	CredentialId        types.String `tfsdk:"credential_id"`
+	LocalRepositoryId   types.String `tfsdk:"local_repository_id"`
+	RemoteRepositoryId  types.String `tfsdk:"remote_repository_id"`
+	VirtualRepositoryId types.String `tfsdk:"virtual_repository_id"`
+	Url                 types.String `tfsdk:"url"`
+}
+
+func (m *RegistryStackResourceModel) stack() repositoryStack {
+	return repositoryStack{
+		LocalId:   m.LocalRepositoryId.ValueString(),
+		RemoteId:  m.RemoteRepositoryId.ValueString(),
+		VirtualId: m.VirtualRepositoryId.ValueString(),
+	}
+}
+
+func (r *RegistryStackResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry_stack"
+}
+
+func (r *RegistryStackResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Registry stack resource. Provision the recommended registry layout of a package type: a local repository `<name>-local` for the internal packages, a remote repository `<name>-remote` proxying the upstream, and a virtual repository `<name>` serving both and uploading to the local one. Use `repoflow_repository_set` for many package types at once.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repositories (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the virtual repository, used by the clients, and prefix of the names of the local and remote ones.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: "Package type of the repositories.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(packageTypes...),
+				},
+			},
+			"upstream_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the upstream registry proxied by the remote repository. Changing it replaces the remote repository, losing its cache.",
+				Required:            true,
+			},
+			"credential_id": schema.StringAttribute{
+				MarkdownDescription: "Id of a `repoflow_credential` of the workspace used to authenticate to the upstream.",
+				Optional:            true,
+			},
+			"local_repository_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the local repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_repository_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the remote repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"virtual_repository_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the virtual repository.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL of the virtual repository, to configure in the package managers.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Registry stack identifier, in the form `workspaceId/name`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RegistryStackResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RegistryStackResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RegistryStackResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	stack, err := createRepositoryStack(r.client, ws, data.Name.ValueString(), data.PackageType.ValueString(), data.UpstreamUrl.ValueString(), data.CredentialId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create registry stack %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	r.mapStackToModel(&data, ws, *stack)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow registry stack resource", map[string]interface{}{
+		"id":           data.Id.ValueString(),
+		"package_type": data.PackageType.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryStackResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RegistryStackResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, _, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	ws, err := r.client.GetWorkspace(workspaceId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspaceId, err))
+		return
+	}
+
+	remote, err := readRepositoryStack(r.client, ws.Id, data.stack())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read registry stack %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	// The stack is planned again when a repository is missing, the remaining
+	// repositories must then be deleted or imported by hand
+	if remote == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.PackageType = types.StringValue(remote.PackageType)
+	data.UpstreamUrl = types.StringPointerValue(remote.RemoteRepositoryUrl)
+	data.CredentialId = types.StringPointerValue(remote.CredentialId)
+	r.mapStackToModel(&data, ws, data.stack())
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryStackResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RegistryStackResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, _, _ := strings.Cut(state.Id.ValueString(), "/")
+
+	ws, err := r.client.GetWorkspace(workspaceId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspaceId, err))
+		return
+	}
+
+	stack := state.stack()
+
+	if !data.UpstreamUrl.Equal(state.UpstreamUrl) {
+		err := replaceStackRemote(r.client, ws, data.Name.ValueString(), data.PackageType.ValueString(), &stack, data.UpstreamUrl.ValueString(), data.CredentialId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to replace the remote repository of registry stack %s, got error: %s", data.Name.ValueString(), err))
+			// The previous remote may already be gone
+			state.RemoteRepositoryId = types.StringValue(stack.RemoteId)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+			return
+		}
+	} else if !data.CredentialId.Equal(state.CredentialId) {
+		// An empty Id detaches the credential
+		credentialId := data.CredentialId.ValueString()
+		_, err := r.client.UpdateRemoteRepository(ws.Id, stack.RemoteId, client.RepositoryRemoteUpdateOptions{
+			CredentialId: &credentialId,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository %s, got error: %s", stack.RemoteId, err))
+			return
+		}
+	}
+
+	r.mapStackToModel(&data, ws, stack)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryStackResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RegistryStackResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, _, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	if err := deleteRepositoryStack(r.client, workspaceId, data.stack()); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete registry stack %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow registry stack resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *RegistryStackResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, name, _ := strings.Cut(req.ID, "/")
+
+	if workspace == "" || name == "" || strings.Contains(name, "/") {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/name. You define: %q", req.ID),
+		)
+		return
+	}
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	// Find the repositories by name, the other attributes are set by Read
+	localName, remoteName, virtualName := repositoryStackNames(name)
+	var stack repositoryStack
+	for _, rp := range []struct {
+		name string
+		id   *string
+	}{{localName, &stack.LocalId}, {remoteName, &stack.RemoteId}, {virtualName, &stack.VirtualId}} {
+		repository, err := r.client.GetRepository(ws.Id, rp.name)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository %s on workspaceId %s, got error: %s", rp.name, ws.Id, err))
+			return
+		}
+		*rp.id = repository.Id
+	}
+
+	data := RegistryStackResourceModel{
+		WorkspaceId: types.StringValue(workspace),
+		Name:        types.StringValue(name),
+	}
+	r.mapStackToModel(&data, ws, stack)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RegistryStackResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create and destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("upstream_url"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("upstream_url"), &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A new upstream replaces the remote repository
+	if !planned.IsUnknown() && !planned.Equal(prior) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("remote_repository_id"), types.StringUnknown())...)
+	}
+}
+
+func (r *RegistryStackResource) mapStackToModel(data *RegistryStackResourceModel, ws *client.Workspace, stack repositoryStack) {
+	data.Id = types.StringValue(ws.Id + "/" + data.Name.ValueString())
+	data.LocalRepositoryId = types.StringValue(stack.LocalId)
+	data.RemoteRepositoryId = types.StringValue(stack.RemoteId)
+	data.VirtualRepositoryId = types.StringValue(stack.VirtualId)
+
+	// The package type is unknown on import, until Read
+	_, _, virtualName := repositoryStackNames(data.Name.ValueString())
+	data.Url = types.StringNull()
+	if !data.PackageType.IsNull() {
+		data.Url = types.StringValue(r.client.RepositoryEndpointUrl(data.PackageType.ValueString(), ws.Name, virtualName))
+	}
+}
@@ -0,0 +1,85 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func TestRegistryStackResource(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	config := func(upstream string) tftypes.Value {
+		return p.config("repoflow_registry_stack", map[string]tftypes.Value{
+			"workspace":    str(workspaceId),
+			"name":         str("npm"),
+			"package_type": str("npm"),
+			"upstream_url": str(upstream),
+		})
+	}
+
+	state := p.apply("repoflow_registry_stack", p.nullState("repoflow_registry_stack"), config("https://registry.npmjs.org"))
+
+	local, err := p.client.GetRepository(workspaceId, "npm-local")
+	if err != nil {
+		t.Fatalf("local repository not created: %s", err)
+	}
+	virtual, err := p.client.GetRepository(workspaceId, "npm")
+	if err != nil {
+		t.Fatalf("virtual repository not created: %s", err)
+	}
+	if virtual.UploadLocalRepositoryId == nil || *virtual.UploadLocalRepositoryId != local.Id {
+		t.Errorf("virtual repository uploads to %v, want %s", virtual.UploadLocalRepositoryId, local.Id)
+	}
+
+	// Refresh without change
+	if refreshed := p.read("repoflow_registry_stack", state); !refreshed.Equal(state) {
+		t.Errorf("refresh changed the state:\n%v\nwant\n%v", refreshed, state)
+	}
+
+	// A new upstream replaces the remote repository in place of the stack
+	remoteId := stringAttr(t, state, "remote_repository_id")
+	state = p.applyInPlace("repoflow_registry_stack", state, config("https://npm.example.com"))
+	if got := stringAttr(t, state, "remote_repository_id"); got == remoteId || got == "" {
+		t.Errorf("remote_repository_id = %q after a new upstream, previous %q", got, remoteId)
+	}
+	if _, err := p.client.GetRepository(workspaceId, remoteId); !client.IsNotFound(err) {
+		t.Errorf("previous remote repository not deleted: %v", err)
+	}
+	if got := stringAttr(t, state, "virtual_repository_id"); got != virtual.Id {
+		t.Errorf("virtual repository replaced, virtual_repository_id = %q, want %q", got, virtual.Id)
+	}
+
+	imported := p.importState("repoflow_registry_stack", "platform/npm")
+	if got := stringAttr(t, imported, "upstream_url"); got != "https://npm.example.com" {
+		t.Errorf("imported upstream_url = %q", got)
+	}
+
+	p.destroy("repoflow_registry_stack", state)
+	if rps, _ := p.client.ListRepositories(workspaceId); len(*rps) != 0 {
+		t.Errorf("repositories left after destroy: %v", *rps)
+	}
+}
+
+func TestRegistryStackResourceDeletedOutside(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	state := p.apply("repoflow_registry_stack", p.nullState("repoflow_registry_stack"), p.config("repoflow_registry_stack", map[string]tftypes.Value{
+		"workspace":    str(workspaceId),
+		"name":         str("npm"),
+		"package_type": str("npm"),
+		"upstream_url": str("https://registry.npmjs.org"),
+	}))
+
+	// A stack missing a repository is planned again
+	if _, err := p.client.DeleteRepository(workspaceId, stringAttr(t, state, "virtual_repository_id")); err != nil {
+		t.Fatalf("DeleteRepository: %s", err)
+	}
+	if state := p.read("repoflow_registry_stack", state); !state.IsNull() {
+		t.Errorf("incomplete stack still in state: %v", state)
+	}
+}
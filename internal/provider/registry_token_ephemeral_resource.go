@@ -0,0 +1,259 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Private state key holding the Id of the token to revoke on close
+const registryTokenIdKey = "token_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &RegistryTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &RegistryTokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithClose = &RegistryTokenEphemeralResource{}
+
+func NewRegistryTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &RegistryTokenEphemeralResource{}
+}
+
+// RegistryTokenEphemeralResource defines the ephemeral resource implementation.
+type RegistryTokenEphemeralResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.CredentialsAPI
+	}
+}
+
+// RegistryTokenEphemeralResourceModel describes the ephemeral resource data model.
+type RegistryTokenEphemeralResourceModel struct {
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	Scopes      types.Set    `tfsdk:"scopes"`
+	Ttl         types.String `tfsdk:"ttl"`
+	Hostname    types.String `tfsdk:"hostname"`
+	EnvName     types.String `tfsdk:"env_name"`
+	Token       types.String `tfsdk:"token"`
+	TokenId     types.String `tfsdk:"token_id"`
+	ExpiresAt   types.String `tfsdk:"expires_at"`
+}
+
+func (r *RegistryTokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry_token"
+}
+
+func (r *RegistryTokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Registry token ephemeral resource. Create a short-lived access token for a `terraform-module` or `terraform-provider` repository, never stored in the state or the plan, e.g. to fill the `credentials` block of the Terraform CLI configuration or the `TF_TOKEN_*` environment variable of a job. The token is revoked once Terraform is done with it.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Terraform registry repository (name or Id).",
+				Required:            true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the token, default to `packages:read`. Add `packages:write` to publish modules or providers.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(tokenScopes...)),
+				},
+			},
+			"ttl": schema.StringAttribute{
+				MarkdownDescription: "Lifetime of the token as a duration, e.g. `30m`, default to `1h`. The token is revoked earlier when Terraform closes the ephemeral resource.",
+				Optional:            true,
+			},
+			"hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the registry, the label of the `credentials` block.",
+				Computed:            true,
+			},
+			"env_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the environment variable holding the token for the Terraform CLI, e.g. `TF_TOKEN_repoflow_example_com`.",
+				Computed:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "Token value.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"token_id": schema.StringAttribute{
+				MarkdownDescription: "Access token Id",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiration date of the token (RFC 3339).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *RegistryTokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RegistryTokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data RegistryTokenEphemeralResourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ttl := time.Hour
+	if !data.Ttl.IsNull() {
+		d, err := time.ParseDuration(data.Ttl.ValueString())
+		if err != nil || d < time.Minute {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ttl"),
+				"Invalid Duration",
+				fmt.Sprintf("The ttl must be a duration of at least 1m, like 30m or 2h, got %q.", data.Ttl.ValueString()),
+			)
+			return
+		}
+		ttl = d
+	}
+
+	scopes := []string{"packages:read"}
+	if !data.Scopes.IsNull() {
+		resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &scopes, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	rp, err := r.client.GetRepository(workspaceId, repositoryId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
+		))
+		return
+	}
+
+	if rp.TerraformRegistry == nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("repository"),
+			"Not a Terraform Registry",
+			fmt.Sprintf("Repository %s stores %s packages, registry tokens are only created for the terraform-module and terraform-provider package types.", rp.Name, rp.PackageType),
+		)
+		return
+	}
+
+	expiresAt := time.Now().Add(ttl).UTC().Format(time.RFC3339)
+	at, err := r.client.CreateAccessToken(client.AccessTokenOptions{
+		Description: fmt.Sprintf("Terraform registry %s (ephemeral)", rp.Name),
+		Scopes:      scopes,
+		ExpiresAt:   &expiresAt,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create registry token, got error: %s", err))
+		return
+	}
+
+	tokenId, err := json.Marshal(at.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to save registry token Id, got error: %s", err))
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, registryTokenIdKey, tokenId)...)
+
+	hostname := rp.TerraformRegistry.Hostname
+	data.Hostname = types.StringValue(hostname)
+	data.EnvName = types.StringValue(registryTokenEnvName(hostname))
+	data.Token = types.StringPointerValue(at.Token)
+	data.TokenId = types.StringValue(at.Id)
+	data.ExpiresAt = types.StringPointerValue(at.ExpiresAt)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "opened a repoflow registry token ephemeral resource", map[string]interface{}{
+		"token_id": at.Id,
+		"hostname": hostname,
+	})
+
+	// Save data into the ephemeral result
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *RegistryTokenEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	tokenId, diags := req.Private.GetKey(ctx, registryTokenIdKey)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() || tokenId == nil {
+		return
+	}
+
+	var id string
+	if err := json.Unmarshal(tokenId, &id); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read registry token Id, got error: %s", err))
+		return
+	}
+
+	// Expired tokens may already be purged
+	if err := r.client.DeleteAccessToken(id); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke registry token %s, got error: %s", id, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "closed a repoflow registry token ephemeral resource", map[string]interface{}{
+		"token_id": id,
+	})
+}
+
+// registryTokenEnvName returns the environment variable read by the Terraform
+// CLI for the credentials of a host: dots become underscores and dashes double
+// underscores.
+func registryTokenEnvName(hostname string) string {
+	name := strings.ReplaceAll(hostname, "-", "__")
+	name = strings.ReplaceAll(name, ".", "_")
+	return "TF_TOKEN_" + name
+}
@@ -0,0 +1,193 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoriesDataSource{}
+
+func NewRepositoriesDataSource() datasource.DataSource {
+	return &RepositoriesDataSource{}
+}
+
+// RepositoriesDataSource defines the data source implementation.
+type RepositoriesDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+type RepositoriesDataSourceModel struct {
+	WorkspaceId    types.String           `tfsdk:"workspace"`
+	PackageType    types.String           `tfsdk:"package_type"`
+	RepositoryType types.String           `tfsdk:"repository_type"`
+	NamePrefix     types.String           `tfsdk:"name_prefix"`
+	Repositories   []RepositoryEntryModel `tfsdk:"repositories"`
+}
+
+type RepositoryEntryModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	PackageType    types.String `tfsdk:"package_type"`
+	RepositoryType types.String `tfsdk:"repository_type"`
+	Status         types.String `tfsdk:"status"`
+}
+
+func (d *RepositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *RepositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repositories data source. The repositories of a workspace, optionally filtered by package type, repository type or name prefix.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repositories (name or Id)",
+				Required:            true,
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the repositories of this package type.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(packageTypes...),
+				},
+			},
+			"repository_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the repositories of this type, `local`, `remote` or `virtual`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("local", "remote", "virtual"),
+				},
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only return the repositories whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				MarkdownDescription: "Repositories found, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Repository identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Repository name",
+							Computed:            true,
+						},
+						"package_type": schema.StringAttribute{
+							MarkdownDescription: "Package type of the repository",
+							Computed:            true,
+						},
+						"repository_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the repository, `local`, `remote` or `virtual`",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the repository",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := d.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	// The API returns every repository of the workspace at once, there is no page to follow
+	rps, err := d.client.ListRepositories(ws.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list repositories of workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	data.Repositories = []RepositoryEntryModel{}
+
+	for _, item := range *rps {
+		if !data.PackageType.IsNull() && item.PackageType != data.PackageType.ValueString() {
+			continue
+		}
+		if !data.RepositoryType.IsNull() && item.RepositoryType != data.RepositoryType.ValueString() {
+			continue
+		}
+		if !strings.HasPrefix(item.Name, data.NamePrefix.ValueString()) {
+			continue
+		}
+
+		data.Repositories = append(data.Repositories, RepositoryEntryModel{
+			Id:             types.StringValue(item.Id),
+			Name:           types.StringValue(item.Name),
+			PackageType:    types.StringValue(item.PackageType),
+			RepositoryType: types.StringValue(item.RepositoryType),
+			Status:         types.StringValue(item.Status),
+		})
+	}
+	sort.Slice(data.Repositories, func(i, j int) bool {
+		return data.Repositories[i].Name.ValueString() < data.Repositories[j].Name.ValueString()
+	})
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read repositories data", map[string]interface{}{
+		"workspace": ws.Id,
+		"listed":    len(*rps),
+		"found":     len(data.Repositories),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
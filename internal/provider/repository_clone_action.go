@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &RepositoryCloneAction{}
+var _ action.ActionWithConfigure = &RepositoryCloneAction{}
+var _ action.ActionWithValidateConfig = &RepositoryCloneAction{}
+
+func NewRepositoryCloneAction() action.Action {
+	return &RepositoryCloneAction{}
+}
+
+// RepositoryCloneAction defines the action implementation.
+type RepositoryCloneAction struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.InstanceAPI
+	}
+}
+
+// RepositoryCloneActionModel describes the action data model.
+type RepositoryCloneActionModel struct {
+	WorkspaceId       types.String `tfsdk:"workspace"`
+	Repository        types.String `tfsdk:"repository"`
+	Name              types.String `tfsdk:"name"`
+	TargetWorkspaceId types.String `tfsdk:"target_workspace"`
+	IncludeContents   types.Bool   `tfsdk:"include_contents"`
+	Timeout           types.String `tfsdk:"timeout"`
+}
+
+func (a *RepositoryCloneAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_clone"
+}
+
+func (a *RepositoryCloneAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository clone action. Create a new repository with the configuration, and optionally the contents, of another one, like a per-team copy of a template repository. The new repository is not managed by Terraform, import it in a `repoflow_repository` resource to manage it.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the source repository (name or Id).",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Source repository name or Id.",
+				Required:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the new repository.",
+				Required:            true,
+			},
+			"target_workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the new repository (name or Id), the workspace of the source repository when unset. Virtual repositories can only be cloned in their workspace.",
+				Optional:            true,
+			},
+			"include_contents": schema.BoolAttribute{
+				MarkdownDescription: "Also copy the packages of the source repository (default `false`).",
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the copy of the contents, as a duration like `45m` or `2h` (default `30m`). The copy keeps running on the server after the timeout.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *RepositoryCloneAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *RepositoryCloneAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data RepositoryCloneActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+		return
+	}
+
+	if _, err := parseTimeout(data.Timeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+	}
+}
+
+func (a *RepositoryCloneAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data RepositoryCloneActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultSystemTaskTimeout
+	if !data.Timeout.IsNull() {
+		var err error
+		if timeout, err = parseTimeout(data.Timeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+			return
+		}
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+	repository := data.Repository.ValueString()
+
+	ws, err := a.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rp, err := a.client.GetRepository(ws.Id, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository %s on workspaceId %s, got error: %s", repository, ws.Id, err,
+		))
+		return
+	}
+
+	opts := client.RepositoryCloneOptions{
+		Name:              data.Name.ValueString(),
+		TargetWorkspaceId: ws.Id,
+		IncludeContents:   data.IncludeContents.ValueBool(),
+	}
+
+	if !data.TargetWorkspaceId.IsNull() {
+		target := data.TargetWorkspaceId.ValueString()
+		tws, err := a.client.GetWorkspace(target)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", target, err))
+			return
+		}
+		opts.TargetWorkspaceId = tws.Id
+	}
+
+	clone, err := a.client.CloneRepository(ws.Id, rp.Id, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clone repository %s, got error: %s", repository, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "cloned a repoflow repository", map[string]interface{}{
+		"source":    rp.Id,
+		"id":        clone.Repository.Id,
+		"workspace": opts.TargetWorkspaceId,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Cloned repository %s into %s (%s/%s)", rp.Name, clone.Repository.Name, opts.TargetWorkspaceId, clone.Repository.Id),
+	})
+
+	if clone.Task == nil {
+		return
+	}
+
+	resp.Diagnostics.Append(waitSystemTask(ctx, a.client, clone.Task, timeout, "packages", resp.SendProgress)...)
+}
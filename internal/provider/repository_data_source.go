@@ -10,7 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
 	"github.com/fe80/terraform-provider-repoflow/internal/factory"
 )
 
@@ -23,7 +23,10 @@ func NewRepositoryDataSource() datasource.DataSource {
 
 // ExampleDataSource defines the data source implementation.
 type RepositoryDataSource struct {
-	client *repoflow.Client
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
 }
 
 type RepositoryDataSourceModel struct {
@@ -40,6 +43,9 @@ type RepositoryDataSourceModel struct {
 	ChildRepositoryIds                types.List   `tfsdk:"child_repository_ids"`
 	UploadLocalRepositoryId           types.String `tfsdk:"upload_local_repository_id"`
 	Status                            types.String `tfsdk:"status"`
+	RegistryHostname                  types.String `tfsdk:"registry_hostname"`
+	ServiceDiscoveryUrl               types.String `tfsdk:"service_discovery_url"`
+	RegistryApiUrl                    types.String `tfsdk:"registry_api_url"`
 }
 
 func (d *RepositoryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -101,6 +107,18 @@ func (d *RepositoryDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "Status of the repository",
 				Computed:            true,
 			},
+			"registry_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the registry in the module and provider sources (terraform-module and terraform-provider package types).",
+				Computed:            true,
+			},
+			"service_discovery_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the service discovery document of the registry (terraform-module and terraform-provider package types).",
+				Computed:            true,
+			},
+			"registry_api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the `modules.v1` or `providers.v1` API of the repository (terraform-module and terraform-provider package types).",
+				Computed:            true,
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Repository identifier",
 				Computed:            true,
@@ -114,12 +132,12 @@ func (d *RepositoryDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*repoflow.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -185,6 +203,13 @@ func (d *RepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Virtual attributes
 	data.UploadLocalRepositoryId = types.StringPointerValue(rp.UploadLocalRepositoryId)
 
+	// Terraform registry attributes
+	if rp.TerraformRegistry != nil {
+		data.RegistryHostname = types.StringValue(rp.TerraformRegistry.Hostname)
+		data.ServiceDiscoveryUrl = types.StringValue(rp.TerraformRegistry.ServiceDiscoveryUrl)
+		data.RegistryApiUrl = types.StringValue(rp.TerraformRegistry.ApiUrl)
+	}
+
 	// Handling ChildRepositories (conversion objets -> ids)
 	if rp.ChildRepositories == nil {
 		data.ChildRepositoryIds = types.ListNull(types.StringType)
@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Index or metadata path probed by default, by package type. The root of the
+// repository endpoint is probed for the other types.
+var endpointCheckPaths = map[string]string{
+	"cargo":    "config.json",
+	"composer": "packages.json",
+	"conan":    "v1/ping",
+	"helm":     "index.yaml",
+	"pypi":     "simple/",
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoryEndpointCheckDataSource{}
+
+func NewRepositoryEndpointCheckDataSource() datasource.DataSource {
+	return &RepositoryEndpointCheckDataSource{}
+}
+
+// RepositoryEndpointCheckDataSource defines the data source implementation.
+type RepositoryEndpointCheckDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+type RepositoryEndpointCheckDataSourceModel struct {
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	Path        types.String `tfsdk:"path"`
+	Url         types.String `tfsdk:"url"`
+	StatusCode  types.Int64  `tfsdk:"status_code"`
+	LatencyMs   types.Int64  `tfsdk:"latency_ms"`
+	Healthy     types.Bool   `tfsdk:"healthy"`
+}
+
+func (d *RepositoryEndpointCheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_endpoint_check"
+}
+
+func (d *RepositoryEndpointCheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository endpoint check data source. Probe the package endpoint of a repository with a `HEAD` request on its index or metadata, e.g. in a `check` block to smoke test the repositories after provisioning. The probe runs on every plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id)",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Path probed under the repository endpoint. Default to the index of the package type: `config.json` for cargo, `packages.json` for composer, `v1/ping` for conan, `index.yaml` for helm, `simple/` for pypi, the root of the endpoint otherwise.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL probed.",
+				Computed:            true,
+			},
+			"status_code": schema.Int64Attribute{
+				MarkdownDescription: "HTTP status of the response.",
+				Computed:            true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				MarkdownDescription: "Time to the response in milliseconds.",
+				Computed:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the endpoint answered with a success or a redirection status (below 400).",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RepositoryEndpointCheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoryEndpointCheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoryEndpointCheckDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+	repository := data.Repository.ValueString()
+
+	ws, err := d.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rp, err := d.client.GetRepository(ws.Id, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository %s on workspaceId %s, got error: %s", repository, ws.Id, err,
+		))
+		return
+	}
+
+	path := endpointCheckPaths[rp.PackageType]
+	if !data.Path.IsNull() {
+		path = data.Path.ValueString()
+	}
+
+	// The package endpoints are served by names
+	check, err := d.client.CheckRepositoryEndpoint(rp.PackageType, ws.Name, rp.Name, path)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to probe the endpoint of repository %s, got error: %s", repository, err))
+		return
+	}
+
+	data.Path = types.StringValue(path)
+	data.Url = types.StringValue(check.Url)
+	data.StatusCode = types.Int64Value(int64(check.StatusCode))
+	data.LatencyMs = types.Int64Value(check.LatencyMs)
+	data.Healthy = types.BoolValue(check.StatusCode < 400)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read repository endpoint check data", map[string]interface{}{
+		"url":         check.Url,
+		"status_code": check.StatusCode,
+		"latency_ms":  check.LatencyMs,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,341 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Roles granted on a repository
+var repositoryRoles = []string{"read", "write", "delete", "manage"}
+
+// Principals are users or groups, prefixed by their kind
+var principalRegexp = regexp.MustCompile(`^(user|group):[^/]+$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryPermissionResource{}
+var _ resource.ResourceWithImportState = &RepositoryPermissionResource{}
+
+func NewRepositoryPermissionResource() resource.Resource {
+	return &RepositoryPermissionResource{}
+}
+
+// RepositoryPermissionResource defines the resource implementation.
+type RepositoryPermissionResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PermissionsAPI
+	}
+}
+
+// RepositoryPermissionResourceModel describes the resource data model.
+type RepositoryPermissionResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Repository  types.String `tfsdk:"repository"`
+	Principal   types.String `tfsdk:"principal"`
+	Role        types.String `tfsdk:"role"`
+}
+
+func (r *RepositoryPermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_permission"
+}
+
+func (r *RepositoryPermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository permission resource. Grant a role on a repository to a user or a group. The grants of a repository applied together, like a large access review, are sent in a single request when the instance supports it.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				MarkdownDescription: "User or group granted the role, in the form `user:<name>` or `group:<name>`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(principalRegexp, "must be in the form user:<name> or group:<name>"),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role granted on the repository, one of `read`, `write`, `delete` or `manage`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(repositoryRoles...),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Permission identifier, in the form `workspaceId/repositoryId/principal`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RepositoryPermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryPermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryPermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	principal := data.Principal.ValueString()
+	opts := client.RepositoryPermissionOptions{
+		Role: data.Role.ValueString(),
+	}
+
+	perm, err := r.client.PutRepositoryPermission(workspaceId, repositoryId, principal, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to grant %s on repository %s, got error: %s", principal, repositoryId, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, principal}, "/"))
+	data.Role = types.StringValue(perm.Role)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow repository permission resource", map[string]interface{}{
+		"id":   data.Id.ValueString(),
+		"role": perm.Role,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryPermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryPermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected repository permission identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	perm, err := r.client.GetRepositoryPermission(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get repository permission %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	data.Principal = types.StringValue(idParts[2])
+	data.Role = types.StringValue(perm.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryPermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RepositoryPermissionResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected repository permission identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	// Only the role can change in place
+	opts := client.RepositoryPermissionOptions{
+		Role: data.Role.ValueString(),
+	}
+
+	perm, err := r.client.PutRepositoryPermission(idParts[0], idParts[1], idParts[2], opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository permission, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(perm.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryPermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryPermissionResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected repository permission identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeleteRepositoryPermission(idParts[0], idParts[1], idParts[2]); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository permission, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow repository permission resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *RepositoryPermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data RepositoryPermissionResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || !principalRegexp.MatchString(idParts[2]) {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/principal, with principal user:<name> or group:<name>. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	perm, err := r.client.GetRepositoryPermission(workspaceId, repositoryId, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import repository permission %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, idParts[2]}, "/"))
+	data.WorkspaceId = types.StringValue(idParts[0])
+	data.Repository = types.StringValue(idParts[1])
+	data.Principal = types.StringValue(idParts[2])
+	data.Role = types.StringValue(perm.Role)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// repositoryResolver is the part of the API looking up the workspaces and
+// repositories given by name or Id.
+type repositoryResolver interface {
+	client.WorkspacesAPI
+	client.RepositoriesAPI
+}
+
+// resolveRepository returns the Id of a workspace and a repository given by name or Id.
+func resolveRepository(c repositoryResolver, workspace string, repository string) (string, string, error) {
+	ws, err := c.GetWorkspace(workspace)
+	if err != nil {
+		return "", "", fmt.Errorf("Unable to get workspace %s, got error: %s", workspace, err)
+	}
+
+	rp, err := c.GetRepository(ws.Id, repository)
+	if err != nil {
+		return "", "", fmt.Errorf("Unable to read repository %s on workspaceId %s, got error: %s", repository, ws.Id, err)
+	}
+
+	return ws.Id, rp.Id, nil
+}
+
+// resolveScope returns the Id of a workspace and, when set, of a repository given by name or Id.
+func resolveScope(c repositoryResolver, workspace string, repository string) (string, string, error) {
+	if repository != "" {
+		return resolveRepository(c, workspace, repository)
+	}
+
+	ws, err := c.GetWorkspace(workspace)
+	if err != nil {
+		return "", "", fmt.Errorf("Unable to get workspace %s, got error: %s", workspace, err)
+	}
+
+	return ws.Id, "", nil
+}
+
+// scopeId returns the state identifier of a setting of a repository, or of the workspace.
+func scopeId(workspaceId string, repositoryId string) string {
+	if repositoryId == "" {
+		return workspaceId
+	}
+	return workspaceId + "/" + repositoryId
+}
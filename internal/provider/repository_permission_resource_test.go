@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func TestRepositoryPermissionResource(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	rp, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+	})
+	if err != nil {
+		t.Fatalf("CreateLocalRepository: %s", err)
+	}
+
+	config := func(role string) tftypes.Value {
+		return p.config("repoflow_repository_permission", map[string]tftypes.Value{
+			"workspace":  str("platform"),
+			"repository": str("npm-local"),
+			"principal":  str("group:developers"),
+			"role":       str(role),
+		})
+	}
+
+	state := p.apply("repoflow_repository_permission", p.nullState("repoflow_repository_permission"), config("read"))
+
+	if got, want := stringAttr(t, state, "id"), workspaceId+"/"+rp.Id+"/group:developers"; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+	if perm, err := p.client.GetRepositoryPermission(workspaceId, rp.Id, "group:developers"); err != nil || perm.Role != "read" {
+		t.Errorf("permission not granted: %v, %v", perm, err)
+	}
+
+	// The role is updated in place
+	state = p.applyInPlace("repoflow_repository_permission", state, config("write"))
+	if perm, err := p.client.GetRepositoryPermission(workspaceId, rp.Id, "group:developers"); err != nil || perm.Role != "write" {
+		t.Errorf("permission not updated: %v, %v", perm, err)
+	}
+
+	imported := p.importState("repoflow_repository_permission", "platform/npm-local/group:developers")
+	if got := stringAttr(t, imported, "role"); got != "write" {
+		t.Errorf("imported role = %q, want write", got)
+	}
+
+	p.destroy("repoflow_repository_permission", state)
+	if _, err := p.client.GetRepositoryPermission(workspaceId, rp.Id, "group:developers"); !client.IsNotFound(err) {
+		t.Errorf("permission not revoked: %v", err)
+	}
+}
+
+func TestRepositoryPermissionResourceInvalid(t *testing.T) {
+	p := newTestProvider(t)
+	newTestWorkspace(t, p, "platform")
+
+	for name, attrs := range map[string]map[string]tftypes.Value{
+		"principal without kind": {"principal": str("developers"), "role": str("read")},
+		"unknown role":           {"principal": str("user:alice"), "role": str("admin")},
+	} {
+		t.Run(name, func(t *testing.T) {
+			attrs["workspace"] = str("platform")
+			attrs["repository"] = str("npm-local")
+
+			if _, diags := p.tryApply("repoflow_repository_permission", p.nullState("repoflow_repository_permission"), p.config("repoflow_repository_permission", attrs)); !hasError(diags) {
+				t.Error("invalid configuration accepted")
+			}
+		})
+	}
+}
@@ -2,34 +2,46 @@ package provider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	// "github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/fe80/go-repoflow/pkg/repoflow"
 
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
 	"github.com/fe80/terraform-provider-repoflow/internal/factory"
 )
 
+// Package types supported by the repositories
+var packageTypes = []string{
+	"cargo", "composer", "conan", "debian", "docker", "gems", "go", "helm",
+	"maven", "npm", "nuget", "oci", "pub", "pypi", "rpm", "swift",
+	"terraform-module", "terraform-provider", "universal",
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &RepositoryResource{}
 var _ resource.ResourceWithImportState = &RepositoryResource{}
+var _ resource.ResourceWithUpgradeState = &RepositoryResource{}
+var _ resource.ResourceWithModifyPlan = &RepositoryResource{}
 
 func NewRepositoryResource() resource.Resource {
 	return &RepositoryResource{}
@@ -37,25 +49,65 @@ func NewRepositoryResource() resource.Resource {
 
 // RepositoryResource defines the resource implementation.
 type RepositoryResource struct {
-	client *repoflow.Client
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.LabelsAPI
+	}
 }
 
 // RepositoryResourceModel describes the resource data model.
 type RepositoryResourceModel struct {
-	Name                              types.String `tfsdk:"name"`
-	Id                                types.String `tfsdk:"id"`
-	WorkspaceId                       types.String `tfsdk:"workspace"`
-	PackageType                       types.String `tfsdk:"package_type"`
-	RepositoryType                    types.String `tfsdk:"repository_type"`
-	RepositoryId                      types.String `tfsdk:"repository_id"`
-	RemoteRepositoryUrl               types.String `tfsdk:"remote_repository_url"`
-	RemoteRepositoryUsername          types.String `tfsdk:"remote_repository_username"`
-	RemoteRepositoryPassword          types.String `tfsdk:"remote_repository_password"`
-	RemoteCacheEnabled                types.Bool   `tfsdk:"remote_cache_enabled"`
+	Name                types.String            `tfsdk:"name"`
+	Id                  types.String            `tfsdk:"id"`
+	WorkspaceId         types.String            `tfsdk:"workspace"`
+	PackageType         types.String            `tfsdk:"package_type"`
+	RepositoryType      types.String            `tfsdk:"repository_type"`
+	RepositoryId        types.String            `tfsdk:"repository_id"`
+	ManagedBy           types.String            `tfsdk:"managed_by"`
+	RegistryHostname    types.String            `tfsdk:"registry_hostname"`
+	ServiceDiscoveryUrl types.String            `tfsdk:"service_discovery_url"`
+	RegistryApiUrl      types.String            `tfsdk:"registry_api_url"`
+	StorageBackendId    types.String            `tfsdk:"storage_backend_id"`
+	Labels              types.Map               `tfsdk:"labels"`
+	Remote              *RepositoryRemoteModel  `tfsdk:"remote"`
+	Virtual             *RepositoryVirtualModel `tfsdk:"virtual"`
+	Conan               *RepositoryConanModel   `tfsdk:"conan"`
+	Oci                 *RepositoryOciModel     `tfsdk:"oci"`
+}
+
+// RepositoryRemoteModel describes the remote block data model.
+type RepositoryRemoteModel struct {
+	Url                               types.String `tfsdk:"url"`
+	Username                          types.String `tfsdk:"username"`
+	Password                          types.String `tfsdk:"password"`
+	PasswordWo                        types.String `tfsdk:"password_wo"`
+	PasswordWoVersion                 types.Int64  `tfsdk:"password_wo_version"`
+	HeaderName                        types.String `tfsdk:"header_name"`
+	HeaderValueWo                     types.String `tfsdk:"header_value_wo"`
+	HeaderValueWoVersion              types.Int64  `tfsdk:"header_value_wo_version"`
+	CredentialId                      types.String `tfsdk:"credential_id"`
+	CacheEnabled                      types.Bool   `tfsdk:"cache_enabled"`
 	FileCacheTimeTillRevalidation     types.Int64  `tfsdk:"file_cache_time_till_revalidation"`
 	MetadataCacheTimeTillRevalidation types.Int64  `tfsdk:"metadata_cache_time_till_revalidation"`
-	ChildRepositoryIds                types.List   `tfsdk:"child_repository_ids"`
-	UploadLocalRepositoryId           types.String `tfsdk:"upload_local_repository_id"`
+}
+
+// RepositoryVirtualModel describes the virtual block data model.
+type RepositoryVirtualModel struct {
+	ChildRepositoryIds      types.Set    `tfsdk:"child_repository_ids"`
+	UploadLocalRepositoryId types.String `tfsdk:"upload_local_repository_id"`
+	Members                 types.Set    `tfsdk:"members"`
+	DeploymentPolicy        types.String `tfsdk:"deployment_policy"`
+}
+
+// RepositoryConanModel describes the conan block data model.
+type RepositoryConanModel struct {
+	RevisionsEnabled types.Bool `tfsdk:"revisions_enabled"`
+}
+
+// RepositoryOciModel describes the oci block data model.
+type RepositoryOciModel struct {
+	AllowedMediaTypes types.Set `tfsdk:"allowed_media_types"`
 }
 
 func (r *RepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +118,9 @@ func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequ
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: "Repository resource",
+		// Version 1: child_repository_ids is a set
+		// Version 2: remote and virtual settings are nested blocks
+		Version: 2,
 
 		Attributes: map[string]schema.Attribute{
 			//Required
@@ -100,83 +155,54 @@ func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
-					stringvalidator.OneOf(
-						"cargo", "composer", "debian", "docker", "gems", "go", "helm",
-						"maven", "npm", "nuget", "pypi", "rpm", "universal",
-					),
-				},
-			},
-
-			// Optional
-			"remote_repository_url": schema.StringAttribute{
-				MarkdownDescription: "URL of the remote repository (require for remote respository type).",
-				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
-			},
-			"remote_repository_username": schema.StringAttribute{
-				MarkdownDescription: "Username for the remote repository.",
-				Optional:            true,
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringvalidator.OneOf(packageTypes...),
 				},
 			},
-			"remote_repository_password": schema.StringAttribute{
-				MarkdownDescription: "Password for the remote repository.",
+			"storage_backend_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the `repoflow_storage_backend` storing the packages of a local or remote repository, the one of the workspace when unset. Moving a repository to another backend requires a replacement. Not supported by the virtual repositories, which store no package.",
 				Optional:            true,
-				Sensitive:           true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
-			"remote_cache_enabled": schema.BoolAttribute{
-				MarkdownDescription: "Whether caching is enabled.",
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels attached to the repository. Keys and values are checked against the `repoflow_label_taxonomy` declared on the instance. Updated in place.",
 				Optional:            true,
-				Computed:            true,
-				Default:             booldefault.StaticBool(false),
-				PlanModifiers: []planmodifier.Bool{
-					boolplanmodifier.RequiresReplace(),
-				},
+				ElementType:         types.StringType,
 			},
-			"file_cache_time_till_revalidation": schema.Int64Attribute{
-				MarkdownDescription: "Milliseconds before cached files require revalidation (null for indefinite caching).",
-				Optional:            true,
+
+			// Computed attributes
+			"repository_id": schema.StringAttribute{
 				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
+				MarkdownDescription: "Repository identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"metadata_cache_time_till_revalidation": schema.Int64Attribute{
-				MarkdownDescription: "Milliseconds before cached metadata requires revalidation (null for indefinite caching).",
-				Optional:            true,
+			"managed_by": schema.StringAttribute{
+				MarkdownDescription: "Tool managing the repository, always `terraform` once applied. A repository created by hand and imported gets the marker on the next apply.",
 				Computed:            true,
-				PlanModifiers: []planmodifier.Int64{
-					int64planmodifier.RequiresReplace(),
-				},
+				Default:             stringdefault.StaticString(managedByMarker),
 			},
-			"child_repository_ids": schema.ListAttribute{
-				MarkdownDescription: "IDs of repositories included in the virtual repository. (require for virtual repository type)",
-				Optional:            true,
+			"registry_hostname": schema.StringAttribute{
+				MarkdownDescription: "Hostname of the registry in the module and provider sources, e.g. `repoflow.example.com` in `repoflow.example.com/acme/network/aws`, and in the `credentials` block of the Terraform CLI configuration. Only set for the `terraform-module` and `terraform-provider` package types.",
 				Computed:            true,
-				ElementType:         types.StringType,
-				PlanModifiers: []planmodifier.List{
-					listplanmodifier.RequiresReplace(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-			"upload_local_repository_id": schema.StringAttribute{
-				MarkdownDescription: "ID of a local repository where uploads will be stored (must also be in child_repository_ids)..",
-				Optional:            true,
+			"service_discovery_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the service discovery document (`/.well-known/terraform.json`) of the registry. Only set for the `terraform-module` and `terraform-provider` package types.",
 				Computed:            true,
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
-
-			// Computed attributes
-			"repository_id": schema.StringAttribute{
+			"registry_api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the `modules.v1` or `providers.v1` API of the repository. Only set for the `terraform-module` and `terraform-provider` package types.",
 				Computed:            true,
-				MarkdownDescription: "Repository identifier",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -189,6 +215,187 @@ func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"remote": schema.SingleNestedBlock{
+				MarkdownDescription: "Remote repository settings (require for remote repository type).",
+				Attributes: map[string]schema.Attribute{
+					"url": schema.StringAttribute{
+						MarkdownDescription: "URL of the remote repository.",
+						Optional:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+					"username": schema.StringAttribute{
+						MarkdownDescription: "Username for the remote repository.",
+						Optional:            true,
+					},
+					"password": schema.StringAttribute{
+						MarkdownDescription: "Password for the remote repository.",
+						DeprecationMessage:  "Use `password_wo` instead to keep the password out of the state.",
+						Optional:            true,
+						Sensitive:           true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("password_wo")),
+						},
+					},
+					"password_wo": schema.StringAttribute{
+						MarkdownDescription: "Password for the remote repository, never stored in the state.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+					},
+					"password_wo_version": schema.Int64Attribute{
+						MarkdownDescription: "Version of the password. Change it to send a new `password_wo` to the remote repository.",
+						Optional:            true,
+					},
+					"header_name": schema.StringAttribute{
+						MarkdownDescription: "Name of the header authenticating to the remote repository (e.g. `X-JFrog-Art-Api`, `PRIVATE-TOKEN`), instead of a username and password.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("username")),
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("header_value_wo")),
+						},
+					},
+					"header_value_wo": schema.StringAttribute{
+						MarkdownDescription: "Value of the authentication header, never stored in the state.",
+						Optional:            true,
+						Sensitive:           true,
+						WriteOnly:           true,
+						Validators: []validator.String{
+							stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("header_name")),
+						},
+					},
+					"header_value_wo_version": schema.Int64Attribute{
+						MarkdownDescription: "Version of the header value. Change it to send a new `header_value_wo` to the remote repository.",
+						Optional:            true,
+					},
+					"credential_id": schema.StringAttribute{
+						MarkdownDescription: "Id of a `repoflow_credential` of the workspace authenticating to the remote repository, instead of its own credentials. Rotating the credential updates every remote repository using it.",
+						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.ConflictsWith(
+								path.MatchRelative().AtParent().AtName("username"),
+								path.MatchRelative().AtParent().AtName("password"),
+								path.MatchRelative().AtParent().AtName("password_wo"),
+								path.MatchRelative().AtParent().AtName("header_name"),
+							),
+						},
+					},
+					"cache_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether caching is enabled. Inherited from the workspace `default_remote_cache_enabled` when unset (default `false`).",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+							boolplanmodifier.RequiresReplace(),
+						},
+					},
+					"file_cache_time_till_revalidation": schema.Int64Attribute{
+						MarkdownDescription: "Milliseconds before cached files require revalidation (null for indefinite caching). Inherited from the workspace `default_file_cache_ttl` when unset.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+					"metadata_cache_time_till_revalidation": schema.Int64Attribute{
+						MarkdownDescription: "Milliseconds before cached metadata requires revalidation (null for indefinite caching). Inherited from the workspace `default_metadata_cache_ttl` when unset.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+							int64planmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"virtual": schema.SingleNestedBlock{
+				MarkdownDescription: "Virtual repository settings (require for virtual repository type).",
+				Attributes: map[string]schema.Attribute{
+					"child_repository_ids": schema.SetAttribute{
+						MarkdownDescription: "IDs of repositories included in the virtual repository. Updated in place.",
+						Optional:            true,
+						ElementType:         types.StringType,
+					},
+					"members": schema.SetNestedAttribute{
+						MarkdownDescription: "Resolution filters of the children, e.g. to only serve `com.acme.*` from an internal child. Children without filters serve every package.",
+						Optional:            true,
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"repository_id": schema.StringAttribute{
+									MarkdownDescription: "ID of the child repository, must be in child_repository_ids.",
+									Required:            true,
+								},
+								"include_patterns": schema.SetAttribute{
+									MarkdownDescription: "Patterns of the packages served by the child, every package when unset.",
+									Optional:            true,
+									ElementType:         types.StringType,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+										setvalidator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("exclude_patterns")),
+									},
+								},
+								"exclude_patterns": schema.SetAttribute{
+									MarkdownDescription: "Patterns of the packages never served by the child.",
+									Optional:            true,
+									ElementType:         types.StringType,
+									Validators: []validator.Set{
+										setvalidator.SizeAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+					"deployment_policy": schema.StringAttribute{
+						MarkdownDescription: "Handling of the uploads without `upload_local_repository_id`: `reject` them, route them to the `newest_local` child, or route them `by_scope` to the local child whose `include_patterns` match the package. Updated in place.",
+						Optional:            true,
+						Computed:            true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("reject", "newest_local", "by_scope"),
+						},
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"upload_local_repository_id": schema.StringAttribute{
+						MarkdownDescription: "ID of a local repository where uploads will be stored (must also be in child_repository_ids).",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+							stringplanmodifier.RequiresReplace(),
+						},
+					},
+				},
+			},
+			"conan": schema.SingleNestedBlock{
+				MarkdownDescription: "Conan settings (only for the conan package type). Updated in place, the settings are left untouched when unset.",
+				Attributes: map[string]schema.Attribute{
+					"revisions_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Whether the recipe and package revisions of Conan 2 are kept (default `true`).",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+				},
+			},
+			"oci": schema.SingleNestedBlock{
+				MarkdownDescription: "OCI artifact settings (only for the oci package type). Updated in place, the settings are left untouched when unset.",
+				Attributes: map[string]schema.Attribute{
+					"allowed_media_types": schema.SetAttribute{
+						MarkdownDescription: "Media types of the artifacts accepted by the repository, e.g. `application/vnd.cncf.helm.chart.content.v1.tar+gzip`. Every media type is accepted when unset.",
+						Optional:            true,
+						ElementType:         types.StringType,
+						Validators: []validator.Set{
+							setvalidator.SizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
@@ -198,12 +405,12 @@ func (r *RepositoryResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*repoflow.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -227,20 +434,36 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	packageType := data.PackageType.ValueString()
 	repositoryType := data.RepositoryType.ValueString()
 
-	if ws, err := r.client.GetWorkspace(workspace); err != nil {
+	settings, diags := r.buildPackageSettings(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	labels := map[string]string{}
+	resp.Diagnostics.Append(r.buildLabels(ctx, &data, &labels)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get worksapce %s, got error: %s", workspace, err))
-	} else {
-		workspaceId = ws.Id
+		return
 	}
+	workspaceId = ws.Id
 
-	var err error
-	var rp *repoflow.Repository
+	var rp *client.Repository
 
 	switch repositoryType {
 	case "local":
-		opts := repoflow.RepositoryOptions{
-			Name:        data.Name.ValueString(),
-			PackageType: data.PackageType.ValueString(),
+		opts := client.RepositoryOptions{
+			RepositoryOptions: repoflow.RepositoryOptions{
+				Name:        data.Name.ValueString(),
+				PackageType: data.PackageType.ValueString(),
+			},
+			StorageBackendId: data.StorageBackendId.ValueString(),
+			ManagedBy:        managedByMarker,
+			Labels:           labels,
+			PackageSettings:  settings,
 		}
 		tflog.Debug(ctx, "create repository with option", map[string]interface{}{
 			"opts": opts,
@@ -248,23 +471,57 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		rp, err = r.client.CreateLocalRepository(workspace, opts)
 
 	case "remote":
-		if data.RemoteRepositoryUrl.IsNull() {
+		if data.Remote == nil || data.Remote.Url.IsNull() {
 			resp.Diagnostics.AddError(
 				"Missing parameter",
-				"'remote_repository_url' is mandatory for remote repository type.",
+				"'remote.url' is mandatory for remote repository type.",
 			)
 			return
 		}
 
-		opts := repoflow.RepositoryRemoteOptions{
-			Name:                              data.Name.ValueString(),
-			PackageType:                       data.PackageType.ValueString(),
-			RemoteRepositoryUrl:               data.RemoteRepositoryUrl.ValueString(),
-			RemoteRepositoryUsername:          data.RemoteRepositoryUsername.ValueString(),
-			RemoteRepositoryPassword:          data.RemoteRepositoryPassword.ValueString(),
-			IsRemoteCacheEnabled:              data.RemoteCacheEnabled.ValueBool(),
-			FileCacheTimeTillRevalidation:     factory.Int64ToPtr(data.FileCacheTimeTillRevalidation),
-			MetadataCacheTimeTillRevalidation: factory.Int64ToPtr(data.MetadataCacheTimeTillRevalidation),
+		// Write-only values are only available in the configuration
+		var passwordWo, headerValueWo types.String
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote").AtName("password_wo"), &passwordWo)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote").AtName("header_value_wo"), &headerValueWo)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		password := data.Remote.Password.ValueString()
+		if !passwordWo.IsNull() {
+			password = passwordWo.ValueString()
+		}
+
+		// Unset cache settings are inherited from the workspace defaults
+		if data.Remote.CacheEnabled.IsUnknown() {
+			data.Remote.CacheEnabled = types.BoolValue(ws.DefaultRemoteCacheEnabled != nil && *ws.DefaultRemoteCacheEnabled)
+		}
+		if data.Remote.FileCacheTimeTillRevalidation.IsUnknown() {
+			data.Remote.FileCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.DefaultFileCacheTtl))
+		}
+		if data.Remote.MetadataCacheTimeTillRevalidation.IsUnknown() {
+			data.Remote.MetadataCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.DefaultMetadataCacheTtl))
+		}
+
+		opts := client.RepositoryRemoteOptions{
+			RepositoryRemoteOptions: repoflow.RepositoryRemoteOptions{
+				Name:                              data.Name.ValueString(),
+				PackageType:                       data.PackageType.ValueString(),
+				RemoteRepositoryUrl:               data.Remote.Url.ValueString(),
+				RemoteRepositoryUsername:          data.Remote.Username.ValueString(),
+				RemoteRepositoryPassword:          password,
+				IsRemoteCacheEnabled:              data.Remote.CacheEnabled.ValueBool(),
+				FileCacheTimeTillRevalidation:     factory.Int64ToPtr(data.Remote.FileCacheTimeTillRevalidation),
+				MetadataCacheTimeTillRevalidation: factory.Int64ToPtr(data.Remote.MetadataCacheTimeTillRevalidation),
+			},
+			RemoteRepositoryHeaderName:  data.Remote.HeaderName.ValueString(),
+			RemoteRepositoryHeaderValue: headerValueWo.ValueString(),
+			CredentialId:                data.Remote.CredentialId.ValueString(),
+			StorageBackendId:            data.StorageBackendId.ValueString(),
+			ManagedBy:                   managedByMarker,
+			Labels:                      labels,
+			PackageSettings:             settings,
 		}
 		tflog.Debug(ctx, "create repository with option", map[string]interface{}{
 			"opts": opts,
@@ -272,28 +529,44 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		rp, err = r.client.CreateRemoteRepository(workspace, opts)
 
 	case "virtual":
-		if data.ChildRepositoryIds.IsNull() {
+		if data.Virtual == nil || data.Virtual.ChildRepositoryIds.IsNull() {
 			resp.Diagnostics.AddError(
 				"Missing parameter",
-				"`child_repository_ids` is required for virtual repository type.",
+				"`virtual.child_repository_ids` is required for virtual repository type.",
+			)
+			return
+		}
+		if !data.StorageBackendId.IsNull() && !data.StorageBackendId.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("storage_backend_id"),
+				"Invalid parameter",
+				"`storage_backend_id` is not supported by the virtual repository type.",
 			)
 			return
 		}
 
 		var childIds []string
-		diags := data.ChildRepositoryIds.ElementsAs(ctx, &childIds, false)
+		diags := data.Virtual.ChildRepositoryIds.ElementsAs(ctx, &childIds, false)
 		resp.Diagnostics.Append(diags...)
 
 		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		uploadLocalRepositoryId := data.UploadLocalRepositoryId.ValueString()
-		opts := repoflow.RepositoryVirtualOptions{
-			Name:                    data.Name.ValueString(),
-			PackageType:             data.PackageType.ValueString(),
-			ChildRepositoryIds:      childIds,
-			UploadLocalRepositoryId: uploadLocalRepositoryId,
+		uploadLocalRepositoryId := data.Virtual.UploadLocalRepositoryId.ValueString()
+		opts := client.RepositoryVirtualOptions{
+			RepositoryVirtualOptions: repoflow.RepositoryVirtualOptions{
+				Name:                    data.Name.ValueString(),
+				PackageType:             data.PackageType.ValueString(),
+				ChildRepositoryIds:      childIds,
+				UploadLocalRepositoryId: uploadLocalRepositoryId,
+			},
+			ManagedBy:       managedByMarker,
+			Labels:          labels,
+			PackageSettings: settings,
+		}
+		if !data.Virtual.DeploymentPolicy.IsUnknown() {
+			opts.DeploymentPolicy = data.Virtual.DeploymentPolicy.ValueString()
 		}
 		tflog.Debug(ctx, "create repository with option", map[string]interface{}{
 			"opts": opts,
@@ -308,6 +581,10 @@ func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequ
 
 	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
 
+	if data.Virtual != nil && !data.Virtual.Members.IsNull() {
+		resp.Diagnostics.Append(r.updateVirtualMembers(ctx, &data, types.SetNull(virtualMemberType))...)
+	}
+
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "created a repoflow repository resource", map[string]interface{}{
@@ -337,6 +614,16 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	rp, err := r.client.GetRepository(workspaceId, repositoryId)
 
+	// The repository was deleted outside of Terraform, let it plan the recreation
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "repository not found, removing it from state", map[string]interface{}{
+			"workspace": workspaceId,
+			"id":        repositoryId,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
 			"Unable to get repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
@@ -345,6 +632,8 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, data.WorkspaceId.ValueString())...)
+	resp.Diagnostics.Append(r.readVirtualMembers(ctx, &data)...)
+	resp.Diagnostics.Append(checkManagedBy("repository", rp.Name, rp.ManagedBy)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -356,19 +645,243 @@ func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	})
 
 	// Save updated data into Terraform state
-	// resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data RepositoryResourceModel
+	var state RepositoryResourceModel
 
-	// Read Terraform plan data into the model
+	// Read Terraform plan and prior state data into the models
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Each update maps the response over the model, build the planned labels and package settings first
+	settings, diags := r.buildPackageSettings(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An empty map removes the labels
+	labels := map[string]string{}
+	resp.Diagnostics.Append(r.buildLabels(ctx, &data, &labels)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	labelsChanged := !data.Labels.Equal(state.Labels)
+	settingsChanged := (data.Conan != nil && (state.Conan == nil || !data.Conan.RevisionsEnabled.Equal(state.Conan.RevisionsEnabled))) ||
+		(data.Oci != nil && (state.Oci == nil || !data.Oci.AllowedMediaTypes.Equal(state.Oci.AllowedMediaTypes)))
+
+	// Claim the repository, like an imported one missing the marker
+	if !state.ManagedBy.Equal(data.ManagedBy) {
+		managedBy := managedByMarker
+		workspaceId := data.WorkspaceId.ValueString()
+		repositoryId := data.RepositoryId.ValueString()
+
+		rp, err := r.client.UpdateRepository(workspaceId, repositoryId, client.RepositoryUpdateOptions{
+			ManagedBy: &managedBy,
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to mark repository %s on workspaceId %s as managed by Terraform, got error: %s", repositoryId, workspaceId, err,
+			))
+			return
+		}
+
+		resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if labelsChanged {
+		workspaceId := data.WorkspaceId.ValueString()
+		repositoryId := data.RepositoryId.ValueString()
+
+		rp, err := r.client.UpdateRepository(workspaceId, repositoryId, client.RepositoryUpdateOptions{
+			Labels: &labels,
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to update labels of repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
+			))
+			return
+		}
+
+		resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if settingsChanged {
+		workspaceId := data.WorkspaceId.ValueString()
+		repositoryId := data.RepositoryId.ValueString()
+
+		rp, err := r.client.UpdateRepository(workspaceId, repositoryId, client.RepositoryUpdateOptions{
+			PackageSettings: settings,
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to update %s settings of repository %s on workspaceId %s, got error: %s", data.PackageType.ValueString(), repositoryId, workspaceId, err,
+			))
+			return
+		}
+
+		resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Only the labels, the remote credentials, the virtual children and policy, and
+	// the package type settings can change in place, everything else requires a replacement
+	if data.Remote != nil {
+		var passwordWo, headerValueWo types.String
+
+		// Write-only values are only available in the configuration
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote").AtName("password_wo"), &passwordWo)...)
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote").AtName("header_value_wo"), &headerValueWo)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// An empty Id detaches the credential
+		credentialId := data.Remote.CredentialId.ValueString()
+
+		opts := client.RepositoryRemoteUpdateOptions{
+			RemoteRepositoryUsername:   data.Remote.Username.ValueStringPointer(),
+			RemoteRepositoryPassword:   data.Remote.Password.ValueStringPointer(),
+			RemoteRepositoryHeaderName: data.Remote.HeaderName.ValueStringPointer(),
+			CredentialId:               &credentialId,
+		}
+
+		// Only send the write-only values when their version changed
+		var priorRemote RepositoryRemoteModel
+		if state.Remote != nil {
+			priorRemote = *state.Remote
+		}
+		if !passwordWo.IsNull() && !data.Remote.PasswordWoVersion.Equal(priorRemote.PasswordWoVersion) {
+			opts.RemoteRepositoryPassword = passwordWo.ValueStringPointer()
+		}
+		if !data.Remote.HeaderValueWoVersion.Equal(priorRemote.HeaderValueWoVersion) {
+			opts.RemoteRepositoryHeaderValue = headerValueWo.ValueStringPointer()
+		}
+
+		workspaceId := data.WorkspaceId.ValueString()
+		repositoryId := data.RepositoryId.ValueString()
+
+		rp, err := r.client.UpdateRemoteRepository(workspaceId, repositoryId, opts)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to update repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
+			))
+			return
+		}
+
+		resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "updated a repoflow repository resource", map[string]interface{}{
+			"id": rp.Id,
+		})
+	}
+
+	// Each update maps the response over the model, compare with the planned virtual settings
+	var planned RepositoryVirtualModel
+	if data.Virtual != nil {
+		planned = *data.Virtual
+	}
+
+	if data.Virtual != nil && state.Virtual != nil && !planned.DeploymentPolicy.Equal(state.Virtual.DeploymentPolicy) {
+		opts := client.RepositoryVirtualUpdateOptions{
+			DeploymentPolicy: planned.DeploymentPolicy.ValueStringPointer(),
+		}
+
+		workspaceId := data.WorkspaceId.ValueString()
+		repositoryId := data.RepositoryId.ValueString()
+
+		rp, err := r.client.UpdateVirtualRepository(workspaceId, repositoryId, opts)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to update deployment policy of repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
+			))
+			return
+		}
+
+		resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if data.Virtual != nil && state.Virtual != nil && !planned.ChildRepositoryIds.Equal(state.Virtual.ChildRepositoryIds) {
+		var planIds, stateIds []string
+		resp.Diagnostics.Append(planned.ChildRepositoryIds.ElementsAs(ctx, &planIds, false)...)
+		resp.Diagnostics.Append(state.Virtual.ChildRepositoryIds.ElementsAs(ctx, &stateIds, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Large virtual repositories are updated with a single request holding the whole delta
+		opts := client.RepositoryVirtualChildrenOptions{
+			AddChildRepositoryIds:    factory.Difference(planIds, stateIds),
+			RemoveChildRepositoryIds: factory.Difference(stateIds, planIds),
+		}
+		tflog.Debug(ctx, "update virtual repository children with option", map[string]interface{}{
+			"opts": opts,
+		})
+
+		workspaceId := data.WorkspaceId.ValueString()
+		repositoryId := data.RepositoryId.ValueString()
+
+		rp, err := r.client.UpdateVirtualRepositoryChildren(workspaceId, repositoryId, opts)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to update children of repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
+			))
+			return
+		}
+
+		resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		tflog.Trace(ctx, "updated children of a repoflow virtual repository resource", map[string]interface{}{
+			"id":      rp.Id,
+			"added":   len(opts.AddChildRepositoryIds),
+			"removed": len(opts.RemoveChildRepositoryIds),
+		})
+	}
+
+	// Filters are updated once the children exist
+	if data.Virtual != nil && state.Virtual != nil && !planned.Members.Equal(state.Virtual.Members) {
+		resp.Diagnostics.Append(r.updateVirtualMembers(ctx, &data, state.Virtual.Members)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -414,15 +927,15 @@ func (r *RepositoryResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	var workspaceId string
 	workspace := idParts[0]
 	repository := idParts[1]
 
-	if ws, err := r.client.GetWorkspace(workspace); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get worksapce %s, got error: %s", workspaceId, err))
-	} else {
-		workspaceId = ws.Id
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get worksapce %s, got error: %s", workspace, err))
+		return
 	}
+	workspaceId := ws.Id
 
 	rp, err := r.client.GetRepository(workspaceId, repository)
 
@@ -434,6 +947,7 @@ func (r *RepositoryResource) ImportState(ctx context.Context, req resource.Impor
 	}
 
 	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rp, workspaceId)...)
+	resp.Diagnostics.Append(r.readVirtualMembers(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -447,7 +961,80 @@ func (r *RepositoryResource) ImportState(ctx context.Context, req resource.Impor
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *RepositoryResource) mapResponseToModel(ctx context.Context, data *RepositoryResourceModel, rp *repoflow.Repository, workspaceId string) diag.Diagnostics {
+func (r *RepositoryResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	// Lists and sets share the same JSON encoding, so version 0 (child_repository_ids
+	// as a list) and version 1 (as a set) states are upgraded the same way.
+	return map[int64]resource.StateUpgrader{
+		0: {StateUpgrader: upgradeRepositoryStateToV2},
+		1: {StateUpgrader: upgradeRepositoryStateToV2},
+	}
+}
+
+// upgradeRepositoryStateToV2 moves the flat remote and virtual attributes into their nested blocks.
+func upgradeRepositoryStateToV2(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+	var prior map[string]interface{}
+
+	if err := json.Unmarshal(req.RawState.JSON, &prior); err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to read prior repository state, got error: %s", err))
+		return
+	}
+
+	state := map[string]interface{}{
+		"id":                    prior["id"],
+		"name":                  prior["name"],
+		"workspace":             prior["workspace"],
+		"package_type":          prior["package_type"],
+		"repository_type":       prior["repository_type"],
+		"repository_id":         prior["repository_id"],
+		"managed_by":            nil,
+		"registry_hostname":     nil,
+		"service_discovery_url": nil,
+		"registry_api_url":      nil,
+		"storage_backend_id":    nil,
+		"labels":                nil,
+		"remote":                nil,
+		"virtual":               nil,
+		"conan":                 nil,
+		"oci":                   nil,
+	}
+
+	switch prior["repository_type"] {
+	case "remote":
+		state["remote"] = map[string]interface{}{
+			"url":                                   prior["remote_repository_url"],
+			"username":                              prior["remote_repository_username"],
+			"password":                              prior["remote_repository_password"],
+			"password_wo":                           nil,
+			"password_wo_version":                   nil,
+			"header_name":                           nil,
+			"header_value_wo":                       nil,
+			"header_value_wo_version":               nil,
+			"credential_id":                         nil,
+			"cache_enabled":                         prior["remote_cache_enabled"],
+			"file_cache_time_till_revalidation":     prior["file_cache_time_till_revalidation"],
+			"metadata_cache_time_till_revalidation": prior["metadata_cache_time_till_revalidation"],
+		}
+	case "virtual":
+		state["virtual"] = map[string]interface{}{
+			"child_repository_ids":       prior["child_repository_ids"],
+			"upload_local_repository_id": prior["upload_local_repository_id"],
+			"members":                    nil,
+			"deployment_policy":          nil,
+		}
+	}
+
+	upgraded, err := json.Marshal(state)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Upgrade State", fmt.Sprintf("Unable to write upgraded repository state, got error: %s", err))
+		return
+	}
+
+	resp.DynamicValue = &tfprotov6.DynamicValue{
+		JSON: upgraded,
+	}
+}
+
+func (r *RepositoryResource) mapResponseToModel(ctx context.Context, data *RepositoryResourceModel, rp *client.Repository, workspaceId string) diag.Diagnostics {
 	var diags diag.Diagnostics
 
 	// We save the state id with workspaceId/repositoryId
@@ -459,6 +1046,21 @@ func (r *RepositoryResource) mapResponseToModel(ctx context.Context, data *Repos
 
 	// Default attributes
 	data.Name = types.StringValue(rp.Name)
+	// Instances not storing the marker do not echo it, keep the planned one
+	if rp.ManagedBy != nil {
+		data.ManagedBy = types.StringPointerValue(rp.ManagedBy)
+	}
+	data.StorageBackendId = types.StringPointerValue(rp.StorageBackendId)
+
+	// Keep the map null when empty to avoid a diff with the configuration
+	if len(rp.Labels) == 0 {
+		data.Labels = types.MapNull(types.StringType)
+	} else {
+		mapValue, mapDiags := types.MapValueFrom(ctx, types.StringType, rp.Labels)
+		diags.Append(mapDiags...)
+		data.Labels = mapValue
+	}
+
 	if rp.RepositoryType != "" {
 		data.PackageType = types.StringValue(rp.PackageType)
 	}
@@ -466,32 +1068,153 @@ func (r *RepositoryResource) mapResponseToModel(ctx context.Context, data *Repos
 		data.RepositoryType = types.StringValue(rp.RepositoryType)
 	}
 
+	// Terraform registry attributes
+	data.RegistryHostname = types.StringNull()
+	data.ServiceDiscoveryUrl = types.StringNull()
+	data.RegistryApiUrl = types.StringNull()
+	if rp.TerraformRegistry != nil {
+		data.RegistryHostname = types.StringValue(rp.TerraformRegistry.Hostname)
+		data.ServiceDiscoveryUrl = types.StringValue(rp.TerraformRegistry.ServiceDiscoveryUrl)
+		data.RegistryApiUrl = types.StringValue(rp.TerraformRegistry.ApiUrl)
+	}
+
 	// Remote attributes
-	data.RemoteRepositoryUrl = types.StringPointerValue(rp.RemoteRepositoryUrl)
-	data.RemoteRepositoryUsername = types.StringPointerValue(rp.RemoteRepositoryUsername)
-	data.RemoteRepositoryPassword = types.StringPointerValue(rp.RemoteRepositoryPassword)
-	data.RemoteCacheEnabled = types.BoolValue(rp.IsRemoteCacheEnabled)
+	if data.RepositoryType.ValueString() == "remote" {
+		remote := &RepositoryRemoteModel{
+			Url:                               types.StringPointerValue(rp.RemoteRepositoryUrl),
+			Username:                          types.StringPointerValue(rp.RemoteRepositoryUsername),
+			Password:                          types.StringNull(),
+			PasswordWo:                        types.StringNull(),
+			PasswordWoVersion:                 types.Int64Null(),
+			HeaderName:                        types.StringNull(),
+			HeaderValueWo:                     types.StringNull(),
+			HeaderValueWoVersion:              types.Int64Null(),
+			CredentialId:                      types.StringPointerValue(rp.CredentialId),
+			CacheEnabled:                      types.BoolValue(rp.IsRemoteCacheEnabled),
+			FileCacheTimeTillRevalidation:     types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.FileCacheTimeTillRevalidation)),
+			MetadataCacheTimeTillRevalidation: types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.MetadataCacheTimeTillRevalidation)),
+		}
 
-	// Cache attributes utilisant ton package factory
-	data.FileCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.FileCacheTimeTillRevalidation))
-	data.MetadataCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.MetadataCacheTimeTillRevalidation))
+		// Credentials are never read back from the API, keep the configured ones
+		if data.Remote != nil {
+			remote.Password = data.Remote.Password
+			remote.PasswordWoVersion = data.Remote.PasswordWoVersion
+			remote.HeaderName = data.Remote.HeaderName
+			remote.HeaderValueWoVersion = data.Remote.HeaderValueWoVersion
+		}
+
+		data.Remote = remote
+	} else {
+		data.Remote = nil
+	}
 
 	// Virtual attributes
-	data.UploadLocalRepositoryId = types.StringPointerValue(rp.UploadLocalRepositoryId)
+	if data.RepositoryType.ValueString() == "virtual" {
+		// The resolution filters are read separately, keep the known ones
+		members := types.SetNull(virtualMemberType)
+		if data.Virtual != nil && !data.Virtual.Members.IsNull() {
+			members = data.Virtual.Members
+		}
 
-	// Handling ChildRepositories (conversion objets -> ids)
-	if rp.ChildRepositories == nil {
-		data.ChildRepositoryIds = types.ListNull(types.StringType)
-	} else {
+		data.Virtual = &RepositoryVirtualModel{
+			UploadLocalRepositoryId: types.StringPointerValue(rp.UploadLocalRepositoryId),
+			Members:                 members,
+			DeploymentPolicy:        types.StringPointerValue(rp.DeploymentPolicy),
+		}
+
+		// Handling ChildRepositories (conversion objets -> ids)
 		ids := make([]string, len(rp.ChildRepositories))
 		for i, child := range rp.ChildRepositories {
 			ids[i] = child.Id
 		}
 
-		listValue, listDiags := types.ListValueFrom(ctx, types.StringType, ids)
-		diags.Append(listDiags...)
-		data.ChildRepositoryIds = listValue
+		setValue, setDiags := types.SetValueFrom(ctx, types.StringType, ids)
+		diags.Append(setDiags...)
+		data.Virtual.ChildRepositoryIds = setValue
+	} else {
+		data.Virtual = nil
+	}
+
+	// Package type settings are only managed when their block is set
+	if data.Conan != nil && rp.ConanSettings != nil {
+		data.Conan = &RepositoryConanModel{
+			RevisionsEnabled: types.BoolValue(rp.ConanSettings.RevisionsEnabled),
+		}
+	}
+	if data.Oci != nil && rp.OciSettings != nil {
+		mediaTypes := types.SetNull(types.StringType)
+		if len(rp.OciSettings.AllowedMediaTypes) > 0 {
+			setValue, setDiags := types.SetValueFrom(ctx, types.StringType, rp.OciSettings.AllowedMediaTypes)
+			diags.Append(setDiags...)
+			mediaTypes = setValue
+		}
+
+		data.Oci = &RepositoryOciModel{
+			AllowedMediaTypes: mediaTypes,
+		}
 	}
 
 	return diags
 }
+
+func (r *RepositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy, nor before the provider is configured
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var labels types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+
+	if resp.Diagnostics.HasError() || labels.IsNull() || labels.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLabels(ctx, r.client, path.Root("labels"), labels)...)
+}
+
+func (r *RepositoryResource) buildLabels(ctx context.Context, data *RepositoryResourceModel, labels *map[string]string) diag.Diagnostics {
+	if data.Labels.IsNull() {
+		return nil
+	}
+
+	return data.Labels.ElementsAs(ctx, labels, false)
+}
+
+// buildPackageSettings returns the settings of the package type blocks, each
+// block is only accepted by its package type.
+func (r *RepositoryResource) buildPackageSettings(ctx context.Context, data *RepositoryResourceModel) (client.PackageSettings, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var settings client.PackageSettings
+
+	packageType := data.PackageType.ValueString()
+
+	if data.Conan != nil {
+		if packageType != "conan" {
+			diags.AddAttributeError(path.Root("conan"), "Invalid parameter", fmt.Sprintf("`conan` settings are not supported by the %s package type.", packageType))
+		}
+
+		settings.ConanSettings = &client.ConanSettings{
+			RevisionsEnabled: data.Conan.RevisionsEnabled.ValueBool(),
+		}
+	}
+
+	if data.Oci != nil {
+		if packageType != "oci" {
+			diags.AddAttributeError(path.Root("oci"), "Invalid parameter", fmt.Sprintf("`oci` settings are not supported by the %s package type.", packageType))
+		}
+
+		// An empty list accepts every media type
+		mediaTypes := []string{}
+		if !data.Oci.AllowedMediaTypes.IsNull() {
+			diags.Append(data.Oci.AllowedMediaTypes.ElementsAs(ctx, &mediaTypes, false)...)
+		}
+
+		settings.OciSettings = &client.OciSettings{
+			AllowedMediaTypes: mediaTypes,
+		}
+	}
+
+	return settings, diags
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/client/fake"
+)
+
+// newTestWorkspace creates a workspace directly in the fake, returning its Id.
+func newTestWorkspace(t *testing.T, p *testProvider, name string) string {
+	t.Helper()
+
+	ws, err := p.client.CreateWorkspace(client.WorkspaceOptions{WorkspaceOptions: repoflow.WorkspaceOptions{Name: name}})
+	if err != nil {
+		t.Fatalf("CreateWorkspace: %s", err)
+	}
+	return ws.Id
+}
+
+func TestRepositoryResourceLocal(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	state := p.apply("repoflow_repository", p.nullState("repoflow_repository"), p.config("repoflow_repository", map[string]tftypes.Value{
+		"workspace":       str(workspaceId),
+		"name":            str("npm-local"),
+		"repository_type": str("local"),
+		"package_type":    str("npm"),
+	}))
+
+	rp, err := p.client.GetRepository(workspaceId, "npm-local")
+	if err != nil {
+		t.Fatalf("repository not created: %s", err)
+	}
+	if got, want := stringAttr(t, state, "id"), workspaceId+"/"+rp.Id; got != want {
+		t.Errorf("id = %q, want %q", got, want)
+	}
+	if got := stringAttr(t, state, "repository_id"); got != rp.Id {
+		t.Errorf("repository_id = %q, want %q", got, rp.Id)
+	}
+
+	// Refresh without change
+	if refreshed := p.read("repoflow_repository", state); !refreshed.Equal(state) {
+		t.Errorf("refresh changed the state:\n%v\nwant\n%v", refreshed, state)
+	}
+
+	imported := p.importState("repoflow_repository", "platform/npm-local")
+	if got := stringAttr(t, imported, "repository_id"); got != rp.Id {
+		t.Errorf("imported repository_id = %q, want %q", got, rp.Id)
+	}
+	if got := stringAttr(t, imported, "package_type"); got != "npm" {
+		t.Errorf("imported package_type = %q, want npm", got)
+	}
+
+	p.destroy("repoflow_repository", state)
+	if _, err := p.client.GetRepository(workspaceId, rp.Id); !client.IsNotFound(err) {
+		t.Errorf("repository not deleted: %v", err)
+	}
+}
+
+func TestRepositoryResourceVirtual(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	var childIds []string
+	for _, name := range []string{"npm-local", "npm-internal"} {
+		rp, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+			RepositoryOptions: repoflow.RepositoryOptions{Name: name, PackageType: "npm"},
+		})
+		if err != nil {
+			t.Fatalf("CreateLocalRepository: %s", err)
+		}
+		childIds = append(childIds, rp.Id)
+	}
+
+	config := func(children ...string) tftypes.Value {
+		return p.config("repoflow_repository", map[string]tftypes.Value{
+			"workspace":       str(workspaceId),
+			"name":            str("npm"),
+			"repository_type": str("virtual"),
+			"package_type":    str("npm"),
+			"virtual": p.block("repoflow_repository", "virtual", map[string]tftypes.Value{
+				"child_repository_ids": stringSet(children...),
+			}),
+		})
+	}
+
+	state := p.apply("repoflow_repository", p.nullState("repoflow_repository"), config(childIds[0]))
+	id := stringAttr(t, state, "repository_id")
+
+	// The children are updated in place
+	state = p.applyInPlace("repoflow_repository", state, config(childIds...))
+	if got := stringAttr(t, state, "repository_id"); got != id {
+		t.Errorf("repository replaced, repository_id = %q, want %q", got, id)
+	}
+
+	rp, err := p.client.GetRepository(workspaceId, id)
+	if err != nil {
+		t.Fatalf("GetRepository: %s", err)
+	}
+	if len(rp.ChildRepositories) != 2 {
+		t.Errorf("children = %v, want %v", rp.ChildRepositories, childIds)
+	}
+}
+
+// remoteUpdates records the updates of the remote repositories, whose secrets the
+// fake does not keep.
+type remoteUpdates struct {
+	*fake.Client
+
+	sent []client.RepositoryRemoteUpdateOptions
+}
+
+func (c *remoteUpdates) UpdateRemoteRepository(workspace string, id string, opts client.RepositoryRemoteUpdateOptions) (*client.Repository, error) {
+	c.sent = append(c.sent, opts)
+	return c.Client.UpdateRemoteRepository(workspace, id, opts)
+}
+
+func TestRepositoryResourceRemoteWriteOnly(t *testing.T) {
+	updates := &remoteUpdates{Client: fake.New()}
+	p := newTestProviderWithClient(t, updates.Client, updates)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	config := func(username string, password string, version int64) tftypes.Value {
+		return p.config("repoflow_repository", map[string]tftypes.Value{
+			"workspace":       str(workspaceId),
+			"name":            str("npm-remote"),
+			"repository_type": str("remote"),
+			"package_type":    str("npm"),
+			"remote": p.block("repoflow_repository", "remote", map[string]tftypes.Value{
+				"url":                 str("https://registry.npmjs.org"),
+				"username":            str(username),
+				"password_wo":         str(password),
+				"password_wo_version": tftypes.NewValue(tftypes.Number, version),
+			}),
+		})
+	}
+
+	state := p.apply("repoflow_repository", p.nullState("repoflow_repository"), config("ci", "first", 1))
+
+	// Other changes leave the password untouched
+	updates.sent = nil
+	state = p.applyInPlace("repoflow_repository", state, config("deploy", "first", 1))
+	if len(updates.sent) != 1 {
+		t.Fatalf("got %d updates, want 1", len(updates.sent))
+	}
+	if password := updates.sent[0].RemoteRepositoryPassword; password != nil {
+		t.Errorf("password sent without a version change: %q", *password)
+	}
+
+	// A new version sends the password
+	updates.sent = nil
+	p.applyInPlace("repoflow_repository", state, config("deploy", "second", 2))
+	if len(updates.sent) != 1 {
+		t.Fatalf("got %d updates, want 1", len(updates.sent))
+	}
+	if password := updates.sent[0].RemoteRepositoryPassword; password == nil || *password != "second" {
+		t.Errorf("password = %v, want second", password)
+	}
+}
+
+func TestRepositoryResourceDeletedOutside(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	state := p.apply("repoflow_repository", p.nullState("repoflow_repository"), p.config("repoflow_repository", map[string]tftypes.Value{
+		"workspace":       str(workspaceId),
+		"name":            str("npm-local"),
+		"repository_type": str("local"),
+		"package_type":    str("npm"),
+	}))
+
+	if _, err := p.client.DeleteRepository(workspaceId, stringAttr(t, state, "repository_id")); err != nil {
+		t.Fatalf("DeleteRepository: %s", err)
+	}
+
+	if state := p.read("repoflow_repository", state); !state.IsNull() {
+		t.Errorf("repository deleted outside of Terraform still in state: %v", state)
+	}
+}
+
+func TestRepositoryResourceLabels(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	if _, err := p.client.PutLabelKey("tier", client.LabelKeyOptions{AllowedValues: []string{"gold", "silver"}}); err != nil {
+		t.Fatalf("PutLabelKey: %s", err)
+	}
+
+	config := func(labels map[string]string) tftypes.Value {
+		return p.config("repoflow_repository", map[string]tftypes.Value{
+			"workspace":       str(workspaceId),
+			"name":            str("npm-local"),
+			"repository_type": str("local"),
+			"package_type":    str("npm"),
+			"labels":          stringMap(labels),
+		})
+	}
+
+	state := p.apply("repoflow_repository", p.nullState("repoflow_repository"), config(map[string]string{"tier": "gold"}))
+	id := stringAttr(t, state, "repository_id")
+
+	// The labels are updated in place
+	state = p.applyInPlace("repoflow_repository", state, config(map[string]string{"tier": "silver"}))
+	if got := stringAttr(t, state, "repository_id"); got != id {
+		t.Errorf("repository replaced, repository_id = %q, want %q", got, id)
+	}
+	if rp, _ := p.client.GetRepository(workspaceId, id); rp.Labels["tier"] != "silver" {
+		t.Errorf("labels = %v, want tier=silver", rp.Labels)
+	}
+
+	// Values outside of the taxonomy are rejected at plan time
+	if _, diags := p.tryApply("repoflow_repository", state, config(map[string]string{"tier": "bronze"})); !hasError(diags) {
+		t.Error("label value outside of the taxonomy accepted")
+	}
+
+	// Removing the attribute removes the labels
+	state = p.applyInPlace("repoflow_repository", state, p.config("repoflow_repository", map[string]tftypes.Value{
+		"workspace":       str(workspaceId),
+		"name":            str("npm-local"),
+		"repository_type": str("local"),
+		"package_type":    str("npm"),
+	}))
+	if rp, _ := p.client.GetRepository(workspaceId, id); len(rp.Labels) != 0 {
+		t.Errorf("labels = %v, want none", rp.Labels)
+	}
+}
+
+// noRepositoryMarker answers like the instances not storing the managed_by marker.
+type noRepositoryMarker struct {
+	*fake.Client
+}
+
+func (c *noRepositoryMarker) strip(rp *client.Repository, err error) (*client.Repository, error) {
+	if rp != nil {
+		rp.ManagedBy = nil
+	}
+	return rp, err
+}
+
+func (c *noRepositoryMarker) CreateLocalRepository(workspace string, opts client.RepositoryOptions) (*client.Repository, error) {
+	return c.strip(c.Client.CreateLocalRepository(workspace, opts))
+}
+
+func (c *noRepositoryMarker) GetRepository(workspace string, id string) (*client.Repository, error) {
+	return c.strip(c.Client.GetRepository(workspace, id))
+}
+
+func TestRepositoryResourceMarkerOmitted(t *testing.T) {
+	api := &noRepositoryMarker{Client: fake.New()}
+	p := newTestProviderWithClient(t, api.Client, api)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	// The planned marker is kept in the state
+	state := p.apply("repoflow_repository", p.nullState("repoflow_repository"), p.config("repoflow_repository", map[string]tftypes.Value{
+		"workspace":       str(workspaceId),
+		"name":            str("npm-local"),
+		"repository_type": str("local"),
+		"package_type":    str("npm"),
+	}))
+	if got := stringAttr(t, state, "managed_by"); got != managedByMarker {
+		t.Errorf("managed_by = %q, want %q", got, managedByMarker)
+	}
+
+	if refreshed := p.read("repoflow_repository", state); !refreshed.Equal(state) {
+		t.Errorf("refresh changed the state:\n%v\nwant\n%v", refreshed, state)
+	}
+}
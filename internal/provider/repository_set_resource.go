@@ -0,0 +1,496 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositorySetResource{}
+var _ resource.ResourceWithImportState = &RepositorySetResource{}
+var _ resource.ResourceWithModifyPlan = &RepositorySetResource{}
+
+func NewRepositorySetResource() resource.Resource {
+	return &RepositorySetResource{}
+}
+
+// RepositorySetResource defines the resource implementation.
+type RepositorySetResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+// RepositorySetResourceModel describes the resource data model.
+type RepositorySetResourceModel struct {
+	Id          types.String                         `tfsdk:"id"`
+	WorkspaceId types.String                         `tfsdk:"workspace"`
+	Name        types.String                         `tfsdk:"name"`
+	Packages    map[string]RepositorySetPackageModel `tfsdk:"packages"`
+}
+
+// RepositorySetPackageModel describes the repositories of a package type of the set.
+type RepositorySetPackageModel struct {
+	RemoteUrl           types.String `tfsdk:"remote_url"`
+	CredentialId        types.String `tfsdk:"credential_id"`
+	LocalRepositoryId   types.String `tfsdk:"local_repository_id"`
+	RemoteRepositoryId  types.String `tfsdk:"remote_repository_id"`
+	VirtualRepositoryId types.String `tfsdk:"virtual_repository_id"`
+	Url                 types.String `tfsdk:"url"`
+}
+
+func (m RepositorySetPackageModel) stack() repositoryStack {
+	return repositoryStack{
+		LocalId:   m.LocalRepositoryId.ValueString(),
+		RemoteId:  m.RemoteRepositoryId.ValueString(),
+		VirtualId: m.VirtualRepositoryId.ValueString(),
+	}
+}
+
+func (r *RepositorySetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_set"
+}
+
+func (r *RepositorySetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository set resource. Provision, for each package type of the set, a local repository `<name>-<type>-local`, a remote repository `<name>-<type>-remote` proxying the upstream, and a virtual repository `<name>-<type>` serving both and uploading to the local one. Removing a package type deletes its repositories and the packages of the local one.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repositories (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the set, prefix of the names of its repositories, e.g. the team owning them.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"packages": schema.MapNestedAttribute{
+				MarkdownDescription: "Repositories of the set, keyed by package type (e.g. `npm`, `pypi`).",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.OneOf(packageTypes...)),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"remote_url": schema.StringAttribute{
+							MarkdownDescription: "URL of the upstream registry proxied by the remote repository. Changing it replaces the remote repository, losing its cache.",
+							Required:            true,
+						},
+						"credential_id": schema.StringAttribute{
+							MarkdownDescription: "Id of a `repoflow_credential` of the workspace used to authenticate to the upstream.",
+							Optional:            true,
+						},
+						"local_repository_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the local repository.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"remote_repository_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the remote repository.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"virtual_repository_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the virtual repository.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "URL of the virtual repository, to configure in the package managers.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Repository set identifier, in the form `workspaceId/name`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RepositorySetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositorySetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositorySetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	data.Id = types.StringValue(ws.Id + "/" + data.Name.ValueString())
+
+	planned := data.Packages
+	data.Packages = map[string]RepositorySetPackageModel{}
+
+	for _, packageType := range slices.Sorted(maps.Keys(planned)) {
+		pkg := planned[packageType]
+
+		stack, err := createRepositoryStack(r.client, ws, r.stackName(&data, packageType), packageType, pkg.RemoteUrl.ValueString(), pkg.CredentialId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create the %s repositories of the set, got error: %s", packageType, err))
+			// Keep the repositories already created in the state
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+
+		data.Packages[packageType] = r.packageModel(&data, ws, packageType, pkg, *stack)
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow repository set resource", map[string]interface{}{
+		"id":            data.Id.ValueString(),
+		"package_types": slices.Sorted(maps.Keys(data.Packages)),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositorySetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositorySetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, _, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	ws, err := r.client.GetWorkspace(workspaceId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspaceId, err))
+		return
+	}
+
+	for packageType, pkg := range data.Packages {
+		remote, err := readRepositoryStack(r.client, ws.Id, pkg.stack())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the %s repositories of the set, got error: %s", packageType, err))
+			return
+		}
+
+		// A package type with a missing repository is planned again, the remaining
+		// repositories must then be deleted or imported by hand
+		if remote == nil {
+			tflog.Warn(ctx, "repository set package type is incomplete, removing it from the state", map[string]interface{}{
+				"id":           data.Id.ValueString(),
+				"package_type": packageType,
+			})
+			delete(data.Packages, packageType)
+			continue
+		}
+
+		pkg.RemoteUrl = types.StringPointerValue(remote.RemoteRepositoryUrl)
+		pkg.CredentialId = types.StringPointerValue(remote.CredentialId)
+		data.Packages[packageType] = r.packageModel(&data, ws, packageType, pkg, pkg.stack())
+	}
+
+	if len(data.Packages) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositorySetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state RepositorySetResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, _, _ := strings.Cut(state.Id.ValueString(), "/")
+
+	ws, err := r.client.GetWorkspace(workspaceId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspaceId, err))
+		return
+	}
+
+	planned := data.Packages
+	data.Packages = state.Packages
+
+	// Save the progress when a step fails, so the state matches the repositories
+	fail := func(action string, packageType string, err error) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to %s the %s repositories of the set, got error: %s", action, packageType, err))
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	}
+
+	for _, packageType := range slices.Sorted(maps.Keys(state.Packages)) {
+		if _, ok := planned[packageType]; ok {
+			continue
+		}
+
+		if err := deleteRepositoryStack(r.client, ws.Id, state.Packages[packageType].stack()); err != nil {
+			fail("delete", packageType, err)
+			return
+		}
+		delete(data.Packages, packageType)
+	}
+
+	for _, packageType := range slices.Sorted(maps.Keys(planned)) {
+		pkg := planned[packageType]
+		prior, ok := state.Packages[packageType]
+
+		if !ok {
+			stack, err := createRepositoryStack(r.client, ws, r.stackName(&data, packageType), packageType, pkg.RemoteUrl.ValueString(), pkg.CredentialId.ValueString())
+			if err != nil {
+				fail("create", packageType, err)
+				return
+			}
+			data.Packages[packageType] = r.packageModel(&data, ws, packageType, pkg, *stack)
+			continue
+		}
+
+		stack := prior.stack()
+
+		if !pkg.RemoteUrl.Equal(prior.RemoteUrl) {
+			err := replaceStackRemote(r.client, ws, r.stackName(&data, packageType), packageType, &stack, pkg.RemoteUrl.ValueString(), pkg.CredentialId.ValueString())
+			if err != nil {
+				// The previous remote may already be gone
+				prior.RemoteRepositoryId = types.StringValue(stack.RemoteId)
+				data.Packages[packageType] = prior
+				fail("update", packageType, err)
+				return
+			}
+		} else if !pkg.CredentialId.Equal(prior.CredentialId) {
+			// An empty Id detaches the credential
+			credentialId := pkg.CredentialId.ValueString()
+			_, err := r.client.UpdateRemoteRepository(ws.Id, stack.RemoteId, client.RepositoryRemoteUpdateOptions{
+				CredentialId: &credentialId,
+			})
+			if err != nil {
+				fail("update", packageType, fmt.Errorf("failed to update repository %s: %w", stack.RemoteId, err))
+				return
+			}
+		}
+
+		data.Packages[packageType] = r.packageModel(&data, ws, packageType, pkg, stack)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositorySetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositorySetResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, _, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	for _, packageType := range slices.Sorted(maps.Keys(data.Packages)) {
+		if err := deleteRepositoryStack(r.client, workspaceId, data.Packages[packageType].stack()); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete the %s repositories of the set, got error: %s", packageType, err))
+			return
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow repository set resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *RepositorySetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, name, _ := strings.Cut(req.ID, "/")
+
+	if workspace == "" || name == "" || strings.Contains(name, "/") {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/name. You define: %q", req.ID),
+		)
+		return
+	}
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rps, err := r.client.ListRepositories(ws.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list repositories of workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	byName := map[string]string{}
+	for _, rp := range *rps {
+		byName[rp.RepositoryType+"/"+rp.Name] = rp.Id
+	}
+
+	// Find the complete stacks named after the set, the other attributes are set by Read
+	data := RepositorySetResourceModel{
+		Id:          types.StringValue(ws.Id + "/" + name),
+		WorkspaceId: types.StringValue(workspace),
+		Name:        types.StringValue(name),
+		Packages:    map[string]RepositorySetPackageModel{},
+	}
+	for _, packageType := range packageTypes {
+		localName, remoteName, virtualName := repositoryStackNames(r.stackName(&data, packageType))
+		stack := repositoryStack{
+			LocalId:   byName["local/"+localName],
+			RemoteId:  byName["remote/"+remoteName],
+			VirtualId: byName["virtual/"+virtualName],
+		}
+		if stack.LocalId == "" || stack.RemoteId == "" || stack.VirtualId == "" {
+			continue
+		}
+
+		data.Packages[packageType] = r.packageModel(&data, ws, packageType, RepositorySetPackageModel{}, stack)
+	}
+
+	if len(data.Packages) == 0 {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("No repositories of set %s found in workspace %s.", name, workspace),
+		)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositorySetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on create and destroy
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var planned, prior map[string]RepositorySetPackageModel
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("packages"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("packages"), &prior)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for packageType, pkg := range prior {
+		next, ok := planned[packageType]
+
+		if !ok {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("packages"),
+				"Repositories deleted",
+				fmt.Sprintf("Removing %s from the set deletes its repositories and the packages uploaded to %s.", packageType, pkg.LocalRepositoryId.ValueString()),
+			)
+			continue
+		}
+
+		// A new upstream replaces the remote repository
+		if !next.RemoteUrl.IsUnknown() && !next.RemoteUrl.Equal(pkg.RemoteUrl) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("packages").AtMapKey(packageType).AtName("remote_repository_id"), types.StringUnknown())...)
+		}
+	}
+}
+
+// stackName returns the name of the repositories of a package type of the set.
+func (r *RepositorySetResource) stackName(data *RepositorySetResourceModel, packageType string) string {
+	return data.Name.ValueString() + "-" + packageType
+}
+
+func (r *RepositorySetResource) packageModel(data *RepositorySetResourceModel, ws *client.Workspace, packageType string, pkg RepositorySetPackageModel, stack repositoryStack) RepositorySetPackageModel {
+	_, _, virtualName := repositoryStackNames(r.stackName(data, packageType))
+
+	pkg.LocalRepositoryId = types.StringValue(stack.LocalId)
+	pkg.RemoteRepositoryId = types.StringValue(stack.RemoteId)
+	pkg.VirtualRepositoryId = types.StringValue(stack.VirtualId)
+	pkg.Url = types.StringValue(r.client.RepositoryEndpointUrl(packageType, ws.Name, virtualName))
+
+	return pkg
+}
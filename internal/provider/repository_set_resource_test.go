@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func TestRepositorySetResource(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	packagesType := p.schemas["repoflow_repository_set"].ValueType().(tftypes.Object).AttributeTypes["packages"].(tftypes.Map)
+	config := func(remoteUrls map[string]string) tftypes.Value {
+		packages := map[string]tftypes.Value{}
+		for packageType, url := range remoteUrls {
+			packages[packageType] = objectValue(packagesType.ElementType.(tftypes.Object), map[string]tftypes.Value{
+				"remote_url": str(url),
+			})
+		}
+		return p.config("repoflow_repository_set", map[string]tftypes.Value{
+			"workspace": str(workspaceId),
+			"name":      str("team"),
+			"packages":  tftypes.NewValue(packagesType, packages),
+		})
+	}
+
+	state := p.apply("repoflow_repository_set", p.nullState("repoflow_repository_set"), config(map[string]string{
+		"npm": "https://registry.npmjs.org",
+	}))
+
+	for _, name := range []string{"team-npm-local", "team-npm-remote", "team-npm"} {
+		if _, err := p.client.GetRepository(workspaceId, name); err != nil {
+			t.Errorf("repository %s not created: %s", name, err)
+		}
+	}
+	virtual, _ := p.client.GetRepository(workspaceId, "team-npm")
+	if got := stringAttr(t, state, "packages", "npm", "virtual_repository_id"); got != virtual.Id {
+		t.Errorf("virtual_repository_id = %q, want %q", got, virtual.Id)
+	}
+	if len(virtual.ChildRepositories) != 2 {
+		t.Errorf("virtual children = %v, want the local and remote repositories", virtual.ChildRepositories)
+	}
+
+	// A new upstream replaces the remote repository only
+	remoteId := stringAttr(t, state, "packages", "npm", "remote_repository_id")
+	state = p.applyInPlace("repoflow_repository_set", state, config(map[string]string{
+		"npm": "https://npm.example.com",
+	}))
+	if got := stringAttr(t, state, "packages", "npm", "remote_repository_id"); got == remoteId {
+		t.Error("remote repository not replaced")
+	}
+	if got := stringAttr(t, state, "packages", "npm", "virtual_repository_id"); got != virtual.Id {
+		t.Errorf("virtual repository replaced, virtual_repository_id = %q, want %q", got, virtual.Id)
+	}
+	if rp, _ := p.client.GetRepository(workspaceId, "team-npm-remote"); rp.RemoteRepositoryUrl == nil || *rp.RemoteRepositoryUrl != "https://npm.example.com" {
+		t.Errorf("remote URL = %v, want https://npm.example.com", rp.RemoteRepositoryUrl)
+	}
+
+	// Package types are added and removed in place
+	state = p.applyInPlace("repoflow_repository_set", state, config(map[string]string{
+		"pypi": "https://pypi.org",
+	}))
+	if _, err := p.client.GetRepository(workspaceId, "team-npm"); !client.IsNotFound(err) {
+		t.Errorf("npm repositories not deleted: %v", err)
+	}
+	if _, err := p.client.GetRepository(workspaceId, "team-pypi"); err != nil {
+		t.Errorf("pypi repositories not created: %s", err)
+	}
+
+	p.destroy("repoflow_repository_set", state)
+	if rps, _ := p.client.ListRepositories(workspaceId); len(*rps) != 0 {
+		t.Errorf("repositories left after destroy: %v", *rps)
+	}
+}
+
+func TestRepositorySetResourcePartialStack(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+
+	// The virtual repository of the stack cannot be created
+	if _, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{Name: "team-npm", PackageType: "npm"},
+	}); err != nil {
+		t.Fatalf("CreateLocalRepository: %s", err)
+	}
+
+	packagesType := p.schemas["repoflow_repository_set"].ValueType().(tftypes.Object).AttributeTypes["packages"].(tftypes.Map)
+	_, diags := p.tryApply("repoflow_repository_set", p.nullState("repoflow_repository_set"), p.config("repoflow_repository_set", map[string]tftypes.Value{
+		"workspace": str(workspaceId),
+		"name":      str("team"),
+		"packages": tftypes.NewValue(packagesType, map[string]tftypes.Value{
+			"npm": objectValue(packagesType.ElementType.(tftypes.Object), map[string]tftypes.Value{
+				"remote_url": str("https://registry.npmjs.org"),
+			}),
+		}),
+	}))
+	if !hasError(diags) {
+		t.Fatal("repository set created over an existing repository")
+	}
+	if got := diags[len(diags)-1].Detail; !strings.Contains(got, "failed to create repository team-npm:") {
+		t.Errorf("error %q does not name the repository", got)
+	}
+
+	// The repositories created before the failure are deleted
+	for _, name := range []string{"team-npm-local", "team-npm-remote"} {
+		if _, err := p.client.GetRepository(workspaceId, name); !client.IsNotFound(err) {
+			t.Errorf("repository %s of the partial stack left behind: %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"fmt"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// repositoryStack holds the Ids of a local, a remote and a virtual repository of a
+// package type, the virtual one serving the two others and uploading to the local one.
+type repositoryStack struct {
+	LocalId   string
+	RemoteId  string
+	VirtualId string
+}
+
+// repositoryStackNames returns the names of the local, remote and virtual repositories of
+// a stack, the virtual one holding the name of the stack as the one used by the clients.
+func repositoryStackNames(name string) (string, string, string) {
+	return name + "-local", name + "-remote", name
+}
+
+// createStackRemote creates the remote repository of a stack, with the cache settings of
+// its workspace.
+func createStackRemote(c client.RepositoriesAPI, ws *client.Workspace, name string, packageType string, remoteUrl string, credentialId string) (*client.Repository, error) {
+	_, remoteName, _ := repositoryStackNames(name)
+
+	rp, err := c.CreateRemoteRepository(ws.Id, client.RepositoryRemoteOptions{
+		RepositoryRemoteOptions: repoflow.RepositoryRemoteOptions{
+			Name:                              remoteName,
+			PackageType:                       packageType,
+			RemoteRepositoryUrl:               remoteUrl,
+			IsRemoteCacheEnabled:              ws.DefaultRemoteCacheEnabled != nil && *ws.DefaultRemoteCacheEnabled,
+			FileCacheTimeTillRevalidation:     ws.DefaultFileCacheTtl,
+			MetadataCacheTimeTillRevalidation: ws.DefaultMetadataCacheTtl,
+		},
+		CredentialId: credentialId,
+		ManagedBy:    managedByMarker,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository %s: %w", remoteName, err)
+	}
+
+	return rp, nil
+}
+
+// createRepositoryStack creates the repositories of a stack. The repositories already
+// created are deleted when one fails, so no partial stack is left behind.
+func createRepositoryStack(c client.RepositoriesAPI, ws *client.Workspace, name string, packageType string, remoteUrl string, credentialId string) (*repositoryStack, error) {
+	localName, _, virtualName := repositoryStackNames(name)
+
+	local, err := c.CreateLocalRepository(ws.Id, client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{
+			Name:        localName,
+			PackageType: packageType,
+		},
+		ManagedBy: managedByMarker,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create repository %s: %w", localName, err)
+	}
+
+	remote, err := createStackRemote(c, ws, name, packageType, remoteUrl, credentialId)
+	if err != nil {
+		_ = deleteRepositoryStack(c, ws.Id, repositoryStack{LocalId: local.Id})
+		return nil, err
+	}
+
+	virtual, err := c.CreateVirtualRepository(ws.Id, client.RepositoryVirtualOptions{
+		RepositoryVirtualOptions: repoflow.RepositoryVirtualOptions{
+			Name:                    virtualName,
+			PackageType:             packageType,
+			ChildRepositoryIds:      []string{local.Id, remote.Id},
+			UploadLocalRepositoryId: local.Id,
+		},
+		ManagedBy: managedByMarker,
+	})
+	if err != nil {
+		_ = deleteRepositoryStack(c, ws.Id, repositoryStack{LocalId: local.Id, RemoteId: remote.Id})
+		return nil, fmt.Errorf("failed to create repository %s: %w", virtualName, err)
+	}
+
+	return &repositoryStack{
+		LocalId:   local.Id,
+		RemoteId:  remote.Id,
+		VirtualId: virtual.Id,
+	}, nil
+}
+
+// replaceStackRemote replaces the remote repository of a stack, the upstream URL of a
+// remote repository being immutable. Only the cache of the upstream is lost.
+func replaceStackRemote(c client.RepositoriesAPI, ws *client.Workspace, name string, packageType string, stack *repositoryStack, remoteUrl string, credentialId string) error {
+	// The new remote takes the name of the previous one, which must go first
+	_, err := c.UpdateVirtualRepositoryChildren(ws.Id, stack.VirtualId, client.RepositoryVirtualChildrenOptions{
+		AddChildRepositoryIds:    []string{},
+		RemoveChildRepositoryIds: []string{stack.RemoteId},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to detach repository %s: %w", stack.RemoteId, err)
+	}
+
+	if _, err := c.DeleteRepository(ws.Id, stack.RemoteId); err != nil && !client.IsNotFound(err) {
+		return fmt.Errorf("failed to delete repository %s: %w", stack.RemoteId, err)
+	}
+
+	remote, err := createStackRemote(c, ws, name, packageType, remoteUrl, credentialId)
+	if err != nil {
+		return err
+	}
+	stack.RemoteId = remote.Id
+
+	_, err = c.UpdateVirtualRepositoryChildren(ws.Id, stack.VirtualId, client.RepositoryVirtualChildrenOptions{
+		AddChildRepositoryIds:    []string{remote.Id},
+		RemoveChildRepositoryIds: []string{},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to attach repository %s: %w", remote.Id, err)
+	}
+
+	return nil
+}
+
+// readRepositoryStack returns the remote repository of a stack, or nil when any
+// repository of the stack is gone.
+func readRepositoryStack(c client.RepositoriesAPI, workspaceId string, stack repositoryStack) (*client.Repository, error) {
+	var remote *client.Repository
+
+	for _, id := range []string{stack.VirtualId, stack.LocalId, stack.RemoteId} {
+		rp, err := c.GetRepository(workspaceId, id)
+
+		if client.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read repository %s on workspaceId %s: %w", id, workspaceId, err)
+		}
+
+		remote = rp
+	}
+
+	return remote, nil
+}
+
+// deleteRepositoryStack deletes the repositories of a stack, the virtual one first.
+// The repositories already gone and the unset Ids are skipped.
+func deleteRepositoryStack(c client.RepositoriesAPI, workspaceId string, stack repositoryStack) error {
+	for _, id := range []string{stack.VirtualId, stack.RemoteId, stack.LocalId} {
+		if id == "" {
+			continue
+		}
+
+		if _, err := c.DeleteRepository(workspaceId, id); err != nil && !client.IsNotFound(err) {
+			return fmt.Errorf("failed to delete repository %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoryStatsDataSource{}
+
+func NewRepositoryStatsDataSource() datasource.DataSource {
+	return &RepositoryStatsDataSource{}
+}
+
+// RepositoryStatsDataSource defines the data source implementation.
+type RepositoryStatsDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+type RepositoryStatsDataSourceModel struct {
+	WorkspaceId       types.String  `tfsdk:"workspace"`
+	Repository        types.String  `tfsdk:"repository"`
+	Period            types.String  `tfsdk:"period"`
+	RequestCount      types.Int64   `tfsdk:"request_count"`
+	ErrorCount        types.Int64   `tfsdk:"error_count"`
+	ErrorRate         types.Float64 `tfsdk:"error_rate"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	LatencyP50Ms      types.Float64 `tfsdk:"latency_p50_ms"`
+	LatencyP95Ms      types.Float64 `tfsdk:"latency_p95_ms"`
+	LatencyP99Ms      types.Float64 `tfsdk:"latency_p99_ms"`
+}
+
+func (d *RepositoryStatsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_stats"
+}
+
+func (d *RepositoryStatsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository statistics data source. Recent request latency and error rate of a repository.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id)",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id",
+				Required:            true,
+			},
+			"period": schema.StringAttribute{
+				MarkdownDescription: "Period covered by the statistics, one of `1h`, `24h` or `7d` (default `24h`).",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("1h", "24h", "7d"),
+				},
+			},
+			"request_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests served over the period.",
+				Computed:            true,
+			},
+			"error_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of requests answered with an error over the period.",
+				Computed:            true,
+			},
+			"error_rate": schema.Float64Attribute{
+				MarkdownDescription: "Ratio of requests answered with an error (between 0 and 1).",
+				Computed:            true,
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Average number of requests per second.",
+				Computed:            true,
+			},
+			"latency_p50_ms": schema.Float64Attribute{
+				MarkdownDescription: "Median request latency in milliseconds.",
+				Computed:            true,
+			},
+			"latency_p95_ms": schema.Float64Attribute{
+				MarkdownDescription: "95th percentile request latency in milliseconds.",
+				Computed:            true,
+			},
+			"latency_p99_ms": schema.Float64Attribute{
+				MarkdownDescription: "99th percentile request latency in milliseconds.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RepositoryStatsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoryStatsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoryStatsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+	repository := data.Repository.ValueString()
+
+	period := "24h"
+	if !data.Period.IsNull() {
+		period = data.Period.ValueString()
+	}
+
+	ws, err := d.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rp, err := d.client.GetRepository(ws.Id, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository %s on workspaceId %s, got error: %s", repository, ws.Id, err,
+		))
+		return
+	}
+
+	stats, err := d.client.GetRepositoryStats(ws.Id, rp.Id, period)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read statistics of repository %s, got error: %s", repository, err))
+		return
+	}
+
+	data.Period = types.StringValue(period)
+	data.RequestCount = types.Int64Value(stats.RequestCount)
+	data.ErrorCount = types.Int64Value(stats.ErrorCount)
+	data.ErrorRate = types.Float64Value(stats.ErrorRate)
+	data.RequestsPerSecond = types.Float64Value(stats.RequestsPerSecond)
+	data.LatencyP50Ms = types.Float64Value(stats.LatencyP50Ms)
+	data.LatencyP95Ms = types.Float64Value(stats.LatencyP95Ms)
+	data.LatencyP99Ms = types.Float64Value(stats.LatencyP99Ms)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read repository statistics data", map[string]interface{}{
+		"repository": rp.Id,
+		"workspace":  ws.Id,
+		"period":     period,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,191 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// RepositoryVirtualMemberModel describes a member of the virtual block data model.
+type RepositoryVirtualMemberModel struct {
+	RepositoryId    types.String `tfsdk:"repository_id"`
+	IncludePatterns types.Set    `tfsdk:"include_patterns"`
+	ExcludePatterns types.Set    `tfsdk:"exclude_patterns"`
+}
+
+var virtualMemberType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"repository_id":    types.StringType,
+		"include_patterns": types.SetType{ElemType: types.StringType},
+		"exclude_patterns": types.SetType{ElemType: types.StringType},
+	},
+}
+
+// expandVirtualMembers returns the resolution filters of the members, by repository Id.
+func expandVirtualMembers(ctx context.Context, members types.Set) (map[string]client.VirtualRepositoryMemberOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []RepositoryVirtualMemberModel
+
+	filters := map[string]client.VirtualRepositoryMemberOptions{}
+
+	if members.IsNull() || members.IsUnknown() {
+		return filters, diags
+	}
+
+	diags.Append(members.ElementsAs(ctx, &models, false)...)
+
+	for _, m := range models {
+		opts := client.VirtualRepositoryMemberOptions{
+			IncludePatterns: []string{},
+			ExcludePatterns: []string{},
+		}
+		if !m.IncludePatterns.IsNull() {
+			diags.Append(m.IncludePatterns.ElementsAs(ctx, &opts.IncludePatterns, false)...)
+		}
+		if !m.ExcludePatterns.IsNull() {
+			diags.Append(m.ExcludePatterns.ElementsAs(ctx, &opts.ExcludePatterns, false)...)
+		}
+		slices.Sort(opts.IncludePatterns)
+		slices.Sort(opts.ExcludePatterns)
+		filters[m.RepositoryId.ValueString()] = opts
+	}
+
+	return filters, diags
+}
+
+// flattenVirtualMembers keeps the children with resolution filters, the other
+// ones are only listed in child_repository_ids.
+func flattenVirtualMembers(ctx context.Context, members []client.VirtualRepositoryMember) (types.Set, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	models := []RepositoryVirtualMemberModel{}
+	for _, m := range members {
+		if len(m.IncludePatterns) == 0 && len(m.ExcludePatterns) == 0 {
+			continue
+		}
+
+		include, d := stringSetOrNull(ctx, m.IncludePatterns)
+		diags.Append(d...)
+		exclude, d := stringSetOrNull(ctx, m.ExcludePatterns)
+		diags.Append(d...)
+
+		models = append(models, RepositoryVirtualMemberModel{
+			RepositoryId:    types.StringValue(m.RepositoryId),
+			IncludePatterns: include,
+			ExcludePatterns: exclude,
+		})
+	}
+
+	if len(models) == 0 {
+		return types.SetNull(virtualMemberType), diags
+	}
+
+	setValue, d := types.SetValueFrom(ctx, virtualMemberType, models)
+	diags.Append(d...)
+
+	return setValue, diags
+}
+
+func stringSetOrNull(ctx context.Context, values []string) (types.Set, diag.Diagnostics) {
+	if len(values) == 0 {
+		return types.SetNull(types.StringType), nil
+	}
+	return types.SetValueFrom(ctx, types.StringType, values)
+}
+
+// readVirtualMembers refreshes the resolution filters of a virtual repository.
+func (r *RepositoryResource) readVirtualMembers(ctx context.Context, data *RepositoryResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if data.Virtual == nil {
+		return diags
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	repositoryId := data.RepositoryId.ValueString()
+
+	members, err := r.client.ListVirtualRepositoryMembers(workspaceId, repositoryId)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf(
+			"Unable to get children of repository %s on workspaceId %s, got error: %s", repositoryId, workspaceId, err,
+		))
+		return diags
+	}
+
+	setValue, d := flattenVirtualMembers(ctx, *members)
+	diags.Append(d...)
+	data.Virtual.Members = setValue
+
+	return diags
+}
+
+// updateVirtualMembers sends the resolution filters which changed between the
+// prior state and the plan. Filters of removed members are cleared.
+func (r *RepositoryResource) updateVirtualMembers(ctx context.Context, data *RepositoryResourceModel, prior types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	planned, d := expandVirtualMembers(ctx, data.Virtual.Members)
+	diags.Append(d...)
+	current, d := expandVirtualMembers(ctx, prior)
+	diags.Append(d...)
+
+	var childIds []string
+	diags.Append(data.Virtual.ChildRepositoryIds.ElementsAs(ctx, &childIds, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	repositoryId := data.RepositoryId.ValueString()
+
+	for id := range current {
+		// Removed children lose their filters with them
+		if _, ok := planned[id]; !ok && slices.Contains(childIds, id) {
+			planned[id] = client.VirtualRepositoryMemberOptions{
+				IncludePatterns: []string{},
+				ExcludePatterns: []string{},
+			}
+		}
+	}
+
+	for id, opts := range planned {
+		if !slices.Contains(childIds, id) {
+			diags.AddAttributeError(
+				path.Root("virtual").AtName("members"),
+				"Invalid Virtual Repository Member",
+				fmt.Sprintf("The repository %s has resolution filters but is not listed in virtual.child_repository_ids.", id),
+			)
+			continue
+		}
+
+		if prev, ok := current[id]; ok &&
+			slices.Equal(prev.IncludePatterns, opts.IncludePatterns) &&
+			slices.Equal(prev.ExcludePatterns, opts.ExcludePatterns) {
+			continue
+		}
+
+		if _, err := r.client.UpdateVirtualRepositoryMember(workspaceId, repositoryId, id, opts); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf(
+				"Unable to update resolution filters of child %s of repository %s, got error: %s", id, repositoryId, err,
+			))
+			return diags
+		}
+
+		tflog.Debug(ctx, "updated resolution filters of a virtual repository child", map[string]interface{}{
+			"id":    repositoryId,
+			"child": id,
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,364 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Events a repository webhook can subscribe to
+var webhookEvents = []string{
+	client.WebhookArtifactUploaded,
+	client.WebhookArtifactDeleted,
+	client.WebhookCacheMiss,
+}
+
+// Webhooks are only sent to HTTP endpoints
+var webhookUrlRegexp = regexp.MustCompile(`^https?://`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryWebhookResource{}
+var _ resource.ResourceWithImportState = &RepositoryWebhookResource{}
+
+func NewRepositoryWebhookResource() resource.Resource {
+	return &RepositoryWebhookResource{}
+}
+
+// RepositoryWebhookResource defines the resource implementation.
+type RepositoryWebhookResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+// RepositoryWebhookResourceModel describes the resource data model.
+type RepositoryWebhookResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	WorkspaceId     types.String `tfsdk:"workspace"`
+	Repository      types.String `tfsdk:"repository"`
+	Url             types.String `tfsdk:"url"`
+	Events          types.Set    `tfsdk:"events"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	SecretWo        types.String `tfsdk:"secret_wo"`
+	SecretWoVersion types.Int64  `tfsdk:"secret_wo_version"`
+	WebhookId       types.String `tfsdk:"webhook_id"`
+}
+
+func (r *RepositoryWebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_webhook"
+}
+
+func (r *RepositoryWebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository webhook resource. Notify an endpoint of the events of a single repository, so each team gets the events of its own repositories.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository name or Id.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				MarkdownDescription: "URL receiving the events.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(webhookUrlRegexp, "must be an http:// or https:// URL"),
+				},
+			},
+			"events": schema.SetAttribute{
+				MarkdownDescription: "Events sent to the URL, among `artifact.uploaded`, `artifact.deleted` and `cache.miss` (remote repositories only).",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(webhookEvents...)),
+				},
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the events are sent (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"secret_wo": schema.StringAttribute{
+				MarkdownDescription: "Secret signing the payloads, never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"secret_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the secret. Change it to send a new `secret_wo` to the webhook.",
+				Optional:            true,
+			},
+			"webhook_id": schema.StringAttribute{
+				MarkdownDescription: "Webhook Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Repository webhook state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RepositoryWebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryWebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wh, err := r.client.CreateRepositoryWebhook(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook on repository %s, got error: %s", repositoryId, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, wh.Id}, "/"))
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, wh)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow repository webhook resource", map[string]interface{}{
+		"id":     data.Id.ValueString(),
+		"events": wh.Events,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryWebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected repository webhook identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	wh, err := r.client.GetRepositoryWebhook(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get repository webhook %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, wh)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RepositoryWebhookResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected repository webhook identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	wh, err := r.client.UpdateRepositoryWebhook(idParts[0], idParts[1], idParts[2], opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository webhook %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, wh)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryWebhookResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected repository webhook identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeleteRepositoryWebhook(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository webhook, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow repository webhook resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *RepositoryWebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data RepositoryWebhookResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/webhookId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	wh, err := r.client.GetRepositoryWebhook(workspaceId, repositoryId, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import repository webhook %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, wh.Id}, "/"))
+	data.WorkspaceId = types.StringValue(idParts[0])
+	data.Repository = types.StringValue(idParts[1])
+	data.SecretWoVersion = types.Int64Null()
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, wh)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryWebhookResource) buildOptions(ctx context.Context, data *RepositoryWebhookResourceModel, config tfsdk.Config) (client.RepositoryWebhookOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var secretWo types.String
+
+	opts := client.RepositoryWebhookOptions{
+		Url:     data.Url.ValueString(),
+		Enabled: data.Enabled.ValueBool(),
+	}
+	diags.Append(data.Events.ElementsAs(ctx, &opts.Events, false)...)
+
+	// Write-only values are only available in the configuration
+	diags.Append(config.GetAttribute(ctx, path.Root("secret_wo"), &secretWo)...)
+	opts.Secret = secretWo.ValueStringPointer()
+
+	return opts, diags
+}
+
+func (r *RepositoryWebhookResource) mapResponseToModel(ctx context.Context, data *RepositoryWebhookResourceModel, wh *client.RepositoryWebhook) diag.Diagnostics {
+	data.WebhookId = types.StringValue(wh.Id)
+	data.Url = types.StringValue(wh.Url)
+	data.Enabled = types.BoolValue(wh.Enabled)
+	// Write-only values are never stored
+	data.SecretWo = types.StringNull()
+
+	events, diags := types.SetValueFrom(ctx, types.StringType, wh.Events)
+	data.Events = events
+
+	return diags
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RetentionPolicyResource{}
+var _ resource.ResourceWithImportState = &RetentionPolicyResource{}
+
+func NewRetentionPolicyResource() resource.Resource {
+	return &RetentionPolicyResource{}
+}
+
+// RetentionPolicyResource defines the resource implementation.
+type RetentionPolicyResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.PoliciesAPI
+	}
+}
+
+// RetentionPolicyResourceModel describes the resource data model.
+type RetentionPolicyResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	WorkspaceId   types.String `tfsdk:"workspace"`
+	Name          types.String `tfsdk:"name"`
+	RepositoryIds types.Set    `tfsdk:"repository_ids"`
+	UnusedDays    types.Int64  `tfsdk:"unused_days"`
+	Schedule      types.String `tfsdk:"schedule"`
+	PolicyId      types.String `tfsdk:"policy_id"`
+}
+
+func (r *RetentionPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_retention_policy"
+}
+
+func (r *RetentionPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Retention policy resource. Purge on a schedule the upstream artifacts cached by remote repositories of a workspace once they were not downloaded for `unused_days`. They are fetched again from the upstream on the next download. Use `repoflow_cleanup_policy` for the artifacts uploaded to local repositories.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the policy (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the policy.",
+				Required:            true,
+			},
+			"repository_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the remote repositories to purge.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"unused_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days without download after which a cached artifact is purged.",
+				Required:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				MarkdownDescription: "Cron expression of the runs (UTC), default to `0 4 * * *` (every day at 4am).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("0 4 * * *"),
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(cronRegexp, "must be a cron expression with five fields"),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "Retention policy Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Retention policy state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RetentionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RetentionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RetentionPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rt, err := r.client.CreateRetentionPolicy(ws.Id, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create retention policy on workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rt, ws.Id)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow retention policy resource", map[string]interface{}{
+		"id":          data.Id.ValueString(),
+		"unused_days": rt.UnusedDays,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RetentionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RetentionPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	policyId := data.PolicyId.ValueString()
+
+	rt, err := r.client.GetRetentionPolicy(workspaceId, policyId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to get retention policy %s on workspaceId %s, got error: %s", policyId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rt, workspaceId)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RetentionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RetentionPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	policyId := data.PolicyId.ValueString()
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rt, err := r.client.UpdateRetentionPolicy(workspaceId, policyId, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to update retention policy %s on workspaceId %s, got error: %s", policyId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rt, workspaceId)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "updated a repoflow retention policy resource", map[string]interface{}{
+		"id":          data.Id.ValueString(),
+		"unused_days": rt.UnusedDays,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RetentionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RetentionPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	policyId := data.PolicyId.ValueString()
+
+	if err := r.client.DeleteRetentionPolicy(workspaceId, policyId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete retention policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow retention policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *RetentionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data RetentionPolicyResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/policyId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	policyId := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	rt, err := r.client.GetRetentionPolicy(ws.Id, policyId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import retention policy %s on workspaceId %s, got error: %s", policyId, ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, rt, ws.Id)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RetentionPolicyResource) buildOptions(ctx context.Context, data *RetentionPolicyResourceModel) (client.RetentionPolicyOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	opts := client.RetentionPolicyOptions{
+		Name:       data.Name.ValueString(),
+		UnusedDays: int(data.UnusedDays.ValueInt64()),
+		Schedule:   data.Schedule.ValueString(),
+	}
+	diags.Append(data.RepositoryIds.ElementsAs(ctx, &opts.RepositoryIds, false)...)
+
+	return opts, diags
+}
+
+func (r *RetentionPolicyResource) mapResponseToModel(ctx context.Context, data *RetentionPolicyResourceModel, rt *client.RetentionPolicy, workspaceId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// We save the state id with workspaceId/policyId
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, rt.Id}, "/"))
+	data.PolicyId = types.StringValue(rt.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.Name = types.StringValue(rt.Name)
+	data.UnusedDays = types.Int64Value(int64(rt.UnusedDays))
+	data.Schedule = types.StringValue(rt.Schedule)
+
+	repositoryIds, setDiags := types.SetValueFrom(ctx, types.StringType, rt.RepositoryIds)
+	diags.Append(setDiags...)
+	data.RepositoryIds = repositoryIds
+
+	return diags
+}
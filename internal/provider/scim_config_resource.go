@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// The SCIM provisioning is a singleton, so the state always uses the same identifier.
+const scimConfigId = "scim_config"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ScimConfigResource{}
+var _ resource.ResourceWithImportState = &ScimConfigResource{}
+
+func NewScimConfigResource() resource.Resource {
+	return &ScimConfigResource{}
+}
+
+// ScimConfigResource defines the resource implementation.
+type ScimConfigResource struct {
+	client client.IdentityAPI
+}
+
+// ScimConfigResourceModel describes the resource data model.
+type ScimConfigResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Enabled        types.Bool   `tfsdk:"enabled"`
+	TokenWo        types.String `tfsdk:"token_wo"`
+	TokenWoVersion types.Int64  `tfsdk:"token_wo_version"`
+	BaseUrl        types.String `tfsdk:"base_url"`
+	TokenUpdatedAt types.String `tfsdk:"token_updated_at"`
+}
+
+func (r *ScimConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_scim_config"
+}
+
+func (r *ScimConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "SCIM config resource. Manage the SCIM provisioning of the instance (only one per instance), letting an identity provider like Okta or Microsoft Entra ID create, update and deactivate the users and groups. Set the `base_url` and the token on the provisioning settings of the identity provider.",
+
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the identity provider can sync the users and groups.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"token_wo": schema.StringAttribute{
+				MarkdownDescription: "Bearer token authenticating the identity provider, never stored in the state. Only sent on creation and when `token_wo_version` changes.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"token_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the token. Change it to send a new `token_wo`, the previous one is revoked.",
+				Optional:            true,
+			},
+			"base_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the SCIM API, to set on the identity provider.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"token_updated_at": schema.StringAttribute{
+				MarkdownDescription: "Date of the last change of the token (RFC 3339).",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "SCIM config identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ScimConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ScimConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ScimConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, diags := r.readToken(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sc, err := r.client.UpdateScimConfig(client.ScimConfigOptions{
+		IsEnabled: data.Enabled.ValueBool(),
+		Token:     token,
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SCIM config, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sc)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow scim config resource", map[string]interface{}{
+		"enabled": sc.IsEnabled,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScimConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ScimConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sc, err := r.client.GetScimConfig()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get SCIM config, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sc)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "get a repoflow scim config resource", map[string]interface{}{
+		"enabled": sc.IsEnabled,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScimConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ScimConfigResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.ScimConfigOptions{
+		IsEnabled: data.Enabled.ValueBool(),
+	}
+
+	// Only send the token when its version changed
+	if !data.TokenWoVersion.Equal(state.TokenWoVersion) {
+		token, diags := r.readToken(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.Token = token
+	}
+
+	sc, err := r.client.UpdateScimConfig(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SCIM config, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sc)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ScimConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ScimConfigResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteScimConfig()
+
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SCIM config, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow scim config resource")
+}
+
+func (r *ScimConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// readToken returns the bearer token, the write-only value being only
+// available in the configuration.
+func (r *ScimConfigResource) readToken(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var tokenWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("token_wo"), &tokenWo)
+
+	return tokenWo.ValueStringPointer(), diags
+}
+
+func (r *ScimConfigResource) mapResponseToModel(data *ScimConfigResourceModel, sc *client.ScimConfig) {
+	data.Id = types.StringValue(scimConfigId)
+	data.Enabled = types.BoolValue(sc.IsEnabled)
+	data.BaseUrl = types.StringValue(sc.BaseUrl)
+	data.TokenUpdatedAt = types.StringPointerValue(sc.TokenUpdatedAt)
+	// Write-only values are never stored
+	data.TokenWo = types.StringNull()
+}
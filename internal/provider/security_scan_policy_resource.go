@@ -0,0 +1,287 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Severities of the vulnerabilities, from the lowest
+var vulnerabilitySeverities = []string{"low", "medium", "high", "critical"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SecurityScanPolicyResource{}
+var _ resource.ResourceWithImportState = &SecurityScanPolicyResource{}
+
+func NewSecurityScanPolicyResource() resource.Resource {
+	return &SecurityScanPolicyResource{}
+}
+
+// SecurityScanPolicyResource defines the resource implementation.
+type SecurityScanPolicyResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.SecurityAPI
+	}
+}
+
+// SecurityScanPolicyResourceModel describes the resource data model.
+type SecurityScanPolicyResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	WorkspaceId   types.String `tfsdk:"workspace"`
+	Repository    types.String `tfsdk:"repository"`
+	BlockSeverity types.String `tfsdk:"block_severity"`
+	FailMode      types.String `tfsdk:"fail_mode"`
+	ScanOnUpload  types.Bool   `tfsdk:"scan_on_upload"`
+}
+
+func (r *SecurityScanPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_security_scan_policy"
+}
+
+func (r *SecurityScanPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Security scan policy resource. Define how the packages of a workspace, or of a repository overriding the policy of its workspace, are scanned for vulnerabilities and which ones are blocked. Destroying the resource resets the workspace to the instance defaults, or makes the repository follow its workspace. Waive a vulnerability with a `repoflow_vulnerability_allowlist`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository of the workspace (name or Id). The policy applies to the whole workspace when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"block_severity": schema.StringAttribute{
+				MarkdownDescription: "Lowest severity blocking the download of a package, one of `low`, `medium`, `high` or `critical`. The vulnerabilities are only reported when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(vulnerabilitySeverities...),
+				},
+			},
+			"fail_mode": schema.StringAttribute{
+				MarkdownDescription: "Behavior for the packages not scanned yet or when the scanner fails: `open` serves them, `closed` blocks them (default `open`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("open"),
+				Validators: []validator.String{
+					stringvalidator.OneOf("open", "closed"),
+				},
+			},
+			"scan_on_upload": schema.BoolAttribute{
+				MarkdownDescription: "Scan the packages when uploaded or cached from a remote, rather than on the first download (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Security scan policy identifier, in the form `workspaceId` or `workspaceId/repositoryId`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityScanPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SecurityScanPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SecurityScanPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	sp, err := r.client.PutSecurityScanPolicy(workspaceId, repositoryId, r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set security scan policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sp)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow security scan policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityScanPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SecurityScanPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	sp, err := r.client.GetSecurityScanPolicy(workspaceId, repositoryId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get security scan policy %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sp)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityScanPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SecurityScanPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	sp, err := r.client.PutSecurityScanPolicy(workspaceId, repositoryId, r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update security scan policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sp)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SecurityScanPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SecurityScanPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	if err := r.client.DeleteSecurityScanPolicy(workspaceId, repositoryId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete security scan policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow security scan policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *SecurityScanPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repository, _ := strings.Cut(req.ID, "/")
+
+	if workspace == "" || strings.Contains(repository, "/") {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace or workspace/repository. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, workspace, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scopeId(workspaceId, repositoryId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	if repository != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	}
+}
+
+func (r *SecurityScanPolicyResource) buildOptions(data *SecurityScanPolicyResourceModel) client.SecurityScanPolicyOptions {
+	return client.SecurityScanPolicyOptions{
+		BlockSeverity: data.BlockSeverity.ValueStringPointer(),
+		FailMode:      data.FailMode.ValueString(),
+		ScanOnUpload:  data.ScanOnUpload.ValueBool(),
+	}
+}
+
+func (r *SecurityScanPolicyResource) mapResponseToModel(data *SecurityScanPolicyResourceModel, sp *client.SecurityScanPolicy) {
+	repositoryId := ""
+	if sp.RepositoryId != nil {
+		repositoryId = *sp.RepositoryId
+	}
+
+	// The workspace and the repository are kept as configured (name or Id)
+	data.Id = types.StringValue(scopeId(sp.WorkspaceId, repositoryId))
+	data.BlockSeverity = types.StringPointerValue(sp.BlockSeverity)
+	data.FailMode = types.StringValue(sp.FailMode)
+	data.ScanOnUpload = types.BoolValue(sp.ScanOnUpload)
+}
@@ -0,0 +1,485 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ServiceAccountResource{}
+var _ resource.ResourceWithImportState = &ServiceAccountResource{}
+var _ resource.ResourceWithModifyPlan = &ServiceAccountResource{}
+
+func NewServiceAccountResource() resource.Resource {
+	return &ServiceAccountResource{}
+}
+
+// ServiceAccountResource defines the resource implementation.
+type ServiceAccountResource struct {
+	client client.CredentialsAPI
+}
+
+// ServiceAccountResourceModel describes the resource data model.
+type ServiceAccountResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Scopes      types.Set    `tfsdk:"scopes"`
+	Tokens      types.Map    `tfsdk:"tokens"`
+	CreatedAt   types.String `tfsdk:"created_at"`
+}
+
+// ServiceAccountTokenModel describes a token of the tokens map data model.
+type ServiceAccountTokenModel struct {
+	ExpiresAt types.String `tfsdk:"expires_at"`
+	Id        types.String `tfsdk:"id"`
+	Token     types.String `tfsdk:"token"`
+}
+
+var serviceAccountTokenType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"expires_at": types.StringType,
+		"id":         types.StringType,
+		"token":      types.StringType,
+	},
+}
+
+func (r *ServiceAccountResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_account"
+}
+
+func (r *ServiceAccountResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Service account resource. Create a non-human account, like the one of a CI pipeline, with its scopes and tokens so pipelines stop sharing personal API keys. The token values are only known after their creation and are stored in the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the service account.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the service account.",
+				Optional:            true,
+			},
+			"scopes": schema.SetAttribute{
+				MarkdownDescription: "Scopes granted to the service account and its tokens, among `packages:read`, `packages:write`, `packages:delete`, `repositories:manage`, `workspaces:manage` and `admin`.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(tokenScopes...)),
+				},
+			},
+			"tokens": schema.MapNestedAttribute{
+				MarkdownDescription: "Tokens of the service account, by name, e.g. one per pipeline. Removing a token revokes it, changing its `expires_at` issues a new one.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"expires_at": schema.StringAttribute{
+							MarkdownDescription: "Expiry date of the token (RFC 3339, e.g. `2026-12-31T00:00:00Z`). The token never expires when unset.",
+							Optional:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Token identifier",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"token": schema.StringAttribute{
+							MarkdownDescription: "Value of the token. Unset for imported tokens.",
+							Computed:            true,
+							Sensitive:           true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation date of the service account (RFC 3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Service account identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ServiceAccountResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ServiceAccountResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceAccountResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.ServiceAccountOptions{
+		Name:        data.Name.ValueString(),
+		Description: data.Description.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &opts.Scopes, false)...)
+
+	tokens, diags := r.expandTokens(ctx, data.Tokens)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sa, err := r.client.CreateServiceAccount(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create service account, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow service account resource", map[string]interface{}{
+		"id": sa.Id,
+	})
+
+	for _, name := range slices.Sorted(maps.Keys(tokens)) {
+		t, err := r.createToken(ctx, sa.Id, name, tokens[name])
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token %s of service account %s, got error: %s", name, sa.Name, err))
+			break
+		}
+
+		sa.Tokens = append(sa.Tokens, *t)
+	}
+
+	// Save the service account even when a token failed, Terraform replaces it on the next apply
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sa)...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountId := data.Id.ValueString()
+
+	sa, err := r.client.GetServiceAccount(accountId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get service account %s, got error: %s", accountId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sa)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ServiceAccountResourceModel
+	var state ServiceAccountResourceModel
+
+	// Read Terraform plan and prior state data into the models
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountId := data.Id.ValueString()
+
+	opts := client.ServiceAccountUpdateOptions{
+		Description: data.Description.ValueStringPointer(),
+	}
+	resp.Diagnostics.Append(data.Scopes.ElementsAs(ctx, &opts.Scopes, false)...)
+
+	planned, diags := r.expandTokens(ctx, data.Tokens)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := r.expandTokens(ctx, state.Tokens)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if _, err := r.client.UpdateServiceAccount(accountId, opts); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update service account %s, got error: %s", accountId, err))
+		return
+	}
+
+	// Revoke the removed tokens and the ones issued again, before creating the new ones
+	for _, name := range slices.Sorted(maps.Keys(prior)) {
+		if p, ok := planned[name]; ok && p.ExpiresAt.Equal(prior[name].ExpiresAt) {
+			continue
+		}
+
+		tokenId := prior[name].Id.ValueString()
+		if err := r.client.DeleteServiceAccountToken(accountId, tokenId); err != nil && !client.IsNotFound(err) {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to revoke token %s of service account %s, got error: %s", name, accountId, err))
+			return
+		}
+
+		tflog.Trace(ctx, "revoked a repoflow service account token", map[string]interface{}{
+			"account": accountId,
+			"id":      tokenId,
+		})
+	}
+
+	// Values of the tokens created by this update, by token Id
+	created := map[string]*string{}
+
+	for _, name := range slices.Sorted(maps.Keys(planned)) {
+		if p, ok := prior[name]; ok && p.ExpiresAt.Equal(planned[name].ExpiresAt) {
+			continue
+		}
+
+		t, err := r.createToken(ctx, accountId, name, planned[name])
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create token %s of service account %s, got error: %s", name, accountId, err))
+			return
+		}
+
+		created[t.Id] = t.Token
+	}
+
+	sa, err := r.client.GetServiceAccount(accountId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get service account %s, got error: %s", accountId, err))
+		return
+	}
+
+	for i, t := range sa.Tokens {
+		if token, ok := created[t.Id]; ok {
+			sa.Tokens[i].Token = token
+		}
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sa)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceAccountResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceAccountResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	accountId := data.Id.ValueString()
+
+	// Deleting the account revokes its tokens
+	if err := r.client.DeleteServiceAccount(accountId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete service account, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow service account resource", map[string]interface{}{
+		"id": accountId,
+	})
+}
+
+func (r *ServiceAccountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *ServiceAccountResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plannedTokens, stateTokens types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tokens"), &plannedTokens)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tokens"), &stateTokens)...)
+	}
+
+	planned, diags := r.expandTokens(ctx, plannedTokens)
+	resp.Diagnostics.Append(diags...)
+	prior, diags := r.expandTokens(ctx, stateTokens)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for name, t := range planned {
+		tokenPath := path.Root("tokens").AtMapKey(name)
+
+		if !t.ExpiresAt.IsNull() && !t.ExpiresAt.IsUnknown() {
+			if _, err := time.Parse(time.RFC3339, t.ExpiresAt.ValueString()); err != nil {
+				resp.Diagnostics.AddAttributeError(
+					tokenPath.AtName("expires_at"),
+					"Invalid Expiry Date",
+					fmt.Sprintf("The expiry date must be a RFC 3339 timestamp, got error: %s", err),
+				)
+				continue
+			}
+		}
+
+		// A new expiry date issues a new token, its value is only known after the apply
+		if p, ok := prior[name]; ok && !p.ExpiresAt.Equal(t.ExpiresAt) {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tokenPath.AtName("id"), types.StringUnknown())...)
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, tokenPath.AtName("token"), types.StringUnknown())...)
+		}
+	}
+}
+
+// expandTokens returns the known tokens of the map, by name.
+func (r *ServiceAccountResource) expandTokens(ctx context.Context, tokens types.Map) (map[string]ServiceAccountTokenModel, diag.Diagnostics) {
+	models := map[string]ServiceAccountTokenModel{}
+
+	if tokens.IsNull() || tokens.IsUnknown() {
+		return models, nil
+	}
+
+	return models, tokens.ElementsAs(ctx, &models, false)
+}
+
+func (r *ServiceAccountResource) createToken(ctx context.Context, accountId string, name string, t ServiceAccountTokenModel) (*client.ServiceAccountToken, error) {
+	token, err := r.client.CreateServiceAccountToken(accountId, client.ServiceAccountTokenOptions{
+		Name:      name,
+		ExpiresAt: t.ExpiresAt.ValueStringPointer(),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	tflog.Trace(ctx, "created a repoflow service account token", map[string]interface{}{
+		"account":    accountId,
+		"id":         token.Id,
+		"expires_at": token.ExpiresAt,
+	})
+
+	return token, nil
+}
+
+func (r *ServiceAccountResource) mapResponseToModel(ctx context.Context, data *ServiceAccountResourceModel, sa *client.ServiceAccount) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(sa.Id)
+	data.Name = types.StringValue(sa.Name)
+
+	if sa.Description != nil && *sa.Description != "" {
+		data.Description = types.StringValue(*sa.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	scopes, setDiags := types.SetValueFrom(ctx, types.StringType, sa.Scopes)
+	diags.Append(setDiags...)
+	data.Scopes = scopes
+	data.CreatedAt = types.StringValue(sa.CreatedAt)
+
+	// Token values are never read back from the API, keep the known ones
+	prior, tokenDiags := r.expandTokens(ctx, data.Tokens)
+	diags.Append(tokenDiags...)
+
+	tokens := map[string]ServiceAccountTokenModel{}
+	for _, t := range sa.Tokens {
+		p := prior[t.Name]
+		model := ServiceAccountTokenModel{
+			ExpiresAt: types.StringPointerValue(t.ExpiresAt),
+			Id:        types.StringValue(t.Id),
+			Token:     types.StringPointerValue(t.Token),
+		}
+
+		// The API may normalize the timestamp, keep the configured value when it is the same instant
+		if t.ExpiresAt != nil && sameInstant(p.ExpiresAt.ValueString(), *t.ExpiresAt) {
+			model.ExpiresAt = p.ExpiresAt
+		}
+		if t.Token == nil && p.Id.ValueString() == t.Id {
+			model.Token = p.Token
+		}
+
+		tokens[t.Name] = model
+	}
+
+	if len(tokens) == 0 && data.Tokens.IsNull() {
+		data.Tokens = types.MapNull(serviceAccountTokenType)
+	} else {
+		mapValue, mapDiags := types.MapValueFrom(ctx, serviceAccountTokenType, tokens)
+		diags.Append(mapDiags...)
+		data.Tokens = mapValue
+	}
+
+	return diags
+}
@@ -0,0 +1,255 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+// The session policy is a singleton, so the state always uses the same identifier.
+const sessionPolicyId = "session_policy"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SessionPolicyResource{}
+var _ resource.ResourceWithImportState = &SessionPolicyResource{}
+var _ resource.ResourceWithModifyPlan = &SessionPolicyResource{}
+
+func NewSessionPolicyResource() resource.Resource {
+	return &SessionPolicyResource{}
+}
+
+// SessionPolicyResource defines the resource implementation.
+type SessionPolicyResource struct {
+	client client.IdentityAPI
+}
+
+// SessionPolicyResourceModel describes the resource data model.
+type SessionPolicyResourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	MaxSessionAgeHours types.Int64  `tfsdk:"max_session_age_hours"`
+	IdleTimeoutMinutes types.Int64  `tfsdk:"idle_timeout_minutes"`
+	MaxTokenTtlDays    types.Int64  `tfsdk:"max_token_ttl_days"`
+}
+
+func (r *SessionPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_session_policy"
+}
+
+func (r *SessionPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Session policy resource. Manage how long the web sessions and the access tokens of the instance stay valid (only one per instance). The current sessions follow the new limits, the tokens already issued keep their expiry date. Destroying the resource resets the policy to its defaults.",
+
+		Attributes: map[string]schema.Attribute{
+			"max_session_age_hours": schema.Int64Attribute{
+				MarkdownDescription: "Number of hours after the login ending a session, whatever its activity (default `24`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(24),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"idle_timeout_minutes": schema.Int64Attribute{
+				MarkdownDescription: "Number of minutes without activity ending a session (default `60`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(60),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_token_ttl_days": schema.Int64Attribute{
+				MarkdownDescription: "Longest lifetime of the new access tokens, in days. The tokens without expiry date or expiring later are refused. The lifetime is not limited when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Session policy identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SessionPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SessionPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SessionPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sp, err := r.client.UpdateSessionPolicy(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create session policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sp)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow session policy resource", map[string]interface{}{
+		"max_session_age_hours": sp.MaxSessionAgeHours,
+		"idle_timeout_minutes":  sp.IdleTimeoutMinutes,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SessionPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sp, err := r.client.GetSessionPolicy()
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get session policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sp)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SessionPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sp, err := r.client.UpdateSessionPolicy(r.buildOptions(&data))
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update session policy, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, sp)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SessionPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SessionPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteSessionPolicy(); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete session policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow session policy resource")
+}
+
+func (r *SessionPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *SessionPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var data SessionPolicyResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.MaxSessionAgeHours.IsUnknown() || data.IdleTimeoutMinutes.IsUnknown() {
+		return
+	}
+
+	// A session would always end on its age before being idle for so long
+	if data.IdleTimeoutMinutes.ValueInt64() > data.MaxSessionAgeHours.ValueInt64()*60 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("idle_timeout_minutes"),
+			"Invalid parameter",
+			fmt.Sprintf("`idle_timeout_minutes` (%d) must not exceed `max_session_age_hours` (%d hours).", data.IdleTimeoutMinutes.ValueInt64(), data.MaxSessionAgeHours.ValueInt64()),
+		)
+	}
+}
+
+func (r *SessionPolicyResource) buildOptions(data *SessionPolicyResourceModel) client.SessionPolicyOptions {
+	return client.SessionPolicyOptions{
+		MaxSessionAgeHours: int(data.MaxSessionAgeHours.ValueInt64()),
+		IdleTimeoutMinutes: int(data.IdleTimeoutMinutes.ValueInt64()),
+		MaxTokenTtlDays:    factory.Int64ToPtr(data.MaxTokenTtlDays),
+	}
+}
+
+func (r *SessionPolicyResource) mapResponseToModel(data *SessionPolicyResourceModel, sp *client.SessionPolicy) {
+	data.Id = types.StringValue(sessionPolicyId)
+	data.MaxSessionAgeHours = types.Int64Value(int64(sp.MaxSessionAgeHours))
+	data.IdleTimeoutMinutes = types.Int64Value(int64(sp.IdleTimeoutMinutes))
+	data.MaxTokenTtlDays = types.Int64PointerValue(factory.IntPtrToInt64Ptr(sp.MaxTokenTtlDays))
+}
@@ -0,0 +1,416 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SigningKeyResource{}
+var _ resource.ResourceWithImportState = &SigningKeyResource{}
+
+func NewSigningKeyResource() resource.Resource {
+	return &SigningKeyResource{}
+}
+
+// SigningKeyResource defines the resource implementation.
+type SigningKeyResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.CredentialsAPI
+	}
+}
+
+// SigningKeyResourceModel describes the resource data model.
+type SigningKeyResourceModel struct {
+	Id                  types.String `tfsdk:"id"`
+	WorkspaceId         types.String `tfsdk:"workspace"`
+	Name                types.String `tfsdk:"name"`
+	RepositoryIds       types.Set    `tfsdk:"repository_ids"`
+	Algorithm           types.String `tfsdk:"algorithm"`
+	PrivateKeyWo        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyWoVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	PassphraseWo        types.String `tfsdk:"passphrase_wo"`
+	Fingerprint         types.String `tfsdk:"fingerprint"`
+	PublicKey           types.String `tfsdk:"public_key"`
+	Generated           types.Bool   `tfsdk:"generated"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	SigningKeyId        types.String `tfsdk:"signing_key_id"`
+}
+
+func (r *SigningKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_signing_key"
+}
+
+func (r *SigningKeyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Signing key resource. Manage the GPG key signing the metadata of the Debian, RPM and Terraform provider repositories of a workspace. Upload an existing private key with the write-only `private_key_wo`, or let RepoFlow generate one. The public key to distribute to the clients is exported in `public_key`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the key (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the key.",
+				Required:            true,
+			},
+			"repository_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the debian, rpm and terraform-provider repositories whose metadata is signed by the key.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"algorithm": schema.StringAttribute{
+				MarkdownDescription: "Algorithm of the generated key, `rsa4096` (default) or `ed25519`. Set by RepoFlow for an uploaded key.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("rsa4096", "ed25519"),
+					stringvalidator.ConflictsWith(path.MatchRoot("private_key_wo")),
+				},
+			},
+			"private_key_wo": schema.StringAttribute{
+				MarkdownDescription: "ASCII-armored private key to upload, never stored in the state. A key is generated when unset.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_wo_version")),
+				},
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the private key. Change it to replace the key with a new `private_key_wo`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"passphrase_wo": schema.StringAttribute{
+				MarkdownDescription: "Passphrase of the uploaded private key, never stored in the state.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("private_key_wo")),
+				},
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "Fingerprint of the key.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_key": schema.StringAttribute{
+				MarkdownDescription: "ASCII-armored public key, to install on the clients (e.g. in `/etc/apt/keyrings`).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"generated": schema.BoolAttribute{
+				MarkdownDescription: "Whether the key was generated by RepoFlow.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: "Creation date of the key (RFC 3339).",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"signing_key_id": schema.StringAttribute{
+				MarkdownDescription: "Signing key Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Signing key state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *SigningKeyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SigningKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sk, err := r.client.CreateSigningKey(ws.Id, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create signing key on workspaceId %s, got error: %s", ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sk, ws.Id)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow signing key resource", map[string]interface{}{
+		"id":          data.Id.ValueString(),
+		"fingerprint": sk.Fingerprint,
+		"generated":   sk.Generated,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SigningKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	signingKeyId := data.SigningKeyId.ValueString()
+
+	sk, err := r.client.GetSigningKey(workspaceId, signingKeyId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to get signing key %s on workspaceId %s, got error: %s", signingKeyId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sk, workspaceId)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SigningKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SigningKeyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	signingKeyId := data.SigningKeyId.ValueString()
+
+	// Only the name and the repositories change in place, a new key replaces the resource
+	opts := client.SigningKeyUpdateOptions{
+		Name:          data.Name.ValueString(),
+		RepositoryIds: []string{},
+	}
+	if !data.RepositoryIds.IsNull() {
+		resp.Diagnostics.Append(data.RepositoryIds.ElementsAs(ctx, &opts.RepositoryIds, false)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sk, err := r.client.UpdateSigningKey(workspaceId, signingKeyId, opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to update signing key %s on workspaceId %s, got error: %s", signingKeyId, workspaceId, err,
+		))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sk, workspaceId)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SigningKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SigningKeyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+	signingKeyId := data.SigningKeyId.ValueString()
+
+	if err := r.client.DeleteSigningKey(workspaceId, signingKeyId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete signing key, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow signing key resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *SigningKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data SigningKeyResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/signingKeyId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspace := idParts[0]
+	signingKeyId := idParts[1]
+
+	ws, err := r.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	sk, err := r.client.GetSigningKey(ws.Id, signingKeyId)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import signing key %s on workspaceId %s, got error: %s", signingKeyId, ws.Id, err))
+		return
+	}
+
+	data.PrivateKeyWoVersion = types.Int64Null()
+	data.RepositoryIds = types.SetNull(types.StringType)
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sk, ws.Id)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SigningKeyResource) buildOptions(ctx context.Context, data *SigningKeyResourceModel, config tfsdk.Config) (client.SigningKeyOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var privateKeyWo, passphraseWo types.String
+
+	opts := client.SigningKeyOptions{
+		Name:          data.Name.ValueString(),
+		RepositoryIds: []string{},
+	}
+	if !data.Algorithm.IsUnknown() {
+		opts.Algorithm = data.Algorithm.ValueString()
+	}
+	if !data.RepositoryIds.IsNull() {
+		diags.Append(data.RepositoryIds.ElementsAs(ctx, &opts.RepositoryIds, false)...)
+	}
+
+	// Write-only values are only available in the configuration
+	diags.Append(config.GetAttribute(ctx, path.Root("private_key_wo"), &privateKeyWo)...)
+	diags.Append(config.GetAttribute(ctx, path.Root("passphrase_wo"), &passphraseWo)...)
+	opts.PrivateKey = privateKeyWo.ValueStringPointer()
+	opts.Passphrase = passphraseWo.ValueStringPointer()
+
+	return opts, diags
+}
+
+func (r *SigningKeyResource) mapResponseToModel(ctx context.Context, data *SigningKeyResourceModel, sk *client.SigningKey, workspaceId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// We save the state id with workspaceId/signingKeyId
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, sk.Id}, "/"))
+	data.SigningKeyId = types.StringValue(sk.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+	data.Name = types.StringValue(sk.Name)
+	data.Algorithm = types.StringValue(sk.Algorithm)
+	data.Fingerprint = types.StringValue(sk.Fingerprint)
+	data.PublicKey = types.StringValue(sk.PublicKey)
+	data.Generated = types.BoolValue(sk.Generated)
+	data.CreatedAt = types.StringValue(sk.CreatedAt)
+	// Write-only values are never stored
+	data.PrivateKeyWo = types.StringNull()
+	data.PassphraseWo = types.StringNull()
+
+	// An unset list and an empty one both sign no repository
+	if len(sk.RepositoryIds) > 0 || !data.RepositoryIds.IsNull() {
+		repositoryIds, setDiags := types.SetValueFrom(ctx, types.StringType, append([]string{}, sk.RepositoryIds...))
+		diags.Append(setDiags...)
+		data.RepositoryIds = repositoryIds
+	}
+
+	return diags
+}
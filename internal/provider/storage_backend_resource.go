@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Location attributes required and supported by each backend type
+var storageBackendLocations = map[string]struct {
+	required  string
+	supported []string
+}{
+	"s3":         {"bucket", []string{"bucket", "region", "endpoint", "path"}},
+	"gcs":        {"bucket", []string{"bucket", "path"}},
+	"azure":      {"bucket", []string{"bucket", "endpoint", "path"}},
+	"filesystem": {"path", []string{"path"}},
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &StorageBackendResource{}
+var _ resource.ResourceWithImportState = &StorageBackendResource{}
+var _ resource.ResourceWithModifyPlan = &StorageBackendResource{}
+
+func NewStorageBackendResource() resource.Resource {
+	return &StorageBackendResource{}
+}
+
+// StorageBackendResource defines the resource implementation.
+type StorageBackendResource struct {
+	client client.InstanceAPI
+}
+
+// StorageBackendResourceModel describes the resource data model.
+type StorageBackendResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Type                 types.String `tfsdk:"type"`
+	Bucket               types.String `tfsdk:"bucket"`
+	Region               types.String `tfsdk:"region"`
+	Endpoint             types.String `tfsdk:"endpoint"`
+	Path                 types.String `tfsdk:"path"`
+	CredentialsWo        types.String `tfsdk:"credentials_wo"`
+	CredentialsWoVersion types.Int64  `tfsdk:"credentials_wo_version"`
+	UsedBytes            types.Int64  `tfsdk:"used_bytes"`
+}
+
+func (r *StorageBackendResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_backend"
+}
+
+func (r *StorageBackendResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Storage backend resource. Where the packages are stored: an S3 (or S3 compatible) bucket, a GCS bucket, an Azure Blob Storage container or a directory of the instance. Select it with the `storage_backend_id` of a `repoflow_workspace` or a `repoflow_repository`. A backend can only be destroyed once no workspace or repository uses it.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the storage backend, unique on the instance.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of storage, one of `s3`, `gcs`, `azure` or `filesystem`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("s3", "gcs", "azure", "filesystem"),
+				},
+			},
+			"bucket": schema.StringAttribute{
+				MarkdownDescription: "Bucket of the `s3` and `gcs` types, container of the `azure` type (required for these types). The stored packages are not moved when it changes.",
+				Optional:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "Region of the bucket (only for the `s3` type).",
+				Optional:            true,
+			},
+			"endpoint": schema.StringAttribute{
+				MarkdownDescription: "Endpoint URL of an S3 compatible storage like MinIO for the `s3` type, or of the Blob service for the `azure` type. The endpoint of the cloud provider is used when unset.",
+				Optional:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Prefix of the packages in the bucket or the container, or absolute directory of the packages for the `filesystem` type (required for this type). The stored packages are not moved when it changes.",
+				Optional:            true,
+			},
+			"credentials_wo": schema.StringAttribute{
+				MarkdownDescription: "Credentials of the storage, never stored in the state: `<access key id>:<secret access key>` for `s3`, the JSON key of a service account for `gcs`, a connection string for `azure`. The identity of the instance (instance role, workload identity) is used when unset. Not supported by the `filesystem` type.",
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("credentials_wo_version")),
+				},
+			},
+			"credentials_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the credentials. Change it to send new `credentials_wo`.",
+				Optional:            true,
+			},
+			"used_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Size of the packages stored in the backend, in bytes.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Storage backend identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *StorageBackendResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *StorageBackendResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data StorageBackendResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := r.buildOptions(&data)
+
+	credentials, diags := r.readCredentials(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts.Credentials = credentials
+
+	sb, err := r.client.CreateStorageBackend(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create storage backend, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sb)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow storage backend resource", map[string]interface{}{
+		"id":   sb.Id,
+		"type": sb.BackendType,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageBackendResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data StorageBackendResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendId := data.Id.ValueString()
+
+	sb, err := r.client.GetStorageBackend(backendId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get storage backend %s, got error: %s", backendId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sb)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageBackendResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state StorageBackendResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := r.buildOptions(&data)
+
+	// Only send the credentials when their version changed
+	if !data.CredentialsWoVersion.Equal(state.CredentialsWoVersion) {
+		credentials, diags := r.readCredentials(ctx, req.Config)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		opts.Credentials = credentials
+	}
+
+	sb, err := r.client.UpdateStorageBackend(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update storage backend, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, sb)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *StorageBackendResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data StorageBackendResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backendId := data.Id.ValueString()
+
+	if err := r.client.DeleteStorageBackend(backendId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete storage backend, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow storage backend resource", map[string]interface{}{
+		"id": backendId,
+	})
+}
+
+func (r *StorageBackendResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *StorageBackendResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var backendType, credentialsWo types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("type"), &backendType)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("credentials_wo"), &credentialsWo)...)
+
+	if resp.Diagnostics.HasError() || backendType.IsUnknown() {
+		return
+	}
+
+	location, ok := storageBackendLocations[backendType.ValueString()]
+	if !ok {
+		return
+	}
+
+	for _, name := range []string{"bucket", "region", "endpoint", "path"} {
+		var value types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(name), &value)...)
+
+		switch {
+		case name == location.required && value.IsNull():
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Missing parameter",
+				fmt.Sprintf("`%s` is required for the %s type.", name, backendType.ValueString()),
+			)
+		case !value.IsNull() && !slices.Contains(location.supported, name):
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid parameter",
+				fmt.Sprintf("`%s` is not supported by the %s type.", name, backendType.ValueString()),
+			)
+		case name == "path" && backendType.ValueString() == "filesystem" && !value.IsUnknown() && !value.IsNull() && !strings.HasPrefix(value.ValueString(), "/"):
+			resp.Diagnostics.AddAttributeError(
+				path.Root(name),
+				"Invalid parameter",
+				"The `path` of the filesystem type must be an absolute directory.",
+			)
+		}
+	}
+
+	if !credentialsWo.IsNull() && backendType.ValueString() == "filesystem" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("credentials_wo"),
+			"Invalid parameter",
+			"`credentials_wo` is not supported by the filesystem type.",
+		)
+	}
+}
+
+func (r *StorageBackendResource) buildOptions(data *StorageBackendResourceModel) client.StorageBackendOptions {
+	return client.StorageBackendOptions{
+		Name:        data.Name.ValueString(),
+		BackendType: data.Type.ValueString(),
+		Bucket:      data.Bucket.ValueStringPointer(),
+		Region:      data.Region.ValueStringPointer(),
+		Endpoint:    data.Endpoint.ValueStringPointer(),
+		Path:        data.Path.ValueStringPointer(),
+	}
+}
+
+// readCredentials returns the credentials of the storage, the write-only value
+// being only available in the configuration.
+func (r *StorageBackendResource) readCredentials(ctx context.Context, config tfsdk.Config) (*string, diag.Diagnostics) {
+	var credentialsWo types.String
+
+	diags := config.GetAttribute(ctx, path.Root("credentials_wo"), &credentialsWo)
+
+	return credentialsWo.ValueStringPointer(), diags
+}
+
+func (r *StorageBackendResource) mapResponseToModel(ctx context.Context, data *StorageBackendResourceModel, sb *client.StorageBackend) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(sb.Id)
+	data.Name = types.StringValue(sb.Name)
+	data.Type = types.StringValue(sb.BackendType)
+	data.Bucket = types.StringPointerValue(sb.Bucket)
+	data.Region = types.StringPointerValue(sb.Region)
+	data.Endpoint = types.StringPointerValue(sb.Endpoint)
+	data.Path = types.StringPointerValue(sb.Path)
+	data.UsedBytes = types.Int64Value(sb.UsedBytes)
+	// Write-only values are never stored
+	data.CredentialsWo = types.StringNull()
+
+	return diags
+}
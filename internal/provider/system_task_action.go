@@ -0,0 +1,166 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &SystemTaskAction{}
+var _ action.ActionWithConfigure = &SystemTaskAction{}
+var _ action.ActionWithValidateConfig = &SystemTaskAction{}
+
+func NewSystemTaskAction() action.Action {
+	return &SystemTaskAction{}
+}
+
+// SystemTaskAction defines the action implementation.
+type SystemTaskAction struct {
+	client interface {
+		client.WorkspacesAPI
+		client.InstanceAPI
+	}
+}
+
+// SystemTaskActionModel describes the action data model.
+type SystemTaskActionModel struct {
+	Task        types.String `tfsdk:"task"`
+	PackageType types.String `tfsdk:"package_type"`
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Timeout     types.String `tfsdk:"timeout"`
+}
+
+func (a *SystemTaskAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_task"
+}
+
+func (a *SystemTaskAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "System task action. Run a maintenance task on every repository of a package type, like rebuilding the metadata after a storage migration, and wait for its completion.",
+
+		Attributes: map[string]schema.Attribute{
+			"task": schema.StringAttribute{
+				MarkdownDescription: "Task to run. `reindex` rebuilds the package metadata of the repositories.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("reindex"),
+				},
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: "Package type of the repositories to process.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(packageTypes...),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Only process the repositories of this workspace (name or Id). Every workspace is processed when unset.",
+				Optional:            true,
+			},
+			"timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait for the task, as a duration like `45m` or `2h` (default `30m`). The task keeps running on the server after the timeout.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *SystemTaskAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *SystemTaskAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var data SystemTaskActionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Timeout.IsNull() || data.Timeout.IsUnknown() {
+		return
+	}
+
+	if _, err := parseTimeout(data.Timeout.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+	}
+}
+
+func (a *SystemTaskAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data SystemTaskActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timeout := defaultSystemTaskTimeout
+	if !data.Timeout.IsNull() {
+		var err error
+		if timeout, err = parseTimeout(data.Timeout.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+			return
+		}
+	}
+
+	opts := client.SystemTaskOptions{
+		Task:        data.Task.ValueString(),
+		PackageType: data.PackageType.ValueString(),
+	}
+
+	if !data.WorkspaceId.IsNull() {
+		workspace := data.WorkspaceId.ValueString()
+		ws, err := a.client.GetWorkspace(workspace)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+			return
+		}
+		opts.WorkspaceId = &ws.Id
+	}
+
+	st, err := a.client.StartSystemTask(opts)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to start %s task, got error: %s", opts.Task, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "started a repoflow system task", map[string]interface{}{
+		"id":           st.Id,
+		"task":         st.Task,
+		"package_type": opts.PackageType,
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Started %s task %s on the %s repositories", st.Task, st.Id, opts.PackageType),
+	})
+
+	resp.Diagnostics.Append(waitSystemTask(ctx, a.client, st, timeout, "repositories", resp.SendProgress)...)
+}
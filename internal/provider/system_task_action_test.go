@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/client/fake"
+)
+
+func TestSystemTaskAction(t *testing.T) {
+	p := newTestProvider(t)
+	for _, workspace := range []string{"platform", "data"} {
+		workspaceId := newTestWorkspace(t, p, workspace)
+		if _, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+			RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+		}); err != nil {
+			t.Fatalf("CreateLocalRepository: %s", err)
+		}
+	}
+
+	config := func(attrs map[string]tftypes.Value) tftypes.Value {
+		attrs["task"] = str("reindex")
+		attrs["package_type"] = str("npm")
+		return p.actionConfig("repoflow_system_task", attrs)
+	}
+
+	// Every workspace by default
+	progress, diags := p.invoke("repoflow_system_task", config(map[string]tftypes.Value{}))
+	checkDiagnostics(t, "invoke repoflow_system_task", diags)
+	if len(progress) != 3 || !strings.Contains(progress[1], "2/2 repositories processed") || !strings.HasSuffix(progress[2], "succeeded") {
+		t.Errorf("progress = %q, want the start, 2/2 repositories and the success", progress)
+	}
+
+	progress, diags = p.invoke("repoflow_system_task", config(map[string]tftypes.Value{
+		"workspace": str("platform"),
+	}))
+	checkDiagnostics(t, "invoke repoflow_system_task", diags)
+	if len(progress) != 3 || !strings.Contains(progress[1], "1/1 repositories processed") {
+		t.Errorf("progress = %q, want the repository of the workspace only", progress)
+	}
+
+	if _, diags := p.invoke("repoflow_system_task", config(map[string]tftypes.Value{
+		"workspace": str("missing"),
+	})); !hasError(diags) {
+		t.Error("task started on a missing workspace")
+	}
+
+	if _, diags := p.invoke("repoflow_system_task", config(map[string]tftypes.Value{
+		"timeout": str("-5m"),
+	})); !hasError(diags) {
+		t.Error("negative timeout accepted")
+	}
+}
+
+// scriptedTasks answers the reads of a system task with the given states, in order,
+// the last one being repeated.
+type scriptedTasks struct {
+	*fake.Client
+
+	states []client.SystemTask
+}
+
+func (c *scriptedTasks) GetSystemTask(id string) (*client.SystemTask, error) {
+	st := c.states[0]
+	if len(c.states) > 1 {
+		c.states = c.states[1:]
+	}
+	return &st, nil
+}
+
+func TestWaitSystemTask(t *testing.T) {
+	defer func(interval time.Duration) { systemTaskPollInterval = interval }(systemTaskPollInterval)
+	systemTaskPollInterval = time.Millisecond
+
+	message := "disk full"
+	task := func(status string, processed int) client.SystemTask {
+		st := client.SystemTask{Id: "st-1", Task: "reindex", Status: status, Processed: processed, Total: 3}
+		if status == client.SystemTaskFailed {
+			st.Message = &message
+		}
+		return st
+	}
+
+	for name, tc := range map[string]struct {
+		states   []client.SystemTask
+		timeout  time.Duration
+		progress []string
+		err      string
+	}{
+		"succeeded": {
+			states: []client.SystemTask{
+				task(client.SystemTaskRunning, 1),
+				task(client.SystemTaskRunning, 1),
+				task(client.SystemTaskSucceeded, 3),
+			},
+			timeout: time.Minute,
+			progress: []string{
+				"reindex task st-1: 0/3 repositories processed",
+				"reindex task st-1: 1/3 repositories processed",
+				"reindex task st-1: 3/3 repositories processed",
+				"reindex task st-1 succeeded",
+			},
+		},
+		"failed": {
+			states:  []client.SystemTask{task(client.SystemTaskFailed, 2)},
+			timeout: time.Minute,
+			err:     "failed after processing 2/3 repositories: disk full",
+		},
+		"timeout": {
+			states:  []client.SystemTask{task(client.SystemTaskRunning, 1)},
+			timeout: 20 * time.Millisecond,
+			err:     "is still running after 20ms, 1/3 repositories processed",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			c := &scriptedTasks{Client: fake.New(), states: tc.states}
+			st := task(client.SystemTaskPending, 0)
+
+			var progress []string
+			diags := waitSystemTask(context.Background(), c, &st, tc.timeout, "repositories", func(event action.InvokeProgressEvent) {
+				progress = append(progress, event.Message)
+			})
+
+			if tc.err == "" {
+				if diags.HasError() {
+					t.Fatalf("waitSystemTask: %v", diags)
+				}
+				if !slices.Equal(progress, tc.progress) {
+					t.Errorf("progress = %q, want %q", progress, tc.progress)
+				}
+				return
+			}
+
+			if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tc.err) {
+				t.Errorf("diagnostics = %v, want an error with %q", diags, tc.err)
+			}
+		})
+	}
+}
+
+func TestParseTimeout(t *testing.T) {
+	for value, want := range map[string]time.Duration{"45m": 45 * time.Minute, "2h": 2 * time.Hour, "90s": 90 * time.Second} {
+		if got, err := parseTimeout(value); err != nil || got != want {
+			t.Errorf("parseTimeout(%q) = %s, %v, want %s", value, got, err, want)
+		}
+	}
+
+	for _, value := range []string{"", "45", "0s", "-5m", "soon"} {
+		if _, err := parseTimeout(value); err == nil {
+			t.Errorf("parseTimeout(%q) accepted", value)
+		}
+	}
+}
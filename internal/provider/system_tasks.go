@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+const defaultSystemTaskTimeout = 30 * time.Minute
+
+// systemTaskPollInterval is how often a running task is read, shorter in the tests.
+var systemTaskPollInterval = 5 * time.Second
+
+// waitSystemTask polls a system task until it is over, reporting its progress
+// on the processed items (repositories, packages...) with send.
+func waitSystemTask(ctx context.Context, c client.InstanceAPI, st *client.SystemTask, timeout time.Duration, items string, send func(action.InvokeProgressEvent)) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	ticker := time.NewTicker(systemTaskPollInterval)
+	defer ticker.Stop()
+	deadline := time.After(timeout)
+	processed := -1
+
+	for {
+		if st.Processed != processed && st.Total > 0 {
+			processed = st.Processed
+			send(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("%s task %s: %d/%d %s processed", st.Task, st.Id, st.Processed, st.Total, items),
+			})
+		}
+
+		switch st.Status {
+		case client.SystemTaskSucceeded:
+			send(action.InvokeProgressEvent{
+				Message: fmt.Sprintf("%s task %s succeeded", st.Task, st.Id),
+			})
+			return diags
+		case client.SystemTaskFailed:
+			message := "no details given"
+			if st.Message != nil {
+				message = *st.Message
+			}
+			diags.AddError(
+				"System Task Failed",
+				fmt.Sprintf("The %s task %s failed after processing %d/%d %s: %s", st.Task, st.Id, st.Processed, st.Total, items, message),
+			)
+			return diags
+		}
+
+		select {
+		case <-ctx.Done():
+			diags.AddError("System Task Interrupted", fmt.Sprintf("Stopped waiting for the %s task %s: %s", st.Task, st.Id, ctx.Err()))
+			return diags
+		case <-deadline:
+			diags.AddError(
+				"System Task Timeout",
+				fmt.Sprintf("The %s task %s is still %s after %s, %d/%d %s processed. It keeps running on the server.", st.Task, st.Id, st.Status, timeout, st.Processed, st.Total, items),
+			)
+			return diags
+		case <-ticker.C:
+		}
+
+		id := st.Id
+		var err error
+		if st, err = c.GetSystemTask(id); err != nil {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to get system task %s, got error: %s", id, err))
+			return diags
+		}
+	}
+}
+
+// parseTimeout parses a strictly positive duration.
+func parseTimeout(value string) (time.Duration, error) {
+	timeout, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a duration like 45m or 2h: %s", value, err)
+	}
+
+	if timeout <= 0 {
+		return 0, fmt.Errorf("%q must be strictly positive", value)
+	}
+
+	return timeout, nil
+}
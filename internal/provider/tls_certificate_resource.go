@@ -0,0 +1,271 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TlsCertificateResource{}
+var _ resource.ResourceWithImportState = &TlsCertificateResource{}
+
+func NewTlsCertificateResource() resource.Resource {
+	return &TlsCertificateResource{}
+}
+
+// TlsCertificateResource defines the resource implementation.
+type TlsCertificateResource struct {
+	client client.InstanceAPI
+}
+
+// TlsCertificateResourceModel describes the resource data model.
+type TlsCertificateResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Certificate       types.String `tfsdk:"certificate"`
+	PrivateKey        types.String `tfsdk:"private_key_wo"`
+	PrivateKeyVersion types.Int64  `tfsdk:"private_key_wo_version"`
+	Fingerprint       types.String `tfsdk:"fingerprint"`
+	NotBefore         types.String `tfsdk:"not_before"`
+	ExpiresAt         types.String `tfsdk:"expires_at"`
+}
+
+func (r *TlsCertificateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tls_certificate"
+}
+
+func (r *TlsCertificateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "TLS certificate resource. Upload the certificate served by the instance or a custom domain. The private key is write-only and never stored in the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Certificate name.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded certificate chain, leaf certificate first.",
+				Required:            true,
+			},
+			"private_key_wo": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key matching the certificate.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"private_key_wo_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the private key. Change it to upload a new `private_key_wo` without changing the certificate.",
+				Optional:            true,
+			},
+			"fingerprint": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 fingerprint of the leaf certificate.",
+				Computed:            true,
+			},
+			"not_before": schema.StringAttribute{
+				MarkdownDescription: "Start of the certificate validity (RFC 3339).",
+				Computed:            true,
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "End of the certificate validity (RFC 3339), e.g. for a `check` block warning before the expiry.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Certificate identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TlsCertificateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TlsCertificateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TlsCertificateResourceModel
+	var privateKey types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_wo"), &privateKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.TlsCertificateOptions{
+		Name:        data.Name.ValueString(),
+		Certificate: data.Certificate.ValueString(),
+		PrivateKey:  privateKey.ValueString(),
+	}
+
+	cert, err := r.client.CreateTlsCertificate(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create TLS certificate, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cert)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow TLS certificate resource", map[string]interface{}{
+		"id":         cert.Id,
+		"expires_at": cert.ExpiresAt,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TlsCertificateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TlsCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateId := data.Id.ValueString()
+
+	cert, err := r.client.GetTlsCertificate(certificateId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get TLS certificate %s, got error: %s", certificateId, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cert)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "get a repoflow TLS certificate resource", map[string]interface{}{
+		"id": cert.Id,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TlsCertificateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TlsCertificateResourceModel
+	var privateKey types.String
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only values are only available in the configuration
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("private_key_wo"), &privateKey)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The certificate and its key are always rotated together
+	opts := client.TlsCertificateOptions{
+		Certificate: data.Certificate.ValueString(),
+		PrivateKey:  privateKey.ValueString(),
+	}
+
+	cert, err := r.client.UpdateTlsCertificate(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update TLS certificate, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, cert)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "rotated a repoflow TLS certificate resource", map[string]interface{}{
+		"id":         cert.Id,
+		"expires_at": cert.ExpiresAt,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TlsCertificateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TlsCertificateResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	certificateId := data.Id.ValueString()
+
+	if err := r.client.DeleteTlsCertificate(certificateId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete TLS certificate, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow TLS certificate resource", map[string]interface{}{
+		"id": certificateId,
+	})
+}
+
+func (r *TlsCertificateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *TlsCertificateResource) mapResponseToModel(data *TlsCertificateResourceModel, cert *client.TlsCertificate) {
+	data.Id = types.StringValue(cert.Id)
+	data.Name = types.StringValue(cert.Name)
+	// The API normalizes the PEM encoding, keep the configured value to avoid a diff
+	if data.Certificate.IsNull() {
+		data.Certificate = types.StringValue(cert.Certificate)
+	}
+	data.Fingerprint = types.StringValue(cert.Fingerprint)
+	data.NotBefore = types.StringValue(cert.NotBefore)
+	data.ExpiresAt = types.StringValue(cert.ExpiresAt)
+	// Never store the private key
+	data.PrivateKey = types.StringNull()
+}
@@ -0,0 +1,379 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &TrustedPublisherResource{}
+var _ resource.ResourceWithImportState = &TrustedPublisherResource{}
+
+func NewTrustedPublisherResource() resource.Resource {
+	return &TrustedPublisherResource{}
+}
+
+// TrustedPublisherResource defines the resource implementation.
+type TrustedPublisherResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PackagesAPI
+	}
+}
+
+// TrustedPublisherResourceModel describes the resource data model.
+type TrustedPublisherResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	WorkspaceId     types.String `tfsdk:"workspace"`
+	Repository      types.String `tfsdk:"repository"`
+	Issuer          types.String `tfsdk:"issuer"`
+	Audience        types.String `tfsdk:"audience"`
+	SubjectPatterns types.Set    `tfsdk:"subject_patterns"`
+	RequiredClaims  types.Map    `tfsdk:"required_claims"`
+	Description     types.String `tfsdk:"description"`
+	Enabled         types.Bool   `tfsdk:"enabled"`
+	PublisherId     types.String `tfsdk:"publisher_id"`
+}
+
+func (r *TrustedPublisherResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_trusted_publisher"
+}
+
+func (r *TrustedPublisherResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Trusted publisher resource. Allow the workloads of an OIDC issuer, e.g. the GitHub Actions workflows of a repository, to publish to a local repository by exchanging their identity token, without any long-lived token. The identity token must match the `issuer`, the `audience`, one of the `subject_patterns` and every `required_claims`.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the repository (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Local repository receiving the packages (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"issuer": schema.StringAttribute{
+				MarkdownDescription: "URL of the issuer of the identity tokens, e.g. `https://token.actions.githubusercontent.com` for GitHub Actions.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(oidcIssuerRegexp, "must be an https:// URL"),
+				},
+			},
+			"audience": schema.StringAttribute{
+				MarkdownDescription: "Expected `aud` claim of the identity tokens, default to the URL of the instance.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"subject_patterns": schema.SetAttribute{
+				MarkdownDescription: "Glob patterns of the accepted `sub` claims, e.g. `repo:acme/app:ref:refs/tags/v*` for the tag workflows of a GitHub repository.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"required_claims": schema.MapAttribute{
+				MarkdownDescription: "Other claims the identity tokens must hold, keyed by claim with the exact expected value, e.g. `environment = \"release\"`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the trusted publisher.",
+				Optional:            true,
+			},
+			"enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether the identity tokens are accepted (default `true`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"publisher_id": schema.StringAttribute{
+				MarkdownDescription: "Trusted publisher Id",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Trusted publisher state identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *TrustedPublisherResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TrustedPublisherResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TrustedPublisherResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tp, err := r.client.CreateTrustedPublisher(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create trusted publisher on repository %s, got error: %s", repositoryId, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, tp.Id}, "/"))
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, tp)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow trusted publisher resource", map[string]interface{}{
+		"id":     data.Id.ValueString(),
+		"issuer": tp.Issuer,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedPublisherResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TrustedPublisherResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected trusted publisher identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	tp, err := r.client.GetTrustedPublisher(idParts[0], idParts[1], idParts[2])
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get trusted publisher %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, tp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedPublisherResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TrustedPublisherResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected trusted publisher identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tp, err := r.client.UpdateTrustedPublisher(idParts[0], idParts[1], idParts[2], opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update trusted publisher %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, tp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedPublisherResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TrustedPublisherResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idParts := strings.Split(data.Id.ValueString(), "/")
+	if len(idParts) != 3 {
+		resp.Diagnostics.AddError("Invalid State", fmt.Sprintf("Unexpected trusted publisher identifier %q", data.Id.ValueString()))
+		return
+	}
+
+	if err := r.client.DeleteTrustedPublisher(idParts[0], idParts[1], idParts[2]); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete trusted publisher, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow trusted publisher resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *TrustedPublisherResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var data TrustedPublisherResourceModel
+
+	idParts := strings.Split(req.ID, "/")
+
+	if len(idParts) != 3 || idParts[0] == "" || idParts[1] == "" || idParts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace/repository/publisherId. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveRepository(r.client, idParts[0], idParts[1])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	tp, err := r.client.GetTrustedPublisher(workspaceId, repositoryId, idParts[2])
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import trusted publisher %s, got error: %s", req.ID, err))
+		return
+	}
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, repositoryId, tp.Id}, "/"))
+	data.WorkspaceId = types.StringValue(idParts[0])
+	data.Repository = types.StringValue(idParts[1])
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, tp)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TrustedPublisherResource) buildOptions(ctx context.Context, data *TrustedPublisherResourceModel) (client.TrustedPublisherOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty map requires no other claim
+	opts := client.TrustedPublisherOptions{
+		Issuer:          data.Issuer.ValueString(),
+		SubjectPatterns: []string{},
+		RequiredClaims:  map[string]string{},
+		Description:     data.Description.ValueStringPointer(),
+		IsEnabled:       data.Enabled.ValueBool(),
+	}
+	if !data.Audience.IsUnknown() {
+		opts.Audience = data.Audience.ValueStringPointer()
+	}
+	diags.Append(data.SubjectPatterns.ElementsAs(ctx, &opts.SubjectPatterns, false)...)
+	diags.Append(data.RequiredClaims.ElementsAs(ctx, &opts.RequiredClaims, false)...)
+
+	return opts, diags
+}
+
+func (r *TrustedPublisherResource) mapResponseToModel(ctx context.Context, data *TrustedPublisherResourceModel, tp *client.TrustedPublisher) diag.Diagnostics {
+	var diags, d diag.Diagnostics
+
+	data.PublisherId = types.StringValue(tp.Id)
+	data.Issuer = types.StringValue(tp.Issuer)
+	data.Audience = types.StringValue(tp.Audience)
+	data.Description = types.StringPointerValue(tp.Description)
+	data.Enabled = types.BoolValue(tp.IsEnabled)
+
+	data.SubjectPatterns, d = types.SetValueFrom(ctx, types.StringType, tp.SubjectPatterns)
+	diags.Append(d...)
+
+	// Keep the claims null when none is set to avoid a diff with the configuration
+	if len(tp.RequiredClaims) > 0 {
+		data.RequiredClaims, d = types.MapValueFrom(ctx, types.StringType, tp.RequiredClaims)
+		diags.Append(d...)
+	} else {
+		data.RequiredClaims = types.MapNull(types.StringType)
+	}
+
+	return diags
+}
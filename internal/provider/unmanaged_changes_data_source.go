@@ -0,0 +1,232 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Reasons for reporting an unmanaged change
+const (
+	unmanagedMissingMarker = "missing_marker"
+	unmanagedOtherMarker   = "other_marker"
+	unmanagedChangedSince  = "changed_since"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &UnmanagedChangesDataSource{}
+
+func NewUnmanagedChangesDataSource() datasource.DataSource {
+	return &UnmanagedChangesDataSource{}
+}
+
+// UnmanagedChangesDataSource defines the data source implementation.
+type UnmanagedChangesDataSource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+	}
+}
+
+type UnmanagedChangesDataSourceModel struct {
+	WorkspaceId types.String           `tfsdk:"workspace"`
+	Since       types.String           `tfsdk:"since"`
+	Changes     []UnmanagedChangeModel `tfsdk:"changes"`
+}
+
+type UnmanagedChangeModel struct {
+	Kind      types.String `tfsdk:"kind"`
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	ManagedBy types.String `tfsdk:"managed_by"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+	Reasons   []string     `tfsdk:"reasons"`
+}
+
+func (d *UnmanagedChangesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_unmanaged_changes"
+}
+
+func (d *UnmanagedChangesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Unmanaged changes data source. The workspace and repositories missing the `terraform` managed-by marker, or whose settings changed since a date, e.g. for a weekly drift report.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace to check (name or Id)",
+				Required:            true,
+			},
+			"since": schema.StringAttribute{
+				MarkdownDescription: "Also report the objects whose settings changed after this date (RFC 3339, e.g. `2026-10-01T00:00:00Z`), like the date of the last apply. Only the managed-by marker is checked when unset.",
+				Optional:            true,
+			},
+			"changes": schema.ListNestedAttribute{
+				MarkdownDescription: "Objects of the workspace to review, the workspace first then the repositories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"kind": schema.StringAttribute{
+							MarkdownDescription: "Kind of object, `workspace` or `repository`.",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Object Id",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Object name",
+							Computed:            true,
+						},
+						"managed_by": schema.StringAttribute{
+							MarkdownDescription: "Tool managing the object, unset when the marker is missing.",
+							Computed:            true,
+						},
+						"updated_at": schema.StringAttribute{
+							MarkdownDescription: "Date of the last change of the settings (RFC 3339).",
+							Computed:            true,
+						},
+						"reasons": schema.ListAttribute{
+							MarkdownDescription: "Why the object is reported: `missing_marker`, `other_marker` (managed by another tool) or `changed_since`.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UnmanagedChangesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UnmanagedChangesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data UnmanagedChangesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var since *time.Time
+	if !data.Since.IsNull() {
+		t, err := time.Parse(time.RFC3339, data.Since.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("since"),
+				"Invalid Date",
+				fmt.Sprintf("The date must be a RFC 3339 timestamp, got error: %s", err),
+			)
+			return
+		}
+		since = &t
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	ws, err := d.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	data.Changes = []UnmanagedChangeModel{}
+
+	if change, ok := unmanagedChange("workspace", ws.Id, ws.Name, ws.ManagedBy, ws.UpdatedAt, since); ok {
+		data.Changes = append(data.Changes, change)
+	}
+
+	rps, err := d.client.ListRepositories(ws.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list repositories of workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	// The list only holds the repository names, read each of them for the marker
+	for _, item := range *rps {
+		rp, err := d.client.GetRepository(ws.Id, item.Id)
+
+		// Deleted since the listing
+		if client.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to read repository %s on workspaceId %s, got error: %s", item.Name, ws.Id, err,
+			))
+			return
+		}
+
+		if change, ok := unmanagedChange("repository", rp.Id, rp.Name, rp.ManagedBy, rp.UpdatedAt, since); ok {
+			data.Changes = append(data.Changes, change)
+		}
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read unmanaged changes data", map[string]interface{}{
+		"workspace":    ws.Id,
+		"repositories": len(*rps),
+		"changes":      len(data.Changes),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// unmanagedChange reports an object missing the managed-by marker, or changed after since.
+func unmanagedChange(kind string, id string, name string, managedBy *string, updatedAt *string, since *time.Time) (UnmanagedChangeModel, bool) {
+	reasons := []string{}
+
+	switch {
+	case managedBy == nil || *managedBy == "":
+		reasons = append(reasons, unmanagedMissingMarker)
+	case *managedBy != managedByMarker:
+		reasons = append(reasons, unmanagedOtherMarker)
+	}
+
+	if since != nil && updatedAt != nil {
+		if t, err := time.Parse(time.RFC3339, *updatedAt); err == nil && t.After(*since) {
+			reasons = append(reasons, unmanagedChangedSince)
+		}
+	}
+
+	change := UnmanagedChangeModel{
+		Kind:      types.StringValue(kind),
+		Id:        types.StringValue(id),
+		Name:      types.StringValue(name),
+		ManagedBy: types.StringPointerValue(managedBy),
+		UpdatedAt: types.StringPointerValue(updatedAt),
+		Reasons:   reasons,
+	}
+
+	return change, len(reasons) > 0
+}
@@ -0,0 +1,334 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// File extension without the leading dot, e.g. jar or tar.gz
+var fileExtensionRegexp = regexp.MustCompile(`^[A-Za-z0-9]+(\.[A-Za-z0-9]+)*$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UploadPolicyResource{}
+var _ resource.ResourceWithImportState = &UploadPolicyResource{}
+
+func NewUploadPolicyResource() resource.Resource {
+	return &UploadPolicyResource{}
+}
+
+// UploadPolicyResource defines the resource implementation.
+type UploadPolicyResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.PoliciesAPI
+	}
+}
+
+// UploadPolicyResourceModel describes the resource data model.
+type UploadPolicyResourceModel struct {
+	Id                   types.String `tfsdk:"id"`
+	WorkspaceId          types.String `tfsdk:"workspace"`
+	Repository           types.String `tfsdk:"repository"`
+	MaxArtifactSizeBytes types.Int64  `tfsdk:"max_artifact_size_bytes"`
+	AllowedExtensions    types.Set    `tfsdk:"allowed_extensions"`
+	RequireChecksum      types.Bool   `tfsdk:"require_checksum"`
+	BlockedPathPatterns  types.Set    `tfsdk:"blocked_path_patterns"`
+}
+
+func (r *UploadPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_upload_policy"
+}
+
+func (r *UploadPolicyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Upload policy resource. Define the constraints on the artifacts uploaded in a workspace, or in a repository overriding the policy of its workspace. The uploads violating the policy are refused. Destroying the resource removes the policy of the workspace, or makes the repository follow its workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository of the workspace (name or Id). The policy applies to the whole workspace when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_artifact_size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum size of an uploaded artifact, in bytes. The size is not limited when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"allowed_extensions": schema.SetAttribute{
+				MarkdownDescription: "File extensions accepted, without the leading dot (e.g. `jar`, `tar.gz`). Every extension is accepted when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(fileExtensionRegexp, "must be a file extension without the leading dot, e.g. tar.gz"),
+					),
+				},
+			},
+			"require_checksum": schema.BoolAttribute{
+				MarkdownDescription: "Whether the uploads must send a checksum of the artifact, checked against the received content (default `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"blocked_path_patterns": schema.SetAttribute{
+				MarkdownDescription: "Glob patterns of the artifact paths refused, e.g. `**/*-SNAPSHOT*` or `internal/**`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Upload policy identifier, in the form `workspaceId` or `workspaceId/repositoryId`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UploadPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UploadPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data UploadPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	up, err := r.client.PutUploadPolicy(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set upload policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, up)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow upload policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UploadPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data UploadPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	up, err := r.client.GetUploadPolicy(workspaceId, repositoryId)
+
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get upload policy %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, up)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UploadPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data UploadPolicyResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	up, err := r.client.PutUploadPolicy(workspaceId, repositoryId, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update upload policy, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, up)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UploadPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data UploadPolicyResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, _ := strings.Cut(data.Id.ValueString(), "/")
+
+	if err := r.client.DeleteUploadPolicy(workspaceId, repositoryId); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete upload policy, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow upload policy resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *UploadPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workspace, repository, _ := strings.Cut(req.ID, "/")
+
+	if workspace == "" || strings.Contains(repository, "/") {
+		resp.Diagnostics.AddError(
+			"Fail to import data",
+			fmt.Sprintf("Id use format: workspace or workspace/repository. You define: %q", req.ID),
+		)
+		return
+	}
+
+	workspaceId, repositoryId, err := resolveScope(r.client, workspace, repository)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), scopeId(workspaceId, repositoryId))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), workspace)...)
+	if repository != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repository)...)
+	}
+}
+
+func (r *UploadPolicyResource) buildOptions(ctx context.Context, data *UploadPolicyResourceModel) (client.UploadPolicyOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list accepts every extension and blocks no path
+	opts := client.UploadPolicyOptions{
+		MaxArtifactSizeBytes: data.MaxArtifactSizeBytes.ValueInt64Pointer(),
+		AllowedExtensions:    []string{},
+		IsChecksumRequired:   data.RequireChecksum.ValueBool(),
+		BlockedPathPatterns:  []string{},
+	}
+	diags.Append(data.AllowedExtensions.ElementsAs(ctx, &opts.AllowedExtensions, false)...)
+	diags.Append(data.BlockedPathPatterns.ElementsAs(ctx, &opts.BlockedPathPatterns, false)...)
+
+	return opts, diags
+}
+
+func (r *UploadPolicyResource) mapResponseToModel(ctx context.Context, data *UploadPolicyResourceModel, up *client.UploadPolicy) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	repositoryId := ""
+	if up.RepositoryId != nil {
+		repositoryId = *up.RepositoryId
+	}
+
+	// The workspace and the repository are kept as configured (name or Id)
+	data.Id = types.StringValue(scopeId(up.WorkspaceId, repositoryId))
+	data.MaxArtifactSizeBytes = types.Int64PointerValue(up.MaxArtifactSizeBytes)
+	data.RequireChecksum = types.BoolValue(up.IsChecksumRequired)
+
+	// Keep the lists null when empty to avoid a diff with the configuration
+	var d diag.Diagnostics
+	data.AllowedExtensions, d = stringSetOrNull(ctx, up.AllowedExtensions)
+	diags.Append(d...)
+	data.BlockedPathPatterns, d = stringSetOrNull(ctx, up.BlockedPathPatterns)
+	diags.Append(d...)
+
+	return diags
+}
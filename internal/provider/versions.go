@@ -0,0 +1,163 @@
+package provider
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// semverRegexp matches a version of one to three numeric segments, like `1.4`, `v2.0.1`
+// or `1.0.0-rc.1+build.5`.
+var semverRegexp = regexp.MustCompile(`^v?(\d+)(?:\.(\d+))?(?:\.(\d+))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// semver is a parsed package version, the missing segments being 0.
+type semver struct {
+	segments   [3]int64
+	specified  int
+	prerelease string
+}
+
+func parseSemver(v string) (*semver, error) {
+	m := semverRegexp.FindStringSubmatch(strings.TrimSpace(v))
+	if m == nil {
+		return nil, fmt.Errorf("%q is not a semantic version", v)
+	}
+
+	sv := &semver{prerelease: m[4]}
+	for i, s := range m[1:4] {
+		if s == "" {
+			break
+		}
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a semantic version: %s", v, err)
+		}
+		sv.segments[i] = n
+		sv.specified++
+	}
+
+	return sv, nil
+}
+
+// compare orders the versions as semantic versioning does, a pre-release coming before
+// its release. The build metadata is ignored.
+func (v *semver) compare(o *semver) int {
+	for i := range v.segments {
+		if c := cmp.Compare(v.segments[i], o.segments[i]); c != 0 {
+			return c
+		}
+	}
+
+	switch {
+	case v.prerelease == o.prerelease:
+		return 0
+	case v.prerelease == "":
+		return 1
+	case o.prerelease == "":
+		return -1
+	}
+
+	a, b := strings.Split(v.prerelease, "."), strings.Split(o.prerelease, ".")
+	for i := 0; i < len(a) && i < len(b); i++ {
+		na, errA := strconv.ParseInt(a[i], 10, 64)
+		nb, errB := strconv.ParseInt(b[i], 10, 64)
+
+		var c int
+		switch {
+		case errA == nil && errB == nil:
+			c = cmp.Compare(na, nb)
+		// The numeric identifiers come before the alphanumeric ones
+		case errA == nil:
+			c = -1
+		case errB == nil:
+			c = 1
+		default:
+			c = strings.Compare(a[i], b[i])
+		}
+		if c != 0 {
+			return c
+		}
+	}
+
+	return cmp.Compare(len(a), len(b))
+}
+
+// versionConstraint is one comparison of a constraint like `>= 1.2, < 2.0`.
+type versionConstraint struct {
+	operator string
+	version  *semver
+}
+
+var constraintRegexp = regexp.MustCompile(`^(=|!=|>=|<=|>|<|~>)?\s*(\S+)$`)
+
+// parseVersionConstraints parses a comma separated list of constraints, with the
+// operators of the Terraform version constraints: `=`, `!=`, `>`, `>=`, `<`, `<=` and
+// `~>`, which only allows the rightmost segment to grow.
+func parseVersionConstraints(s string) ([]versionConstraint, error) {
+	constraints := []versionConstraint{}
+
+	for _, part := range strings.Split(s, ",") {
+		m := constraintRegexp.FindStringSubmatch(strings.TrimSpace(part))
+		if m == nil {
+			return nil, fmt.Errorf("%q is not a valid version constraint", strings.TrimSpace(part))
+		}
+
+		v, err := parseSemver(m[2])
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid version constraint: %s", strings.TrimSpace(part), err)
+		}
+
+		constraints = append(constraints, versionConstraint{operator: cmp.Or(m[1], "="), version: v})
+	}
+
+	return constraints, nil
+}
+
+// matchVersionConstraints reports whether a version meets every constraint. A pre-release
+// only meets a constraint on a pre-release of the same release, like Terraform does.
+func matchVersionConstraints(v *semver, constraints []versionConstraint) bool {
+	for _, c := range constraints {
+		if v.prerelease != "" && (c.version.prerelease == "" || v.segments != c.version.segments) {
+			return false
+		}
+
+		r := v.compare(c.version)
+
+		var ok bool
+		switch c.operator {
+		case "=":
+			ok = r == 0
+		case "!=":
+			ok = r != 0
+		case ">":
+			ok = r > 0
+		case ">=":
+			ok = r >= 0
+		case "<":
+			ok = r < 0
+		case "<=":
+			ok = r <= 0
+		case "~>":
+			ok = r >= 0 && pessimisticMatch(v, c.version)
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// pessimisticMatch reports whether v keeps the segments of the constraint version but
+// the rightmost one, e.g. `~> 1.4` allows `1.x` and `~> 1.4.2` allows `1.4.x`.
+func pessimisticMatch(v *semver, c *semver) bool {
+	keep := max(c.specified-1, 1)
+	for i := range keep {
+		if v.segments[i] != c.segments[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseSemver(t *testing.T) {
+	for v, want := range map[string]*semver{
+		"1":                  {segments: [3]int64{1, 0, 0}, specified: 1},
+		"1.4":                {segments: [3]int64{1, 4, 0}, specified: 2},
+		"v2.0.1":             {segments: [3]int64{2, 0, 1}, specified: 3},
+		" 1.2.3 ":            {segments: [3]int64{1, 2, 3}, specified: 3},
+		"1.0.0-rc.1":         {segments: [3]int64{1, 0, 0}, specified: 3, prerelease: "rc.1"},
+		"1.0.0-rc.1+build.5": {segments: [3]int64{1, 0, 0}, specified: 3, prerelease: "rc.1"},
+		"1.0.0+build.5":      {segments: [3]int64{1, 0, 0}, specified: 3},
+	} {
+		got, err := parseSemver(v)
+		if err != nil {
+			t.Errorf("parseSemver(%q): %s", v, err)
+			continue
+		}
+		if *got != *want {
+			t.Errorf("parseSemver(%q) = %+v, want %+v", v, *got, *want)
+		}
+	}
+
+	for _, v := range []string{"", "latest", "1.2.3.4", "1.x", "v", "1.0.0-", "1.0.0+", "99999999999999999999"} {
+		if _, err := parseSemver(v); err == nil {
+			t.Errorf("parseSemver(%q) accepted", v)
+		}
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	// Each version comes before the next one
+	ordered := []string{
+		"0.9.9",
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.2",
+		"1.10.0",
+		"2",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			a, _ := parseSemver(ordered[i])
+			b, _ := parseSemver(ordered[j])
+
+			want := 0
+			if i < j {
+				want = -1
+			} else if i > j {
+				want = 1
+			}
+			if got := a.compare(b); got != want {
+				t.Errorf("compare(%s, %s) = %d, want %d", ordered[i], ordered[j], got, want)
+			}
+		}
+	}
+
+	// The build metadata and the missing segments are ignored
+	for a, b := range map[string]string{"1.0.0+build.1": "1.0.0+build.2", "1": "1.0.0", "v1.2": "1.2.0"} {
+		va, _ := parseSemver(a)
+		vb, _ := parseSemver(b)
+		if got := va.compare(vb); got != 0 {
+			t.Errorf("compare(%s, %s) = %d, want 0", a, b, got)
+		}
+	}
+}
+
+func TestMatchVersionConstraints(t *testing.T) {
+	for constraint, tc := range map[string]struct {
+		match   []string
+		noMatch []string
+	}{
+		"1.2.0":           {match: []string{"1.2.0", "1.2", "v1.2.0"}, noMatch: []string{"1.2.1", "1.1.9"}},
+		"= 1.2.0":         {match: []string{"1.2.0"}, noMatch: []string{"1.2.1"}},
+		"!= 1.2.0":        {match: []string{"1.1.0", "1.2.1"}, noMatch: []string{"1.2.0"}},
+		"> 1.2.0":         {match: []string{"1.2.1", "2.0.0"}, noMatch: []string{"1.2.0", "1.0.0"}},
+		">= 1.2.0":        {match: []string{"1.2.0", "1.3.0"}, noMatch: []string{"1.1.9"}},
+		"< 1.2.0":         {match: []string{"1.1.9", "0.1.0"}, noMatch: []string{"1.2.0", "1.2.1"}},
+		"<= 1.2.0":        {match: []string{"1.2.0", "1.0.0"}, noMatch: []string{"1.2.1"}},
+		">=1.0,<2.0":      {match: []string{"1.0.0", "1.9.9"}, noMatch: []string{"0.9.0", "2.0.0"}},
+		">= 1.0, != 1.5":  {match: []string{"1.4.0", "1.6.0"}, noMatch: []string{"1.5.0"}},
+		"~> 1":            {match: []string{"1.0.0", "1.9.0"}, noMatch: []string{"0.9.0", "2.0.0"}},
+		"~> 1.4":          {match: []string{"1.4.0", "1.4.7", "1.9.0"}, noMatch: []string{"1.3.9", "2.0.0"}},
+		"~> 1.4.2":        {match: []string{"1.4.2", "1.4.9"}, noMatch: []string{"1.4.1", "1.5.0", "2.0.0"}},
+		"~> 0.0.1":        {match: []string{"0.0.1", "0.0.5"}, noMatch: []string{"0.1.0"}},
+		">= 1.0.0":        {match: []string{"1.0.0"}, noMatch: []string{"1.1.0-beta", "2.0.0-rc.1"}},
+		">= 1.1.0-beta":   {match: []string{"1.1.0-beta", "1.1.0-rc.1", "1.1.0", "1.2.0"}, noMatch: []string{"1.1.0-alpha", "1.2.0-beta"}},
+		"~> 1.1.0-beta.1": {match: []string{"1.1.0-beta.2", "1.1.5"}, noMatch: []string{"1.1.0-beta.0", "1.2.0"}},
+		"= 1.1.0-rc.1":    {match: []string{"1.1.0-rc.1"}, noMatch: []string{"1.1.0", "1.1.0-rc.2"}},
+	} {
+		constraints, err := parseVersionConstraints(constraint)
+		if err != nil {
+			t.Errorf("parseVersionConstraints(%q): %s", constraint, err)
+			continue
+		}
+
+		for _, v := range tc.match {
+			sv, _ := parseSemver(v)
+			if !matchVersionConstraints(sv, constraints) {
+				t.Errorf("%s does not match %q", v, constraint)
+			}
+		}
+		for _, v := range tc.noMatch {
+			sv, _ := parseSemver(v)
+			if matchVersionConstraints(sv, constraints) {
+				t.Errorf("%s matches %q", v, constraint)
+			}
+		}
+	}
+}
+
+func TestParseVersionConstraintsInvalid(t *testing.T) {
+	for _, constraint := range []string{"", ",", ">= 1.0,", "=> 1.0", "~ 1.0", ">= latest", ">= 1.0 < 2.0", "1.x"} {
+		if _, err := parseVersionConstraints(constraint); err == nil {
+			t.Errorf("parseVersionConstraints(%q) accepted", constraint)
+		}
+	}
+}
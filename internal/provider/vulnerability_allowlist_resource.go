@@ -0,0 +1,305 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+var vulnerabilityIdRegexp = regexp.MustCompile(`^(CVE-[0-9]{4}-[0-9]{4,}|GHSA(-[23456789cfghjmpqrvwx]{4}){3})$`)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &VulnerabilityAllowlistResource{}
+var _ resource.ResourceWithImportState = &VulnerabilityAllowlistResource{}
+var _ resource.ResourceWithModifyPlan = &VulnerabilityAllowlistResource{}
+
+func NewVulnerabilityAllowlistResource() resource.Resource {
+	return &VulnerabilityAllowlistResource{}
+}
+
+// VulnerabilityAllowlistResource defines the resource implementation.
+type VulnerabilityAllowlistResource struct {
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.SecurityAPI
+	}
+}
+
+// VulnerabilityAllowlistResourceModel describes the resource data model.
+type VulnerabilityAllowlistResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	VulnerabilityId types.String `tfsdk:"vulnerability_id"`
+	WorkspaceId     types.String `tfsdk:"workspace"`
+	Repository      types.String `tfsdk:"repository"`
+	PackageName     types.String `tfsdk:"package"`
+	Justification   types.String `tfsdk:"justification"`
+	ExpiresAt       types.String `tfsdk:"expires_at"`
+}
+
+func (r *VulnerabilityAllowlistResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vulnerability_allowlist"
+}
+
+func (r *VulnerabilityAllowlistResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Vulnerability allowlist resource. Time-boxed waiver of a vulnerability in the `repoflow_security_scan_policy`, so the affected packages are no longer blocked. The waiver lapses at `expires_at` and is then removed from the state.",
+
+		Attributes: map[string]schema.Attribute{
+			"vulnerability_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the waived vulnerability, a CVE (e.g. `CVE-2024-3094`) or a GitHub advisory (e.g. `GHSA-xxxx-xxxx-xxxx`).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(vulnerabilityIdRegexp, "must be a CVE or a GHSA identifier"),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace covered by the waiver (name or Id). The waiver applies to the whole instance when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository of the workspace covered by the waiver (name or Id). The waiver applies to the whole workspace when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("workspace")),
+				},
+			},
+			"package": schema.StringAttribute{
+				MarkdownDescription: "Name of the package for which the vulnerability is waived. Every package affected by the vulnerability is allowed when unset.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"justification": schema.StringAttribute{
+				MarkdownDescription: "Why the vulnerability is waived, e.g. the vulnerable code is not reachable.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"expires_at": schema.StringAttribute{
+				MarkdownDescription: "Expiry date of the waiver (RFC 3339, e.g. `2026-12-31T00:00:00Z`).",
+				Required:            true,
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Vulnerability allowlist entry identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *VulnerabilityAllowlistResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *VulnerabilityAllowlistResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data VulnerabilityAllowlistResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts := client.AllowedVulnerabilityOptions{
+		VulnerabilityId: data.VulnerabilityId.ValueString(),
+		PackageName:     data.PackageName.ValueStringPointer(),
+		Justification:   data.Justification.ValueString(),
+		ExpiresAt:       data.ExpiresAt.ValueString(),
+	}
+
+	// The workspace and the repository can be given by name, the API only accepts the Ids
+	if !data.WorkspaceId.IsNull() {
+		workspaceId, repositoryId, err := resolveScope(r.client, data.WorkspaceId.ValueString(), data.Repository.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", err.Error())
+			return
+		}
+		opts.WorkspaceId = &workspaceId
+		if repositoryId != "" {
+			opts.RepositoryId = &repositoryId
+		}
+	}
+
+	av, err := r.client.CreateAllowedVulnerability(opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to allow vulnerability, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, av)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow vulnerability allowlist resource", map[string]interface{}{
+		"id":               av.Id,
+		"vulnerability_id": av.VulnerabilityId,
+		"expires_at":       av.ExpiresAt,
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VulnerabilityAllowlistResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data VulnerabilityAllowlistResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryId := data.Id.ValueString()
+
+	av, err := r.client.GetAllowedVulnerability(entryId)
+
+	// The waiver lapsed or was revoked outside of Terraform
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get allowed vulnerability %s, got error: %s", entryId, err))
+		return
+	}
+
+	r.mapResponseToModel(&data, av)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VulnerabilityAllowlistResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data VulnerabilityAllowlistResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The vulnerability and its scope require a replacement, the justification and the expiry change in place
+	opts := client.AllowedVulnerabilityUpdateOptions{
+		Justification: data.Justification.ValueString(),
+		ExpiresAt:     data.ExpiresAt.ValueString(),
+	}
+
+	av, err := r.client.UpdateAllowedVulnerability(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update allowed vulnerability, got error: %s", err))
+		return
+	}
+
+	r.mapResponseToModel(&data, av)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *VulnerabilityAllowlistResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data VulnerabilityAllowlistResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entryId := data.Id.ValueString()
+
+	err := r.client.DeleteAllowedVulnerability(entryId)
+
+	// The waiver may have lapsed since the last refresh
+	if err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete allowed vulnerability, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow vulnerability allowlist resource", map[string]interface{}{
+		"id": entryId,
+	})
+}
+
+func (r *VulnerabilityAllowlistResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *VulnerabilityAllowlistResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	checkExpiresAt(ctx, req, resp, "Expired Vulnerability Waiver", "waiver", "no longer allows the vulnerability")
+}
+
+func (r *VulnerabilityAllowlistResource) mapResponseToModel(data *VulnerabilityAllowlistResourceModel, av *client.AllowedVulnerability) {
+	data.Id = types.StringValue(av.Id)
+	data.VulnerabilityId = types.StringValue(av.VulnerabilityId)
+	// Keep the workspace and the repository as configured (name or Id), only fill them on import
+	if data.WorkspaceId.IsNull() || data.WorkspaceId.IsUnknown() {
+		data.WorkspaceId = types.StringPointerValue(av.WorkspaceId)
+	}
+	if data.Repository.IsNull() || data.Repository.IsUnknown() {
+		data.Repository = types.StringPointerValue(av.RepositoryId)
+	}
+	data.PackageName = types.StringPointerValue(av.PackageName)
+	data.Justification = types.StringValue(av.Justification)
+	// The API may normalize the timestamp, keep the configured value when it is the same instant
+	if !sameInstant(data.ExpiresAt.ValueString(), av.ExpiresAt) {
+		data.ExpiresAt = types.StringValue(av.ExpiresAt)
+	}
+}
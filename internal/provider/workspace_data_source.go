@@ -9,7 +9,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
-	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
@@ -21,12 +22,17 @@ func NewWorkspaceDataSource() datasource.DataSource {
 
 // ExampleDataSource defines the data source implementation.
 type WorkspaceDataSource struct {
-	client *repoflow.Client
+	client client.WorkspacesAPI
 }
 
 type WorkspaceDataSourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Id           types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	Labels       types.Map    `tfsdk:"labels"`
+	StorageQuota types.Int64  `tfsdk:"storage_quota_bytes"`
+	StorageUsed  types.Int64  `tfsdk:"storage_used_bytes"`
+	Region       types.String `tfsdk:"region"`
 }
 
 func (d *WorkspaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,6 +53,27 @@ func (d *WorkspaceDataSource) Schema(ctx context.Context, req datasource.SchemaR
 				MarkdownDescription: "Workspace identifier",
 				Computed:            true,
 			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Workspace description",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels attached to the workspace",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"storage_quota_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Storage quota of the workspace in bytes, null when unlimited",
+				Computed:            true,
+			},
+			"storage_used_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Storage used by the workspace in bytes",
+				Computed:            true,
+			},
+			"region": schema.StringAttribute{
+				MarkdownDescription: "RepoFlow cloud region hosting the workspace, null on self-hosted instances",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -56,12 +83,12 @@ func (d *WorkspaceDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*repoflow.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -71,7 +98,7 @@ func (d *WorkspaceDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var data WorkspaceResourceModel
+	var data WorkspaceDataSourceModel
 
 	// Read Terraform prior state data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -91,6 +118,19 @@ func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadReque
 
 	data.Id = types.StringValue(ws.Id)
 	data.Name = types.StringValue(ws.Name)
+	data.Description = types.StringPointerValue(ws.Description)
+	data.StorageQuota = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.StorageLimitInByte))
+	data.StorageUsed = types.Int64Value(int64(ws.StorageUsageInByte))
+	data.Region = types.StringPointerValue(ws.Region)
+
+	// Always expose a map so labels can be filtered with lookup()
+	if ws.Labels == nil {
+		ws.Labels = map[string]string{}
+	}
+
+	labels, diags := types.MapValueFrom(ctx, types.StringType, ws.Labels)
+	resp.Diagnostics.Append(diags...)
+	data.Labels = labels
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -0,0 +1,139 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &WorkspaceExportAction{}
+var _ action.ActionWithConfigure = &WorkspaceExportAction{}
+
+func NewWorkspaceExportAction() action.Action {
+	return &WorkspaceExportAction{}
+}
+
+// WorkspaceExportAction defines the action implementation.
+type WorkspaceExportAction struct {
+	client client.WorkspacesAPI
+}
+
+// WorkspaceExportActionModel describes the action data model.
+type WorkspaceExportActionModel struct {
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Path        types.String `tfsdk:"path"`
+}
+
+func (a *WorkspaceExportAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_export"
+}
+
+func (a *WorkspaceExportAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workspace export action. Write the configuration of a workspace (settings, repositories, policies and permissions) to a JSON document, e.g. for a backup or to clone an environment with the `repoflow_workspace_import` action. Actions do not return values, read the document back with the `file` function or the `local_file` data source.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace to export (name or Id).",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Local file the document is written to, replaced when it exists. The missing parent directories are created.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (a *WorkspaceExportAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *WorkspaceExportAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data WorkspaceExportActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+	target := data.Path.ValueString()
+
+	ws, err := a.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	doc, err := a.client.ExportWorkspace(ws.Id)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	// Indent the document so the backups can be diffed
+	var out bytes.Buffer
+	if err := json.Indent(&out, doc, "", "  "); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read the export of workspace %s, got error: %s", workspace, err))
+		return
+	}
+	out.WriteString("\n")
+
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		resp.Diagnostics.AddError("Export Error", fmt.Sprintf("Unable to create the directory of %s, got error: %s", target, err))
+		return
+	}
+
+	if err := os.WriteFile(target, out.Bytes(), 0o600); err != nil {
+		resp.Diagnostics.AddError("Export Error", fmt.Sprintf("Unable to write %s, got error: %s", target, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "exported a repoflow workspace", map[string]interface{}{
+		"workspace": ws.Id,
+		"path":      target,
+		"bytes":     out.Len(),
+	})
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Exported workspace %s to %s", ws.Name, target),
+	})
+}
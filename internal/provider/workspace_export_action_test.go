@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+func TestWorkspaceExportAction(t *testing.T) {
+	p := newTestProvider(t)
+	workspaceId := newTestWorkspace(t, p, "platform")
+	if _, err := p.client.CreateLocalRepository(workspaceId, client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+	}); err != nil {
+		t.Fatalf("CreateLocalRepository: %s", err)
+	}
+	if _, err := p.client.PutRepositoryPermission(workspaceId, "npm-local", "group:dev", client.RepositoryPermissionOptions{Role: "write"}); err != nil {
+		t.Fatalf("PutRepositoryPermission: %s", err)
+	}
+
+	// The missing parent directories are created
+	target := filepath.Join(t.TempDir(), "backups", "platform.json")
+	config := func(workspace string) tftypes.Value {
+		return p.actionConfig("repoflow_workspace_export", map[string]tftypes.Value{
+			"workspace": str(workspace),
+			"path":      str(target),
+		})
+	}
+
+	progress, diags := p.invoke("repoflow_workspace_export", config("platform"))
+	checkDiagnostics(t, "invoke repoflow_workspace_export", diags)
+	if len(progress) != 1 || progress[0] != "Exported workspace platform to "+target {
+		t.Errorf("progress = %q", progress)
+	}
+
+	content, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("export not written: %s", err)
+	}
+	if info, _ := os.Stat(target); info.Mode().Perm() != 0o600 {
+		t.Errorf("export mode = %s, want -rw-------", info.Mode().Perm())
+	}
+
+	// The document is indented, the repositories and permissions referenced by name
+	if !strings.HasPrefix(string(content), "{\n  ") || !strings.HasSuffix(string(content), "}\n") {
+		t.Errorf("export not indented:\n%s", content)
+	}
+	var doc struct {
+		Workspace struct {
+			Name string `json:"name"`
+		} `json:"workspace"`
+		Repositories []struct {
+			Name string `json:"name"`
+		} `json:"repositories"`
+		Permissions []struct {
+			Repository string `json:"repository"`
+			Principal  string `json:"principal"`
+		} `json:"permissions"`
+	}
+	if err := json.Unmarshal(content, &doc); err != nil {
+		t.Fatalf("export is not JSON: %s", err)
+	}
+	if doc.Workspace.Name != "platform" || len(doc.Repositories) != 1 || doc.Repositories[0].Name != "npm-local" {
+		t.Errorf("export = %+v, want the platform workspace and its npm-local repository", doc)
+	}
+	if len(doc.Permissions) != 1 || doc.Permissions[0].Repository != "npm-local" || doc.Permissions[0].Principal != "group:dev" {
+		t.Errorf("permissions = %+v, want the grant of group:dev on npm-local", doc.Permissions)
+	}
+
+	// A missing workspace leaves the previous export untouched
+	if _, diags := p.invoke("repoflow_workspace_export", config("missing")); !hasError(diags) {
+		t.Error("missing workspace exported")
+	}
+	if after, _ := os.ReadFile(target); string(after) != string(content) {
+		t.Error("export replaced by a failed one")
+	}
+}
@@ -0,0 +1,158 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ action.Action = &WorkspaceImportAction{}
+var _ action.ActionWithConfigure = &WorkspaceImportAction{}
+
+func NewWorkspaceImportAction() action.Action {
+	return &WorkspaceImportAction{}
+}
+
+// WorkspaceImportAction defines the action implementation.
+type WorkspaceImportAction struct {
+	client client.WorkspacesAPI
+}
+
+// WorkspaceImportActionModel describes the action data model.
+type WorkspaceImportActionModel struct {
+	WorkspaceId types.String `tfsdk:"workspace"`
+	Path        types.String `tfsdk:"path"`
+	Document    types.String `tfsdk:"document"`
+	DryRun      types.Bool   `tfsdk:"dry_run"`
+}
+
+func (a *WorkspaceImportAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_import"
+}
+
+func (a *WorkspaceImportAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workspace import action. Apply a configuration document written by the `repoflow_workspace_export` action to a workspace, e.g. to restore a backup or to stamp out an environment from a template: the missing repositories, policies and permissions are created and the others updated. The objects missing from the document are left untouched. Each change is reported as a progress message, use `dry_run` to only report them. The created objects are not managed by Terraform.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace the document is applied to (name or Id), it can differ from the exported one.",
+				Required:            true,
+			},
+			"path": schema.StringAttribute{
+				MarkdownDescription: "Local file holding the document. Conflicts with `document`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("path"), path.MatchRoot("document")),
+				},
+			},
+			"document": schema.StringAttribute{
+				MarkdownDescription: "Document as a JSON string, e.g. rendered by `templatefile`. Conflicts with `path`.",
+				Optional:            true,
+			},
+			"dry_run": schema.BoolAttribute{
+				MarkdownDescription: "Only report the changes, without applying them (default `false`).",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (a *WorkspaceImportAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Action Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	a.client = client
+}
+
+func (a *WorkspaceImportAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var data WorkspaceImportActionModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	doc := []byte(data.Document.ValueString())
+	if !data.Path.IsNull() {
+		var err error
+		if doc, err = os.ReadFile(data.Path.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Invalid Document", err.Error())
+			return
+		}
+	}
+
+	if !json.Valid(doc) {
+		resp.Diagnostics.AddError("Invalid Document", "The document is not valid JSON.")
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+	dryRun := data.DryRun.ValueBool()
+
+	ws, err := a.client.GetWorkspace(workspace)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	result, err := a.client.ImportWorkspace(ws.Id, client.WorkspaceImportOptions{
+		Document: doc,
+		DryRun:   dryRun,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "imported a repoflow workspace", map[string]interface{}{
+		"workspace": ws.Id,
+		"dry_run":   dryRun,
+		"changes":   len(result.Changes),
+	})
+
+	suffix := ""
+	if dryRun {
+		suffix = " (dry run)"
+	}
+
+	for _, change := range result.Changes {
+		resp.SendProgress(action.InvokeProgressEvent{
+			Message: fmt.Sprintf("%s %s: %s%s", change.Kind, change.Name, change.Action, suffix),
+		})
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Imported %d change(s) to workspace %s%s", len(result.Changes), ws.Name, suffix),
+	})
+}
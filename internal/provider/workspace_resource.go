@@ -3,22 +3,41 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	// "github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/fe80/go-repoflow/pkg/repoflow"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+// Roles of the workspace members
+var memberRoles = []string{"viewer", "developer", "admin"}
+
+// Audit logs are kept at least 30 days for compliance, and at most 10 years
+const (
+	minAuditRetentionDays = 30
+	maxAuditRetentionDays = 3650
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &WorkspaceResource{}
 var _ resource.ResourceWithImportState = &WorkspaceResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceResource{}
 
 func NewWorkspaceResource() resource.Resource {
 	return &WorkspaceResource{}
@@ -26,13 +45,34 @@ func NewWorkspaceResource() resource.Resource {
 
 // WorkspaceResource defines the resource implementation.
 type WorkspaceResource struct {
-	client *repoflow.Client
+	client interface {
+		client.WorkspacesAPI
+		client.RepositoriesAPI
+		client.LabelsAPI
+	}
 }
 
 // WorkspaceResourceModel describes the resource data model.
 type WorkspaceResourceModel struct {
-	Name types.String `tfsdk:"name"`
-	Id   types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Id           types.String `tfsdk:"id"`
+	Description  types.String `tfsdk:"description"`
+	Labels       types.Map    `tfsdk:"labels"`
+	StorageQuota types.Int64  `tfsdk:"storage_quota_bytes"`
+	StorageUsed  types.Int64  `tfsdk:"storage_used_bytes"`
+
+	DefaultRemoteCacheEnabled types.Bool  `tfsdk:"default_remote_cache_enabled"`
+	DefaultFileCacheTtl       types.Int64 `tfsdk:"default_file_cache_ttl"`
+	DefaultMetadataCacheTtl   types.Int64 `tfsdk:"default_metadata_cache_ttl"`
+
+	DefaultMemberRole  types.String `tfsdk:"default_member_role"`
+	AuditRetentionDays types.Int64  `tfsdk:"audit_retention_days"`
+	StorageBackendId   types.String `tfsdk:"storage_backend_id"`
+	ManagedBy          types.String `tfsdk:"managed_by"`
+
+	// Provider side settings, never sent to the API
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool `tfsdk:"force_destroy"`
 }
 
 func (r *WorkspaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -52,6 +92,79 @@ func (r *WorkspaceResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Workspace description.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Labels attached to the workspace. Keys and values are checked against the `repoflow_label_taxonomy` declared on the instance.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"storage_quota_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Storage quota of the workspace in bytes. The storage is unlimited when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"storage_used_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Storage used by the workspace in bytes.",
+				Computed:            true,
+			},
+			"default_remote_cache_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether caching is enabled on new remote repositories not setting `cache_enabled`.",
+				Optional:            true,
+			},
+			"default_file_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Milliseconds before cached files require revalidation on new remote repositories not setting `file_cache_time_till_revalidation`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"default_metadata_cache_ttl": schema.Int64Attribute{
+				MarkdownDescription: "Milliseconds before cached metadata requires revalidation on new remote repositories not setting `metadata_cache_time_till_revalidation`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"default_member_role": schema.StringAttribute{
+				MarkdownDescription: "Role given to the new members of the workspace, one of `viewer`, `developer` or `admin`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(memberRoles...),
+				},
+			},
+			"audit_retention_days": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of days the audit logs of the workspace are kept, between %d and %d. The instance retention applies when unset.", minAuditRetentionDays, maxAuditRetentionDays),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(minAuditRetentionDays, maxAuditRetentionDays),
+				},
+			},
+			"storage_backend_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the `repoflow_storage_backend` storing the packages of the new repositories of the workspace, the instance default storage when unset. The existing repositories keep their backend.",
+				Optional:            true,
+			},
+			"managed_by": schema.StringAttribute{
+				MarkdownDescription: "Tool managing the workspace, always `terraform` once applied. A workspace created by hand and imported gets the marker on the next apply.",
+				Computed:            true,
+				Default:             stringdefault.StaticString(managedByMarker),
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: "Prevent Terraform from destroying the workspace (default `false`). It must be set to `false` and applied before the workspace can be destroyed.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"force_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Delete every repository of the workspace on destroy, instead of failing on a non-empty workspace (default `false`).",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 			"id": schema.StringAttribute{
 				Computed:            true,
 				MarkdownDescription: "Workspace identifier",
@@ -69,12 +182,12 @@ func (r *WorkspaceResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*repoflow.Client)
+	client, ok := req.ProviderData.(client.API)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -95,9 +208,26 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 
 	workspaceName := data.Name.ValueString()
 
-	opts := repoflow.WorkspaceOptions{
-		Name: workspaceName,
+	opts := client.WorkspaceOptions{
+		WorkspaceOptions: repoflow.WorkspaceOptions{
+			Name:         workspaceName,
+			Comments:     data.Description.ValueStringPointer(),
+			StorageLimit: factory.Int64ToPtr(data.StorageQuota),
+		},
+		DefaultRemoteCacheEnabled: data.DefaultRemoteCacheEnabled.ValueBoolPointer(),
+		DefaultFileCacheTtl:       factory.Int64ToPtr(data.DefaultFileCacheTtl),
+		DefaultMetadataCacheTtl:   factory.Int64ToPtr(data.DefaultMetadataCacheTtl),
+		DefaultMemberRole:         data.DefaultMemberRole.ValueStringPointer(),
+		AuditRetentionDays:        factory.Int64ToPtr(data.AuditRetentionDays),
+		StorageBackendId:          data.StorageBackendId.ValueStringPointer(),
+		ManagedBy:                 managedByMarker,
+	}
+	resp.Diagnostics.Append(r.buildLabels(ctx, &data, &opts.Labels)...)
+
+	if resp.Diagnostics.HasError() {
+		return
 	}
+
 	ws, err := r.client.CreateWorkspace(opts)
 
 	if err != nil {
@@ -105,8 +235,7 @@ func (r *WorkspaceResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	data.Id = types.StringValue(ws.Id)
-	data.Name = types.StringValue(ws.Name)
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ws)...)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -132,15 +261,22 @@ func (r *WorkspaceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	ws, err := r.client.GetWorkspace(workspaceId)
 
+	// The workspace was deleted outside of Terraform, let it plan the recreation
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "workspace not found, removing it from state", map[string]interface{}{
+			"id": workspaceId,
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace, got error: %s", err))
 		return
 	}
 
-	data = WorkspaceResourceModel{
-		Id:   types.StringValue(ws.Id),
-		Name: types.StringValue(ws.Name),
-	}
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ws)...)
+	resp.Diagnostics.Append(checkManagedBy("workspace", ws.Name, ws.ManagedBy)...)
 
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
@@ -162,6 +298,44 @@ func (r *WorkspaceResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
+	// The name requires a replacement, every other setting changes in place
+	managedBy := managedByMarker
+	opts := client.WorkspaceUpdateOptions{
+		Description:  data.Description.ValueStringPointer(),
+		Labels:       map[string]string{},
+		StorageLimit: factory.Int64ToPtr(data.StorageQuota),
+
+		DefaultRemoteCacheEnabled: data.DefaultRemoteCacheEnabled.ValueBoolPointer(),
+		DefaultFileCacheTtl:       factory.Int64ToPtr(data.DefaultFileCacheTtl),
+		DefaultMetadataCacheTtl:   factory.Int64ToPtr(data.DefaultMetadataCacheTtl),
+		DefaultMemberRole:         data.DefaultMemberRole.ValueStringPointer(),
+		AuditRetentionDays:        factory.Int64ToPtr(data.AuditRetentionDays),
+		StorageBackendId:          data.StorageBackendId.ValueStringPointer(),
+
+		// Claim the workspace, like an imported one missing the marker
+		ManagedBy: &managedBy,
+	}
+	resp.Diagnostics.Append(r.buildLabels(ctx, &data, &opts.Labels)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ws, err := r.client.UpdateWorkspace(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workspace, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ws)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "updated a repoflow resource", map[string]interface{}{
+		"id": ws.Id,
+	})
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -178,7 +352,31 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 
 	workspaceName := data.Name.ValueString()
 
-	ws, err := r.client.DeleteWorkspace(workspaceName)
+	if data.DeletionProtection.ValueBool() {
+		resp.Diagnostics.AddError(
+			"Deletion Protection",
+			fmt.Sprintf("The workspace %s is protected against deletion, set deletion_protection to false and apply before destroying it.", workspaceName),
+		)
+		return
+	}
+
+	if data.ForceDestroy.ValueBool() {
+		resp.Diagnostics.Append(r.deleteRepositories(ctx, workspaceName)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	_, err := r.client.DeleteWorkspace(workspaceName)
+
+	// The workspace was already deleted outside of Terraform
+	if client.IsNotFound(err) {
+		tflog.Warn(ctx, "workspace already deleted", map[string]interface{}{
+			"id": data.Id.ValueString(),
+		})
+		return
+	}
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete workspace, got error: %s", err))
@@ -188,10 +386,133 @@ func (r *WorkspaceResource) Delete(ctx context.Context, req resource.DeleteReque
 	// Write logs using the tflog package
 	// Documentation: https://terraform.io/plugin/log
 	tflog.Trace(ctx, "deleted a repoflow resource", map[string]interface{}{
-		"id": ws.Id,
+		"id": data.Id.ValueString(),
 	})
 }
 
 func (r *WorkspaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	var data WorkspaceResourceModel
+
+	// The workspace can be given by name or Id
+	ws, err := r.client.GetWorkspace(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to import workspace %s, got error: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, ws)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "import a repoflow workspace resource", map[string]interface{}{
+		"id": ws.Id,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// deleteRepositories deletes every repository of a workspace, virtual repositories
+// first as they reference the other ones.
+func (r *WorkspaceResource) deleteRepositories(ctx context.Context, workspace string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rps, err := r.client.ListRepositories(workspace)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list repositories of workspace %s, got error: %s", workspace, err))
+		return diags
+	}
+
+	repositories := *rps
+	sort.SliceStable(repositories, func(i, j int) bool {
+		return repositories[i].RepositoryType == "virtual" && repositories[j].RepositoryType != "virtual"
+	})
+
+	for _, rp := range repositories {
+		if _, err := r.client.DeleteRepository(workspace, rp.Id); err != nil && !client.IsNotFound(err) {
+			diags.AddError("Client Error", fmt.Sprintf("Unable to delete repository %s of workspace %s, got error: %s", rp.Name, workspace, err))
+			return diags
+		}
+
+		tflog.Debug(ctx, "deleted a repository of the destroyed workspace", map[string]interface{}{
+			"workspace":  workspace,
+			"repository": rp.Id,
+		})
+	}
+
+	return diags
+}
+
+func (r *WorkspaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var labels types.Map
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("labels"), &labels)...)
+
+	if resp.Diagnostics.HasError() || labels.IsNull() || labels.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateLabels(ctx, r.client, path.Root("labels"), labels)...)
+}
+
+func (r *WorkspaceResource) buildLabels(ctx context.Context, data *WorkspaceResourceModel, labels *map[string]string) diag.Diagnostics {
+	if data.Labels.IsNull() {
+		return nil
+	}
+
+	return data.Labels.ElementsAs(ctx, labels, false)
+}
+
+func (r *WorkspaceResource) mapResponseToModel(ctx context.Context, data *WorkspaceResourceModel, ws *client.Workspace) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(ws.Id)
+	data.Name = types.StringValue(ws.Name)
+
+	if ws.Description != nil && *ws.Description != "" {
+		data.Description = types.StringValue(*ws.Description)
+	} else {
+		data.Description = types.StringNull()
+	}
+
+	data.StorageQuota = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.StorageLimitInByte))
+	data.StorageUsed = types.Int64Value(int64(ws.StorageUsageInByte))
+	data.DefaultRemoteCacheEnabled = types.BoolPointerValue(ws.DefaultRemoteCacheEnabled)
+	data.DefaultFileCacheTtl = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.DefaultFileCacheTtl))
+	data.DefaultMetadataCacheTtl = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.DefaultMetadataCacheTtl))
+	data.DefaultMemberRole = types.StringPointerValue(ws.DefaultMemberRole)
+	data.AuditRetentionDays = types.Int64PointerValue(factory.IntPtrToInt64Ptr(ws.AuditRetentionDays))
+	data.StorageBackendId = types.StringPointerValue(ws.StorageBackendId)
+	// Instances not storing the marker do not echo it, keep the planned one
+	if ws.ManagedBy != nil {
+		data.ManagedBy = types.StringPointerValue(ws.ManagedBy)
+	}
+
+	if len(ws.Labels) == 0 {
+		data.Labels = types.MapNull(types.StringType)
+	} else {
+		mapValue, mapDiags := types.MapValueFrom(ctx, types.StringType, ws.Labels)
+		diags.Append(mapDiags...)
+		data.Labels = mapValue
+	}
+
+	// Provider side settings are not returned by the API, default them on import
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+	if data.ForceDestroy.IsNull() {
+		data.ForceDestroy = types.BoolValue(false)
+	}
+
+	return diags
 }
@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/client/fake"
+)
+
+func TestWorkspaceResource(t *testing.T) {
+	p := newTestProvider(t)
+
+	state := p.apply("repoflow_workspace", p.nullState("repoflow_workspace"), p.config("repoflow_workspace", map[string]tftypes.Value{
+		"name":        str("platform"),
+		"description": str("Platform team"),
+	}))
+
+	id := stringAttr(t, state, "id")
+	ws, err := p.client.GetWorkspace("platform")
+	if err != nil {
+		t.Fatalf("workspace not created: %s", err)
+	}
+	if ws.Id != id {
+		t.Errorf("id = %q, want %q", id, ws.Id)
+	}
+	if got := stringAttr(t, state, "managed_by"); got != managedByMarker {
+		t.Errorf("managed_by = %q, want %q", got, managedByMarker)
+	}
+
+	// Update in place
+	state = p.applyInPlace("repoflow_workspace", state, p.config("repoflow_workspace", map[string]tftypes.Value{
+		"name":        str("platform"),
+		"description": str("Platform and tooling"),
+	}))
+	if got := stringAttr(t, state, "id"); got != id {
+		t.Errorf("id changed on update, got %q, want %q", got, id)
+	}
+	if ws, _ := p.client.GetWorkspace(id); ws.Description == nil || *ws.Description != "Platform and tooling" {
+		t.Errorf("description not updated: %v", ws.Description)
+	}
+
+	// Import by name
+	imported := p.importState("repoflow_workspace", "platform")
+	if got := stringAttr(t, imported, "id"); got != id {
+		t.Errorf("imported id = %q, want %q", got, id)
+	}
+	if got := stringAttr(t, imported, "description"); got != "Platform and tooling" {
+		t.Errorf("imported description = %q", got)
+	}
+
+	p.destroy("repoflow_workspace", state)
+	if _, err := p.client.GetWorkspace(id); !client.IsNotFound(err) {
+		t.Errorf("workspace not deleted: %v", err)
+	}
+}
+
+func TestWorkspaceResourceDeletedOutside(t *testing.T) {
+	p := newTestProvider(t)
+
+	state := p.apply("repoflow_workspace", p.nullState("repoflow_workspace"), p.config("repoflow_workspace", map[string]tftypes.Value{
+		"name": str("platform"),
+	}))
+
+	if _, err := p.client.DeleteWorkspace("platform"); err != nil {
+		t.Fatalf("DeleteWorkspace: %s", err)
+	}
+
+	if state := p.read("repoflow_workspace", state); !state.IsNull() {
+		t.Errorf("workspace deleted outside of Terraform still in state: %v", state)
+	}
+}
+
+func TestWorkspaceResourceDeletionProtection(t *testing.T) {
+	p := newTestProvider(t)
+
+	state := p.apply("repoflow_workspace", p.nullState("repoflow_workspace"), p.config("repoflow_workspace", map[string]tftypes.Value{
+		"name":                str("platform"),
+		"deletion_protection": tftypes.NewValue(tftypes.Bool, true),
+	}))
+
+	if _, diags := p.tryApply("repoflow_workspace", state, p.nullState("repoflow_workspace")); !hasError(diags) {
+		t.Fatal("protected workspace destroyed")
+	}
+	if _, err := p.client.GetWorkspace("platform"); err != nil {
+		t.Errorf("protected workspace deleted: %s", err)
+	}
+}
+
+func TestWorkspaceResourceForceDestroy(t *testing.T) {
+	p := newTestProvider(t)
+
+	state := p.apply("repoflow_workspace", p.nullState("repoflow_workspace"), p.config("repoflow_workspace", map[string]tftypes.Value{
+		"name":          str("platform"),
+		"force_destroy": tftypes.NewValue(tftypes.Bool, true),
+	}))
+
+	_, err := p.client.CreateLocalRepository("platform", client.RepositoryOptions{
+		RepositoryOptions: repoflow.RepositoryOptions{Name: "npm-local", PackageType: "npm"},
+	})
+	if err != nil {
+		t.Fatalf("CreateLocalRepository: %s", err)
+	}
+
+	p.destroy("repoflow_workspace", state)
+	if _, err := p.client.GetWorkspace("platform"); !client.IsNotFound(err) {
+		t.Errorf("workspace not deleted: %v", err)
+	}
+}
+
+// noWorkspaceMarker answers like the instances not storing the managed_by marker.
+type noWorkspaceMarker struct {
+	*fake.Client
+}
+
+func (c *noWorkspaceMarker) strip(ws *client.Workspace, err error) (*client.Workspace, error) {
+	if ws != nil {
+		ws.ManagedBy = nil
+	}
+	return ws, err
+}
+
+func (c *noWorkspaceMarker) CreateWorkspace(opts client.WorkspaceOptions) (*client.Workspace, error) {
+	return c.strip(c.Client.CreateWorkspace(opts))
+}
+
+func (c *noWorkspaceMarker) GetWorkspace(id string) (*client.Workspace, error) {
+	return c.strip(c.Client.GetWorkspace(id))
+}
+
+func (c *noWorkspaceMarker) UpdateWorkspace(id string, opts client.WorkspaceUpdateOptions) (*client.Workspace, error) {
+	return c.strip(c.Client.UpdateWorkspace(id, opts))
+}
+
+func TestWorkspaceResourceMarkerOmitted(t *testing.T) {
+	api := &noWorkspaceMarker{Client: fake.New()}
+	p := newTestProviderWithClient(t, api.Client, api)
+
+	config := func(description string) tftypes.Value {
+		return p.config("repoflow_workspace", map[string]tftypes.Value{
+			"name":        str("platform"),
+			"description": str(description),
+		})
+	}
+
+	// The planned marker is kept in the state
+	state := p.apply("repoflow_workspace", p.nullState("repoflow_workspace"), config("Platform team"))
+	if got := stringAttr(t, state, "managed_by"); got != managedByMarker {
+		t.Errorf("managed_by = %q, want %q", got, managedByMarker)
+	}
+
+	state = p.applyInPlace("repoflow_workspace", state, config("Platform and tooling"))
+	if got := stringAttr(t, state, "managed_by"); got != managedByMarker {
+		t.Errorf("managed_by = %q after an update, want %q", got, managedByMarker)
+	}
+
+	if refreshed := p.read("repoflow_workspace", state); !refreshed.Equal(state) {
+		t.Errorf("refresh changed the state:\n%v\nwant\n%v", refreshed, state)
+	}
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &WorkspaceSettingsResource{}
+var _ resource.ResourceWithImportState = &WorkspaceSettingsResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceSettingsResource{}
+
+func NewWorkspaceSettingsResource() resource.Resource {
+	return &WorkspaceSettingsResource{}
+}
+
+// WorkspaceSettingsResource defines the resource implementation.
+type WorkspaceSettingsResource struct {
+	client client.WorkspacesAPI
+}
+
+// WorkspaceSettingsResourceModel describes the resource data model.
+type WorkspaceSettingsResourceModel struct {
+	Id                         types.String `tfsdk:"id"`
+	WorkspaceId                types.String `tfsdk:"workspace"`
+	AllowedPackageTypes        types.Set    `tfsdk:"allowed_package_types"`
+	RepositoryNamePattern      types.String `tfsdk:"repository_name_pattern"`
+	DefaultRetentionUnusedDays types.Int64  `tfsdk:"default_retention_unused_days"`
+}
+
+func (r *WorkspaceSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_settings"
+}
+
+func (r *WorkspaceSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workspace settings resource. Enforce rules on the repositories of a workspace: the package types allowed, a naming convention and the default retention of the cached artifacts. There is one settings per workspace, the rules only apply to the repositories created after them. Destroying the resource lifts every rule.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace of the settings (name or Id).",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"allowed_package_types": schema.SetAttribute{
+				MarkdownDescription: "Package types of the repositories created in the workspace. Every type is allowed when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(packageTypes...)),
+				},
+			},
+			"repository_name_pattern": schema.StringAttribute{
+				MarkdownDescription: "Regular expression the names of the repositories created in the workspace must match, e.g. `^(npm|pypi)-[a-z0-9-]+$`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"default_retention_unused_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days without download after which the artifacts cached by the remote repositories are purged, for the repositories without `repoflow_retention_policy`. They are kept forever when unset.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Workspace settings identifier, the workspace Id",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WorkspaceSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkspaceSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WorkspaceSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.WorkspaceId.ValueString()
+
+	st, err := r.client.PutWorkspaceSettings(workspace, opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set the settings of workspace %s, got error: %s", workspace, err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, st)...)
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "created a repoflow workspace settings resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WorkspaceSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	st, err := r.client.GetWorkspaceSettings(data.Id.ValueString())
+
+	// The settings are gone with their workspace
+	if client.IsNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace settings %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, st)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WorkspaceSettingsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	opts, diags := r.buildOptions(ctx, &data)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	st, err := r.client.PutWorkspaceSettings(data.Id.ValueString(), opts)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update workspace settings, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(r.mapResponseToModel(ctx, &data, st)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WorkspaceSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WorkspaceSettingsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.client.DeleteWorkspaceSettings(data.Id.ValueString()); err != nil && !client.IsNotFound(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset workspace settings, got error: %s", err))
+		return
+	}
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "deleted a repoflow workspace settings resource", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+}
+
+func (r *WorkspaceSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ws, err := r.client.GetWorkspace(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", req.ID, err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ws.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), req.ID)...)
+}
+
+func (r *WorkspaceSettingsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var pattern types.String
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("repository_name_pattern"), &pattern)...)
+
+	if resp.Diagnostics.HasError() || pattern.IsUnknown() || pattern.IsNull() {
+		return
+	}
+
+	// The API matches the names with the same regular expression syntax
+	if _, err := regexp.Compile(pattern.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("repository_name_pattern"),
+			"Invalid parameter",
+			fmt.Sprintf("%q is not a valid regular expression: %s.", pattern.ValueString(), err),
+		)
+	}
+}
+
+func (r *WorkspaceSettingsResource) buildOptions(ctx context.Context, data *WorkspaceSettingsResourceModel) (client.WorkspaceSettingsOptions, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// An empty list allows every package type
+	opts := client.WorkspaceSettingsOptions{
+		AllowedPackageTypes:        []string{},
+		RepositoryNamePattern:      data.RepositoryNamePattern.ValueStringPointer(),
+		DefaultRetentionUnusedDays: factory.Int64ToPtr(data.DefaultRetentionUnusedDays),
+	}
+	diags.Append(data.AllowedPackageTypes.ElementsAs(ctx, &opts.AllowedPackageTypes, false)...)
+
+	return opts, diags
+}
+
+func (r *WorkspaceSettingsResource) mapResponseToModel(ctx context.Context, data *WorkspaceSettingsResourceModel, st *client.WorkspaceSettings) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(st.WorkspaceId)
+	// The workspace is kept as configured (name or Id)
+
+	// Keep the list null when empty to avoid a diff with the configuration
+	data.AllowedPackageTypes, diags = stringSetOrNull(ctx, st.AllowedPackageTypes)
+	data.RepositoryNamePattern = types.StringPointerValue(st.RepositoryNamePattern)
+	data.DefaultRetentionUnusedDays = types.Int64PointerValue(factory.IntPtrToInt64Ptr(st.DefaultRetentionUnusedDays))
+
+	return diags
+}
@@ -0,0 +1,183 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/terraform-provider-repoflow/internal/client"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkspacesDataSource{}
+
+func NewWorkspacesDataSource() datasource.DataSource {
+	return &WorkspacesDataSource{}
+}
+
+// WorkspacesDataSource defines the data source implementation.
+type WorkspacesDataSource struct {
+	client client.WorkspacesAPI
+}
+
+type WorkspacesDataSourceModel struct {
+	NameRegex  types.String          `tfsdk:"name_regex"`
+	Labels     map[string]string     `tfsdk:"labels"`
+	Workspaces []WorkspaceEntryModel `tfsdk:"workspaces"`
+}
+
+type WorkspaceEntryModel struct {
+	Id   types.String `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+}
+
+func (d *WorkspacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspaces"
+}
+
+func (d *WorkspacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workspaces data source. The workspaces of the instance, optionally filtered by name or labels, e.g. to apply the same settings to each of them with `for_each`.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return the workspaces whose name matches this regular expression, e.g. `^team-`.",
+				Optional:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: "Only return the workspaces holding every one of these labels with the same value.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"workspaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Workspaces found, ordered by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Workspace identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Workspace name",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkspacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(client.API)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected client.API, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkspacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegexp *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid parameter",
+				fmt.Sprintf("%q is not a valid regular expression: %s.", data.NameRegex.ValueString(), err),
+			)
+			return
+		}
+		nameRegexp = re
+	}
+
+	wss, err := d.client.ListWorkspaces()
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workspaces, got error: %s", err))
+		return
+	}
+
+	data.Workspaces = []WorkspaceEntryModel{}
+
+	for _, item := range *wss {
+		if nameRegexp != nil && !nameRegexp.MatchString(item.Name) {
+			continue
+		}
+
+		// The list only holds the workspace names, read the workspace for its labels
+		if len(data.Labels) > 0 {
+			ws, err := d.client.GetWorkspace(item.Id)
+
+			// Deleted since the listing
+			if client.IsNotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", item.Name, err))
+				return
+			}
+
+			if !hasLabels(ws.Labels, data.Labels) {
+				continue
+			}
+		}
+
+		data.Workspaces = append(data.Workspaces, WorkspaceEntryModel{
+			Id:   types.StringValue(item.Id),
+			Name: types.StringValue(item.Name),
+		})
+	}
+	sort.Slice(data.Workspaces, func(i, j int) bool {
+		return data.Workspaces[i].Name.ValueString() < data.Workspaces[j].Name.ValueString()
+	})
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read workspaces data", map[string]interface{}{
+		"listed": len(*wss),
+		"found":  len(data.Workspaces),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// hasLabels reports whether labels hold every one of the wanted labels with the same value.
+func hasLabels(labels map[string]string, wanted map[string]string) bool {
+	for key, value := range wanted {
+		if v, ok := labels[key]; !ok || v != value {
+			return false
+		}
+	}
+	return true
+}